@@ -0,0 +1,225 @@
+package billerwallet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bojanz/currency"
+
+	"brank.as/petnet/api/core/static"
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const phpCurrency = "PHP"
+
+type Store interface {
+	CreateBillerWalletBalance(context.Context, storage.BillerWalletBalance) (*storage.BillerWalletBalance, error)
+	LatestBillerWalletBalances(context.Context) ([]storage.BillerWalletBalance, error)
+	SetBillerWalletBalanceAlerted(ctx context.Context, id string) error
+	ListBillerWalletBalance(context.Context, storage.BillerWalletBalanceFilter) ([]storage.BillerWalletBalance, error)
+}
+
+// Client fetches the biller wallet balances from perahub.
+type Client interface {
+	BCGetWalletBalance(context.Context) (*bpi.BCGetWalletBalanceResponse, error)
+	BillsPaymentEcpayCheckBalance(context.Context) (*bpi.BillsPaymentEcpayCheckBalanceResponseBody, error)
+}
+
+// Alert is sent when the balance of a biller wallet drops below its threshold.
+type Alert struct {
+	Partner   string
+	Balance   currency.Minor
+	Threshold currency.Minor
+	Checked   time.Time
+}
+
+type Notifier interface {
+	Notify(context.Context, Alert) error
+}
+
+type Svc struct {
+	st         Store
+	cl         Client
+	threshold  int64
+	thresholds map[string]int64
+	notifiers  []Notifier
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithThreshold sets the balance, in minor units, below which an alert is sent
+// for billers without their own threshold.
+func WithThreshold(n int64) Option {
+	return func(s *Svc) {
+		if n > 0 {
+			s.threshold = n
+		}
+	}
+}
+
+// WithPartnerThreshold sets the alert threshold, in minor units, of a biller.
+func WithPartnerThreshold(partner string, n int64) Option {
+	return func(s *Svc) {
+		if n > 0 {
+			s.thresholds[partner] = n
+		}
+	}
+}
+
+// WithNotifiers adds the notifiers alerts are sent to.
+func WithNotifiers(ns ...Notifier) Option {
+	return func(s *Svc) {
+		for _, n := range ns {
+			if n != nil {
+				s.notifiers = append(s.notifiers, n)
+			}
+		}
+	}
+}
+
+// New biller wallet balance monitor.
+func New(st Store, cl Client, opts ...Option) *Svc {
+	s := &Svc{
+		st:         st,
+		cl:         cl,
+		thresholds: map[string]int64{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ParseAmount converts an amount in major units, e.g. "1000.50", to minor units.
+func ParseAmount(amt string) (int64, error) {
+	a, err := currency.NewAmount(amt, phpCurrency)
+	if err != nil {
+		return 0, err
+	}
+	return a.ToMinorUnits(), nil
+}
+
+func (s *Svc) partnerThreshold(partner string) int64 {
+	if n, ok := s.thresholds[partner]; ok {
+		return n
+	}
+	return s.threshold
+}
+
+// balance returns the wallet balance of the biller in minor units.
+func (s *Svc) balance(ctx context.Context, partner string) (int64, error) {
+	var amt string
+	switch partner {
+	case static.BYCBP:
+		rs, err := s.cl.BCGetWalletBalance(ctx)
+		if err != nil {
+			return 0, err
+		}
+		amt = rs.Result.Balance
+	case static.ECPBP:
+		rs, err := s.cl.BillsPaymentEcpayCheckBalance(ctx)
+		if err != nil {
+			return 0, err
+		}
+		amt = rs.Result.RemBal
+	default:
+		return 0, fmt.Errorf("no wallet balance for biller %q", partner)
+	}
+	return ParseAmount(amt)
+}
+
+// Poll records the wallet balance of each biller and alerts the notifiers of
+// the billers below their threshold. A low balance is alerted until an alert
+// is delivered, then not again until the balance recovers. Meant to run as a
+// leader cron.
+func (s *Svc) Poll(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	ls, err := s.st.LatestBillerWalletBalances(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("listing latest biller wallet balances")
+		return err
+	}
+	prev := make(map[string]storage.BillerWalletBalance, len(ls))
+	for _, l := range ls {
+		prev[l.Partner] = l
+	}
+
+	for _, p := range []string{static.BYCBP, static.ECPBP} {
+		log := log.WithField("partner", p)
+		b := storage.BillerWalletBalance{
+			Partner:   p,
+			Currency:  phpCurrency,
+			Threshold: s.partnerThreshold(p),
+		}
+		bal, err := s.balance(ctx, p)
+		if err != nil {
+			logging.WithError(err, log).Error("fetching biller wallet balance")
+			b.Error = err.Error()
+		} else {
+			b.Balance = bal
+			b.Low = b.Threshold > 0 && bal < b.Threshold
+			b.Alerted = b.Low && prev[p].Low && prev[p].Alerted
+		}
+		rec, err := s.st.CreateBillerWalletBalance(ctx, b)
+		if err != nil {
+			logging.WithError(err, log).Error("recording biller wallet balance")
+			continue
+		}
+		if !rec.Low || rec.Alerted {
+			continue
+		}
+		if !s.notify(ctx, Alert{
+			Partner:   p,
+			Balance:   minor(rec.Balance),
+			Threshold: minor(rec.Threshold),
+			Checked:   rec.Created,
+		}) {
+			continue
+		}
+		if err := s.st.SetBillerWalletBalanceAlerted(ctx, rec.ID); err != nil {
+			logging.WithError(err, log).Error("recording biller wallet balance alert")
+		}
+	}
+	return nil
+}
+
+// notify sends the alert to the notifiers and reports whether it was
+// delivered to at least one of them. Without notifiers the log is the alert.
+func (s *Svc) notify(ctx context.Context, a Alert) bool {
+	log := logging.FromContext(ctx).WithField("partner", a.Partner)
+	log.WithField("balance", a.Balance.String()).Warn("biller wallet balance below threshold")
+	delivered := len(s.notifiers) == 0
+	for _, n := range s.notifiers {
+		if err := n.Notify(ctx, a); err != nil {
+			logging.WithError(err, log).Error("sending biller wallet balance alert")
+			continue
+		}
+		delivered = true
+	}
+	return delivered
+}
+
+// Balances returns the latest balance of each biller and the samples in the
+// filter.
+func (s *Svc) Balances(ctx context.Context, f storage.BillerWalletBalanceFilter) ([]storage.BillerWalletBalance, []storage.BillerWalletBalance, error) {
+	ls, err := s.st.LatestBillerWalletBalances(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(ls, func(i, j int) bool { return ls[i].Partner < ls[j].Partner })
+	hs, err := s.st.ListBillerWalletBalance(ctx, f)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ls, hs, nil
+}
+
+func minor(n int64) currency.Minor {
+	m, _ := currency.NewMinor(fmt.Sprint(n), phpCurrency)
+	return m
+}
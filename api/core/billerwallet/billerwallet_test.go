@@ -0,0 +1,187 @@
+package billerwallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"brank.as/petnet/api/core/static"
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+)
+
+type fakeStore struct {
+	Store
+	rows []storage.BillerWalletBalance
+}
+
+func (f *fakeStore) CreateBillerWalletBalance(_ context.Context, b storage.BillerWalletBalance) (*storage.BillerWalletBalance, error) {
+	b.ID = fmt.Sprint(len(f.rows))
+	b.Created = time.Now()
+	f.rows = append(f.rows, b)
+	return &b, nil
+}
+
+func (f *fakeStore) SetBillerWalletBalanceAlerted(_ context.Context, id string) error {
+	for i := range f.rows {
+		if f.rows[i].ID == id {
+			f.rows[i].Alerted = true
+			return nil
+		}
+	}
+	return storage.ErrNotFound
+}
+
+func (f *fakeStore) LatestBillerWalletBalances(context.Context) ([]storage.BillerWalletBalance, error) {
+	l := map[string]storage.BillerWalletBalance{}
+	for _, r := range f.rows {
+		if r.Error == "" {
+			l[r.Partner] = r
+		}
+	}
+	var rs []storage.BillerWalletBalance
+	for _, r := range l {
+		rs = append(rs, r)
+	}
+	return rs, nil
+}
+
+type fakeClient struct {
+	bc, ec string
+	ecErr  error
+}
+
+func (f *fakeClient) BCGetWalletBalance(context.Context) (*bpi.BCGetWalletBalanceResponse, error) {
+	return &bpi.BCGetWalletBalanceResponse{Result: bpi.BCGetWalletBalanceResult{Balance: f.bc}}, nil
+}
+
+func (f *fakeClient) BillsPaymentEcpayCheckBalance(context.Context) (*bpi.BillsPaymentEcpayCheckBalanceResponseBody, error) {
+	if f.ecErr != nil {
+		return nil, f.ecErr
+	}
+	return &bpi.BillsPaymentEcpayCheckBalanceResponseBody{Result: bpi.BillsPaymentEcpayCheckBalanceResult{RemBal: f.ec}}, nil
+}
+
+type fakeNotifier struct {
+	alerts []Alert
+	err    error
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, a Alert) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.alerts = append(f.alerts, a)
+	return nil
+}
+
+func TestPoll(t *testing.T) {
+	ctx := context.Background()
+	st := &fakeStore{}
+	cl := &fakeClient{bc: "10000.00", ec: "500.00"}
+	n := &fakeNotifier{}
+	s := New(st, cl,
+		WithThreshold(100000),
+		WithPartnerThreshold(static.BYCBP, 5000000),
+		WithNotifiers(n),
+	)
+
+	if err := s.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.rows) != 2 {
+		t.Fatalf("want 2 samples, got %d", len(st.rows))
+	}
+	bc, ec := st.rows[0], st.rows[1]
+	if bc.Balance != 1000000 || bc.Threshold != 5000000 || !bc.Low {
+		t.Errorf("unexpected bayad center sample: %+v", bc)
+	}
+	if ec.Balance != 50000 || ec.Threshold != 100000 || !ec.Low {
+		t.Errorf("unexpected ecpay sample: %+v", ec)
+	}
+	if len(n.alerts) != 2 || n.alerts[0].Balance.String() != "10000.00 PHP" {
+		t.Fatalf("want 2 alerts, got %+v", n.alerts)
+	}
+
+	// still low, no new alerts
+	if err := s.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(n.alerts) != 2 {
+		t.Fatalf("want no new alerts, got %d", len(n.alerts)-2)
+	}
+
+	// ecpay recovers and a failed poll does not reset the state
+	cl.ec = "2000.00"
+	if err := s.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cl.ecErr = errors.New("timeout")
+	if err := s.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if got := st.rows[len(st.rows)-1]; got.Error != "timeout" || got.Low {
+		t.Errorf("unexpected failed sample: %+v", got)
+	}
+	cl.ec, cl.ecErr = "100.00", nil
+	if err := s.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(n.alerts) != 3 || n.alerts[2].Partner != static.ECPBP {
+		t.Fatalf("want ecpay alert after drop, got %+v", n.alerts)
+	}
+}
+
+func TestPollUndelivered(t *testing.T) {
+	ctx := context.Background()
+	st := &fakeStore{}
+	cl := &fakeClient{bc: "10000.00", ec: "500.00"}
+	n := &fakeNotifier{err: errors.New("webhook down")}
+	s := New(st, cl, WithThreshold(100000), WithNotifiers(n))
+
+	if err := s.Poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range st.rows {
+		if r.Low && r.Alerted {
+			t.Errorf("undelivered alert recorded: %+v", r)
+		}
+	}
+
+	// the alert is resent until it is delivered, then not again
+	n.err = nil
+	for i := 0; i < 2; i++ {
+		if err := s.Poll(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(n.alerts) != 1 || n.alerts[0].Partner != static.ECPBP {
+		t.Fatalf("want 1 ecpay alert, got %+v", n.alerts)
+	}
+	if got := st.rows[len(st.rows)-1]; !got.Alerted {
+		t.Errorf("want alerted sample, got %+v", got)
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var got webhookAlert
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	a := Alert{Partner: static.ECPBP, Balance: minor(50000), Threshold: minor(100000), Checked: time.Now()}
+	if err := NewWebhookNotifier(srv.URL, 0).Notify(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	if got.Partner != static.ECPBP || got.Balance != "500.00" || got.Threshold != "1000.00" || got.Currency != "PHP" {
+		t.Errorf("unexpected webhook payload: %+v", got)
+	}
+}
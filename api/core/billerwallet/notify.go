@@ -0,0 +1,85 @@
+package billerwallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	epb "brank.as/petnet/gunk/dsa/v1/email"
+)
+
+// EmailNotifier sends alerts by email through the profile mailer.
+type EmailNotifier struct {
+	cl epb.EmailServiceClient
+	to []string
+}
+
+func NewEmailNotifier(cl epb.EmailServiceClient, to []string) *EmailNotifier {
+	return &EmailNotifier{cl: cl, to: to}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, a Alert) error {
+	_, err := n.cl.SendBillerBalanceAlert(ctx, &epb.SendBillerBalanceAlertRequest{
+		Emails:    n.to,
+		Partner:   a.Partner,
+		Balance:   a.Balance.String(),
+		Threshold: a.Threshold.String(),
+		Checked:   timestamppb.New(a.Checked),
+	})
+	return err
+}
+
+// WebhookNotifier posts alerts as JSON to a URL.
+type WebhookNotifier struct {
+	url string
+	cl  *http.Client
+}
+
+func NewWebhookNotifier(url string, timeout time.Duration) *WebhookNotifier {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return &WebhookNotifier{url: url, cl: &http.Client{Timeout: timeout}}
+}
+
+type webhookAlert struct {
+	Event     string    `json:"event"`
+	Partner   string    `json:"partner"`
+	Balance   string    `json:"balance"`
+	Threshold string    `json:"threshold"`
+	Currency  string    `json:"currency"`
+	Checked   time.Time `json:"checked"`
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, a Alert) error {
+	b, err := json.Marshal(webhookAlert{
+		Event:     "biller_wallet_balance_low",
+		Partner:   a.Partner,
+		Balance:   a.Balance.ToAmount().Number(),
+		Threshold: a.Threshold.ToAmount().Number(),
+		Currency:  a.Balance.CurrencyCode(),
+		Checked:   a.Checked,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := n.cl.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook responded %s", res.Status)
+	}
+	return nil
+}
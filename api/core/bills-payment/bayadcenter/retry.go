@@ -0,0 +1,92 @@
+package bayadcenter
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/bojanz/currency"
+
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// Retry resends a recorded bayad center payment. Perahub expects the original
+// transact payload along with the id of the failed transaction, so it is
+// rebuilt from the history row.
+func (s *Svc) Retry(ctx context.Context, b *storage.BillPayment, id int) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx).WithField("method", "bills-payment.bayadcenter.retry")
+	var bls storage.Bills
+	if len(b.Bills) > 0 {
+		if err := json.Unmarshal(b.Bills, &bls); err != nil {
+			logging.WithError(err, log).Error("unmarshal bills")
+		}
+	}
+	var oi storage.BillsOtherInfo
+	if len(b.OtherInfo) > 0 {
+		if err := json.Unmarshal(b.OtherInfo, &oi); err != nil {
+			logging.WithError(err, log).Error("unmarshal other info")
+		}
+	}
+	var amt currency.Minor
+	if err := json.Unmarshal(b.Amount, &amt); err != nil {
+		logging.WithError(err, log).Error("unmarshal amount")
+	}
+	amount := amt.Number()
+	charge := bls.Result.ServiceCharge.Number()
+	a, _ := strconv.Atoi(amount)
+	c, _ := strconv.Atoi(charge)
+	custID, _ := strconv.Atoi(b.CustomerID)
+
+	rs, err := s.billAcc.BCRetry(ctx, bpi.BCRetryRequest{
+		ID:                      id,
+		UserID:                  b.UserID,
+		CustomerID:              custID,
+		LocationID:              b.LocationID,
+		LocationName:            b.LocationName,
+		Coy:                     b.Coy,
+		BillID:                  strconv.Itoa(int(b.BillID)),
+		BillerTag:               b.BillerTag,
+		BillerName:              b.BillerName,
+		TrxDate:                 b.TrxDate.Format("2006-01-02"),
+		Amount:                  amount,
+		ServiceCharge:           charge,
+		PartnerCharge:           b.PartnerCharge,
+		TotalAmount:             a + c,
+		Identifier:              b.Identifier,
+		AccountNumber:           b.AccountNumber,
+		PaymentMethod:           b.PaymentMethod,
+		ClientReferenceNumber:   b.ClientRefNumber,
+		ReferenceNumber:         b.ReferenceNumber,
+		ValidationNumber:        b.ValidationNumber,
+		ReceiptValidationNumber: b.ReceiptValidationNumber,
+		TpaID:                   b.TpaID,
+		CurrencyID:              b.CurrencyID,
+		FormType:                b.FormType,
+		FormNumber:              b.FormNumber,
+		OtherInfo: bpi.BCOtherInfo{
+			LastName:        oi.LastName,
+			FirstName:       oi.FirstName,
+			MiddleName:      oi.MiddleName,
+			PaymentType:     oi.PaymentType,
+			Course:          oi.Course,
+			TotalAssessment: oi.TotalAssessment,
+			SchoolYear:      oi.SchoolYear,
+			Term:            oi.Term,
+		},
+		Type: b.Type,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("Bills payment bayadcenter retry failed.")
+		return nil, handleBayadcenterError(err)
+	}
+
+	return &bp.BPTransactionStatus{
+		ReferenceNumber: rs.Result.ReferenceNumber,
+		Status:          string(storage.SuccessStatus),
+		PartnerStatus:   rs.Result.Status,
+		Message:         rs.Result.Message,
+	}, nil
+}
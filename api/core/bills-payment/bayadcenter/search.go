@@ -0,0 +1,36 @@
+package bayadcenter
+
+import (
+	"context"
+	"strings"
+
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) Search(ctx context.Context, b *storage.BillPayment) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx).WithField("method", "bills-payment.bayadcenter.search")
+	rs, err := s.billAcc.BCTransactInquire(ctx, bpi.BCTransactInquireRequest{
+		Code:            b.BillerTag,
+		ClientReference: b.ClientRefNumber,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("Bills payment bayadcenter search failed.")
+		return nil, handleBayadcenterError(err)
+	}
+
+	res := &bp.BPTransactionStatus{
+		ReferenceNumber: rs.Result.ReferenceNumber,
+		PartnerStatus:   rs.Result.Status,
+		Message:         rs.Result.Message.Message,
+	}
+	switch strings.ToUpper(rs.Result.Status) {
+	case "SUCCESS", "PAID", "POSTED":
+		res.Status = string(storage.SuccessStatus)
+	case "FAILED", "FAIL", "CANCELLED":
+		res.Status = string(storage.FailStatus)
+	}
+	return res, nil
+}
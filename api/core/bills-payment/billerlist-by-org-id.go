@@ -19,6 +19,10 @@ func (s *Svc) BillsPaymentTransactList(ctx context.Context, r *bp.BillsPaymentTr
 	if err != nil {
 		dateUntil = time.Now()
 	}
+	after, err := storage.ParseCursor(r.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
 
 	res, err := s.st.ListBillPayment(ctx, storage.BillPaymentFilter{
 		BillPaymentStatus: string(storage.SuccessStatus),
@@ -31,6 +35,9 @@ func (s *Svc) BillsPaymentTransactList(ctx context.Context, r *bp.BillsPaymentTr
 		OrgID:             r.GetOrgID(),
 		ReferenceNumber:   r.GetReferenceNumber(),
 		ExcludePartners:   r.GetExcludePartners(),
+		BranchID:          r.GetBranchID(),
+		TellerID:          r.GetTellerID(),
+		After:             after,
 	})
 	if err != nil {
 		return nil, err
@@ -53,12 +60,19 @@ func (s *Svc) BillsPaymentTransactList(ctx context.Context, r *bp.BillsPaymentTr
 				Currency: "PHP",
 			},
 			TransactionCompletedTime: timestamppb.New(v.TrxDate),
+			BranchID:                 v.BranchID,
+			TellerID:                 v.TellerID,
 		})
 	}
 
+	last := res[len(res)-1]
 	return &bp.BillsPaymentTransactListResponse{
 		Next:          r.Offset + int32(len(bills)),
 		BillsPayments: bills,
 		Total:         int32(res[0].Total),
+		NextPageToken: storage.NextPageToken(r.GetSortByColumn().String(), int(r.GetLimit()), len(res), storage.Cursor{
+			Created: last.Created,
+			ID:      last.BillPaymentID,
+		}),
 	}, nil
 }
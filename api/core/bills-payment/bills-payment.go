@@ -17,8 +17,27 @@ type Biller interface {
 	Kind() string
 }
 
+// Retrier is implemented by billers that can resend a failed bill payment.
+type Retrier interface {
+	Retry(ctx context.Context, b *storage.BillPayment, id int) (*bp.BPTransactionStatus, error)
+}
+
+// Voider is implemented by billers that can void a bill payment.
+type Voider interface {
+	Void(ctx context.Context, b *storage.BillPayment) (*bp.BPTransactionStatus, error)
+}
+
+// Searcher is implemented by billers that can look up the status of a bill
+// payment.
+type Searcher interface {
+	Search(ctx context.Context, b *storage.BillPayment) (*bp.BPTransactionStatus, error)
+}
+
 type BillerStore interface {
 	ListBillPayment(ctx context.Context, f storage.BillPaymentFilter) ([]storage.BillPayment, error)
+	GetBillPayment(ctx context.Context, billPaymentID string) (*storage.BillPayment, error)
+	UpdateBillPayment(ctx context.Context, r storage.BillPayment) (*storage.BillPayment, error)
+	SetBillPaymentStatus(ctx context.Context, billPaymentID string, old, new storage.TxnStatus) error
 }
 
 type Svc struct {
@@ -0,0 +1,28 @@
+package ecpay
+
+import (
+	"context"
+
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) Retry(ctx context.Context, b *storage.BillPayment, id int) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx).WithField("method", "bills-payment.ecpay.retry")
+	rs, err := s.billAcc.BillsPaymentEcpayRetry(ctx, bpi.BillsPaymentEcpayRetryRequest{
+		ID: id,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("Bills payment ecpay retry failed.")
+		return nil, handleEcpayError(err)
+	}
+
+	return &bp.BPTransactionStatus{
+		ReferenceNumber: rs.Result.ReferenceNumber,
+		Status:          string(storage.SuccessStatus),
+		PartnerStatus:   rs.Result.Status,
+		Message:         rs.Result.Message,
+	}, nil
+}
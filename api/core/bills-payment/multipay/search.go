@@ -0,0 +1,37 @@
+package multipay
+
+import (
+	"context"
+
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) Search(ctx context.Context, b *storage.BillPayment) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx).WithField("method", "bills-payment.multipay.search")
+	rs, err := s.billAcc.BillsPaymentMultiPaySearchTrx(ctx, bpi.BillsPaymentMultiPaySearchTrxRequest{
+		ReferenceNo: b.ReferenceNumber,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("Bills payment multipay search failed.")
+		return nil, handleMultipayError(err)
+	}
+
+	res := &bp.BPTransactionStatus{
+		ReferenceNumber: rs.Result.Refno,
+		PartnerStatus:   rs.Result.Status,
+		Message:         rs.Message,
+	}
+	// multipay reports "V" for voided and "S" for settled transactions.
+	switch {
+	case rs.Result.Status == "V":
+		res.Status = string(storage.VoidStatus)
+	case rs.Result.Status == "S":
+		res.Status = string(storage.SuccessStatus)
+	case rs.Result.IsTransactionExpired:
+		res.Status = string(storage.FailStatus)
+	}
+	return res, nil
+}
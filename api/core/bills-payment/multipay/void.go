@@ -0,0 +1,27 @@
+package multipay
+
+import (
+	"context"
+
+	bpi "brank.as/petnet/api/integration/bills-payment"
+	"brank.as/petnet/api/storage"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) Void(ctx context.Context, b *storage.BillPayment) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx).WithField("method", "bills-payment.multipay.void")
+	rs, err := s.billAcc.BillsPaymentMultiPayVoidTrx(ctx, bpi.BillsPaymentMultiPayVoidTrxRequest{
+		ReferenceNo: b.ReferenceNumber,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("Bills payment multipay void failed.")
+		return nil, handleMultipayError(err)
+	}
+
+	return &bp.BPTransactionStatus{
+		ReferenceNumber: rs.Data.Refno,
+		Status:          string(storage.VoidStatus),
+		PartnerStatus:   rs.Data.Status,
+	}, nil
+}
@@ -0,0 +1,210 @@
+package bills_payment
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+
+	coreerror "brank.as/petnet/api/core/error"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/api/util"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/auth/hydra"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// BPRetry resends a failed bill payment to its biller. The row is claimed
+// before the biller is called so concurrent retries cannot pay twice.
+func (s *Svc) BPRetry(ctx context.Context, r *bp.BPRetryRequest) (*bp.BPTransactionStatus, error) {
+	b, bl, err := s.billPayment(ctx, r.GetBillPaymentID(), r.GetPartner())
+	if err != nil {
+		return nil, err
+	}
+	rt, ok := bl.(Retrier)
+	if !ok {
+		return nil, coreerror.NewCoreError(codes.Unimplemented, "biller does not support retry")
+	}
+	if b.BillPaymentStatus != string(storage.FailStatus) {
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "only failed bill payments can be retried")
+	}
+	if err := s.claim(ctx, b); err != nil {
+		return nil, err
+	}
+	res, err := rt.Retry(ctx, b, int(r.GetID()))
+	if err != nil {
+		s.release(ctx, b, err)
+		return nil, err
+	}
+	return s.recordStatus(ctx, b, res)
+}
+
+// BPVoid voids a recorded bill payment with its biller. The row is claimed
+// before the biller is called so it cannot be voided and retried at once.
+func (s *Svc) BPVoid(ctx context.Context, r *bp.BPVoidRequest) (*bp.BPTransactionStatus, error) {
+	b, bl, err := s.billPayment(ctx, r.GetBillPaymentID(), r.GetPartner())
+	if err != nil {
+		return nil, err
+	}
+	v, ok := bl.(Voider)
+	if !ok {
+		return nil, coreerror.NewCoreError(codes.Unimplemented, "biller does not support void")
+	}
+	switch storage.TxnStatus(b.BillPaymentStatus) {
+	case storage.SuccessStatus, storage.FailStatus:
+	default:
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "only successful or failed bill payments can be voided")
+	}
+	if err := s.claim(ctx, b); err != nil {
+		return nil, err
+	}
+	res, err := v.Void(ctx, b)
+	if err != nil {
+		s.release(ctx, b, err)
+		return nil, err
+	}
+	return s.recordStatus(ctx, b, res)
+}
+
+// claim marks the bill payment pending while it is sent to the biller, it
+// fails when the row changed since it was loaded.
+func (s *Svc) claim(ctx context.Context, b *storage.BillPayment) error {
+	log := logging.FromContext(ctx)
+	if err := s.st.SetBillPaymentStatus(ctx, b.BillPaymentID, storage.TxnStatus(b.BillPaymentStatus), storage.PendingStatus); err != nil {
+		if err == storage.ErrNotFound {
+			return coreerror.NewCoreError(codes.FailedPrecondition, "bill payment is already being processed")
+		}
+		logging.WithError(err, log).Error("claim bill payment")
+		return coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	return nil
+}
+
+// release ends the claim after the biller call failed. Only a rejection by the
+// biller restores the status so the request can be made again, the outcome of
+// an unanswered request is unknown and left for review.
+func (s *Svc) release(ctx context.Context, b *storage.BillPayment, err error) {
+	log := logging.FromContext(ctx)
+	sts := storage.ReviewStatus
+	if rejected(err) {
+		sts = storage.TxnStatus(b.BillPaymentStatus)
+	}
+	if err := s.st.SetBillPaymentStatus(util.Detach(ctx), b.BillPaymentID, storage.PendingStatus, sts); err != nil {
+		logging.WithError(err, log).WithField("status", sts).Error("release bill payment")
+	}
+}
+
+// rejected reports whether the error is a response of the biller refusing the
+// request, as opposed to a failure to reach it or to read its response.
+func rejected(err error) bool {
+	switch coreerror.ToCoreError(err).Code {
+	case codes.Internal, codes.Unknown, codes.Unavailable, codes.DeadlineExceeded, codes.Canceled, codes.OutOfRange:
+		return false
+	}
+	return true
+}
+
+// BPSearch looks up a recorded bill payment with its biller and records the
+// status reported.
+func (s *Svc) BPSearch(ctx context.Context, r *bp.BPSearchRequest) (*bp.BPTransactionStatus, error) {
+	b, bl, err := s.billPayment(ctx, r.GetBillPaymentID(), r.GetPartner())
+	if err != nil {
+		return nil, err
+	}
+	sr, ok := bl.(Searcher)
+	if !ok {
+		return nil, coreerror.NewCoreError(codes.Unimplemented, "biller does not support search")
+	}
+	res, err := sr.Search(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordStatus(ctx, b, res)
+}
+
+// billPayment loads the history row and the biller it was paid through. Rows
+// of other organizations or of another biller than the partner requested are
+// reported as not found, only the admin platform may act on rows without
+// naming an org.
+func (s *Svc) billPayment(ctx context.Context, id, partner string) (*storage.BillPayment, Biller, error) {
+	log := logging.FromContext(ctx)
+	b, err := s.st.GetBillPayment(ctx, id)
+	if err != nil {
+		logging.WithError(err, log).Error("get bill payment")
+		return nil, nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	if b.BillPaymentID == "" || b.PartnerID != partner {
+		return nil, nil, coreerror.NewCoreError(codes.NotFound, "bill payment not found")
+	}
+	org := getDSAOrgID(ctx)
+	switch {
+	case org == "" && phmw.GetOrgType(ctx) == ppb.OrgType_PetNet.String():
+	case org == "" || b.OrgID != org:
+		return nil, nil, coreerror.NewCoreError(codes.NotFound, "bill payment not found")
+	}
+	bl, ok := s.billers[b.PartnerID]
+	if !ok {
+		return nil, nil, coreerror.NewCoreError(codes.FailedPrecondition, coreerror.MsgPartnerDoesntExist)
+	}
+	return b, bl, nil
+}
+
+func getDSAOrgID(ctx context.Context) string {
+	switch phmw.GetOrgType(ctx) {
+	case ppb.OrgType_PetNet.String(), ppb.OrgType_DSA.String():
+		return phmw.GetDSAOrgID(ctx)
+	}
+	return hydra.OrgID(ctx)
+}
+
+// recordStatus updates the history row with the biller response. A response
+// without a status leaves the recorded status unchanged.
+func (s *Svc) recordStatus(ctx context.Context, b *storage.BillPayment, res *bp.BPTransactionStatus) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx)
+	// bills is patched as a plain object; rows of failed payments carry empty
+	// amounts that do not decode into storage.Bills.
+	bls := map[string]interface{}{}
+	if len(b.Bills) > 0 {
+		if err := json.Unmarshal(b.Bills, &bls); err != nil {
+			logging.WithError(err, log).Error("unmarshal bills")
+		}
+	}
+	rs, _ := bls["result"].(map[string]interface{})
+	if rs == nil {
+		rs = map[string]interface{}{}
+	}
+	rs["status"] = res.GetPartnerStatus()
+	if res.GetMessage() != "" {
+		rs["message"] = res.GetMessage()
+	}
+	if res.GetReferenceNumber() != "" {
+		rs["reference_number"] = res.GetReferenceNumber()
+	}
+	bls["result"] = rs
+	bj, err := json.Marshal(bls)
+	if err != nil {
+		logging.WithError(err, log).Error("marshal bills")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDRPInternalError)
+	}
+	b.Bills = bj
+	if res.GetStatus() != "" {
+		b.BillPaymentStatus = res.GetStatus()
+	}
+	if b.BillPaymentStatus != string(storage.FailStatus) {
+		b.ErrorCode, b.ErrorMsg, b.ErrorType = "", "", ""
+	}
+	if _, err := s.st.UpdateBillPayment(ctx, *b); err != nil {
+		logging.WithError(err, log).Error("update bill payment")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+
+	res.BillPaymentID = b.BillPaymentID
+	res.Partner = b.PartnerID
+	res.Status = b.BillPaymentStatus
+	if res.ReferenceNumber == "" {
+		res.ReferenceNumber = b.ReferenceNumber
+	}
+	return res, nil
+}
@@ -0,0 +1,387 @@
+package bills_payment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+
+	coreerror "brank.as/petnet/api/core/error"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/auth/hydra"
+)
+
+type fakeStore struct {
+	BillerStore
+	rows    map[string]storage.BillPayment
+	updated []storage.BillPayment
+	claimed bool
+}
+
+func (f *fakeStore) GetBillPayment(_ context.Context, id string) (*storage.BillPayment, error) {
+	r := f.rows[id]
+	return &r, nil
+}
+
+func (f *fakeStore) UpdateBillPayment(_ context.Context, r storage.BillPayment) (*storage.BillPayment, error) {
+	f.updated = append(f.updated, r)
+	f.rows[r.BillPaymentID] = r
+	return &r, nil
+}
+
+func (f *fakeStore) SetBillPaymentStatus(_ context.Context, id string, old, new storage.TxnStatus) error {
+	r, ok := f.rows[id]
+	if !ok || r.BillPaymentStatus != string(old) || f.claimed {
+		return storage.ErrNotFound
+	}
+	r.BillPaymentStatus = string(new)
+	f.rows[id] = r
+	return nil
+}
+
+type fakeBiller struct {
+	Biller
+	res *bp.BPTransactionStatus
+	err error
+}
+
+func (fakeBiller) Kind() string { return "MLP" }
+
+func (f fakeBiller) Void(context.Context, *storage.BillPayment) (*bp.BPTransactionStatus, error) {
+	return f.res, f.err
+}
+
+func (f fakeBiller) Search(context.Context, *storage.BillPayment) (*bp.BPTransactionStatus, error) {
+	return f.res, f.err
+}
+
+func (f fakeBiller) Retry(context.Context, *storage.BillPayment, int) (*bp.BPTransactionStatus, error) {
+	return f.res, f.err
+}
+
+// voidOnly is a biller without retry or search support.
+type voidOnly struct{ Biller }
+
+func (voidOnly) Kind() string { return "MLP" }
+
+func (voidOnly) Void(context.Context, *storage.BillPayment) (*bp.BPTransactionStatus, error) {
+	return &bp.BPTransactionStatus{}, nil
+}
+
+func TestBPRecover(t *testing.T) {
+	const id = "8f1c2a77-0d2e-4c41-9a5c-0f0c8b0c1b6e"
+	row := storage.BillPayment{
+		BillPaymentID:     id,
+		OrgID:             "org-1",
+		PartnerID:         "MLP",
+		BillPaymentStatus: string(storage.FailStatus),
+		ReferenceNumber:   "REF1",
+		ErrorMsg:          "timeout",
+		Bills:             []byte(`{"code":"200","result":{"status":"P"}}`),
+	}
+	ctx := metautils.ExtractIncoming(context.Background()).Set(hydra.OrgIDKey, "org-1").ToIncoming(context.Background())
+
+	t.Run("Void", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		s, err := New([]Biller{fakeBiller{res: &bp.BPTransactionStatus{
+			Status:        string(storage.VoidStatus),
+			PartnerStatus: "V",
+		}}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := s.BPVoid(ctx, &bp.BPVoidRequest{BillPaymentID: id, Partner: "MLP"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetStatus() != string(storage.VoidStatus) || res.GetPartner() != "MLP" || res.GetReferenceNumber() != "REF1" {
+			t.Errorf("unexpected response: %v", res)
+		}
+		if len(st.updated) != 1 {
+			t.Fatalf("want 1 update, got %d", len(st.updated))
+		}
+		u := st.updated[0]
+		if u.BillPaymentStatus != string(storage.VoidStatus) || u.ErrorMsg != "" {
+			t.Errorf("unexpected row: %+v", u)
+		}
+		var bls struct {
+			Code   string `json:"code"`
+			Result struct {
+				Status string `json:"status"`
+			} `json:"result"`
+		}
+		if err := json.Unmarshal(u.Bills, &bls); err != nil {
+			t.Fatal(err)
+		}
+		if bls.Result.Status != "V" || bls.Code != "200" {
+			t.Errorf("unexpected bills: %+v", bls)
+		}
+	})
+
+	t.Run("SearchPending", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		s, err := New([]Biller{fakeBiller{res: &bp.BPTransactionStatus{PartnerStatus: "P"}}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := s.BPSearch(ctx, &bp.BPSearchRequest{BillPaymentID: id, Partner: "MLP"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetStatus() != string(storage.FailStatus) || st.updated[0].ErrorMsg != "timeout" {
+			t.Errorf("status should be unchanged: %v %+v", res, st.updated[0])
+		}
+	})
+
+	t.Run("BillerError", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		want := coreerror.NewCoreError(codes.Unimplemented, "not supported")
+		s, err := New([]Biller{fakeBiller{err: want}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.BPVoid(ctx, &bp.BPVoidRequest{BillPaymentID: id, Partner: "MLP"}); !errors.Is(err, want) {
+			t.Errorf("want %v, got %v", want, err)
+		}
+		if len(st.updated) != 0 {
+			t.Error("row should not be updated on biller error")
+		}
+		if got := st.rows[id].BillPaymentStatus; got != string(storage.FailStatus) {
+			t.Errorf("claim not released, status %s", got)
+		}
+	})
+
+	t.Run("VoidPending", func(t *testing.T) {
+		r := row
+		r.BillPaymentStatus = string(storage.PendingStatus)
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: r}}
+		s, err := New([]Biller{fakeBiller{err: errors.New("biller called")}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPVoid(ctx, &bp.BPVoidRequest{BillPaymentID: id, Partner: "MLP"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.FailedPrecondition {
+			t.Errorf("want failed precondition, got %v", err)
+		}
+	})
+
+	t.Run("VoidClaimed", func(t *testing.T) {
+		// a retry claims the row after it was loaded
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}, claimed: true}
+		s, err := New([]Biller{fakeBiller{err: errors.New("biller called")}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPVoid(ctx, &bp.BPVoidRequest{BillPaymentID: id, Partner: "MLP"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.FailedPrecondition {
+			t.Errorf("want failed precondition, got %v", err)
+		}
+	})
+
+	t.Run("VoidUnanswered", func(t *testing.T) {
+		r := row
+		r.BillPaymentStatus = string(storage.SuccessStatus)
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: r}}
+		want := coreerror.NewCoreError(codes.Unavailable, "biller down")
+		s, err := New([]Biller{fakeBiller{err: want}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.BPVoid(ctx, &bp.BPVoidRequest{BillPaymentID: id, Partner: "MLP"}); !errors.Is(err, want) {
+			t.Errorf("want %v, got %v", want, err)
+		}
+		if got := st.rows[id].BillPaymentStatus; got != string(storage.ReviewStatus) {
+			t.Errorf("want status %s, got %s", storage.ReviewStatus, got)
+		}
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		s, err := New([]Biller{fakeBiller{res: &bp.BPTransactionStatus{
+			Status:        string(storage.SuccessStatus),
+			PartnerStatus: "S",
+		}}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := s.BPRetry(ctx, &bp.BPRetryRequest{BillPaymentID: id, Partner: "MLP"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.GetStatus() != string(storage.SuccessStatus) {
+			t.Errorf("unexpected response: %v", res)
+		}
+		if got := st.rows[id].BillPaymentStatus; got != string(storage.SuccessStatus) {
+			t.Errorf("want status %s, got %s", storage.SuccessStatus, got)
+		}
+	})
+
+	t.Run("RetryNotFailed", func(t *testing.T) {
+		r := row
+		r.BillPaymentStatus = string(storage.SuccessStatus)
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: r}}
+		s, err := New([]Biller{fakeBiller{res: &bp.BPTransactionStatus{}}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPRetry(ctx, &bp.BPRetryRequest{BillPaymentID: id, Partner: "MLP"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.FailedPrecondition {
+			t.Errorf("want failed precondition, got %v", err)
+		}
+		if len(st.updated) != 0 {
+			t.Error("row should not be updated")
+		}
+	})
+
+	t.Run("RetryClaimed", func(t *testing.T) {
+		// another retry claims the row after it was loaded
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}, claimed: true}
+		s, err := New([]Biller{fakeBiller{err: errors.New("biller called")}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPRetry(ctx, &bp.BPRetryRequest{BillPaymentID: id, Partner: "MLP"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.FailedPrecondition {
+			t.Errorf("want failed precondition, got %v", err)
+		}
+	})
+
+	t.Run("RetryError", func(t *testing.T) {
+		tests := []struct {
+			desc   string
+			err    error
+			status storage.TxnStatus
+		}{
+			{
+				desc:   "Rejected",
+				err:    coreerror.NewCoreError(codes.InvalidArgument, "invalid account number"),
+				status: storage.FailStatus,
+			},
+			{
+				desc:   "Unavailable",
+				err:    coreerror.NewCoreError(codes.Unavailable, "biller down"),
+				status: storage.ReviewStatus,
+			},
+			{
+				desc:   "Transport",
+				err:    errors.New("connection reset by peer"),
+				status: storage.ReviewStatus,
+			},
+		}
+		for _, test := range tests {
+			test := test
+			t.Run(test.desc, func(t *testing.T) {
+				st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+				s, err := New([]Biller{fakeBiller{err: test.err}}, nil, st)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := s.BPRetry(ctx, &bp.BPRetryRequest{BillPaymentID: id, Partner: "MLP"}); !errors.Is(err, test.err) {
+					t.Errorf("want %v, got %v", test.err, err)
+				}
+				if got := st.rows[id].BillPaymentStatus; got != string(test.status) {
+					t.Errorf("want status %s, got %s", test.status, got)
+				}
+			})
+		}
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		s, err := New([]Biller{voidOnly{}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPRetry(ctx, &bp.BPRetryRequest{BillPaymentID: id, Partner: "MLP"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.Unimplemented {
+			t.Errorf("want unimplemented, got %v", err)
+		}
+		if got := st.rows[id].BillPaymentStatus; got != string(storage.FailStatus) {
+			t.Errorf("row should not be claimed, status %s", got)
+		}
+	})
+
+	t.Run("Org", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		s, err := New([]Biller{fakeBiller{res: &bp.BPTransactionStatus{}}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		md := func() metautils.NiceMD { return metautils.ExtractIncoming(context.Background()) }
+		tests := []struct {
+			desc string
+			ctx  context.Context
+			want codes.Code
+		}{
+			{
+				desc: "Other",
+				ctx:  md().Set(hydra.OrgIDKey, "org-2").ToIncoming(context.Background()),
+				want: codes.NotFound,
+			},
+			{
+				desc: "Missing",
+				ctx:  context.Background(),
+				want: codes.NotFound,
+			},
+			{
+				desc: "MissingDSA",
+				ctx:  md().Set(phmw.OrgType, ppb.OrgType_DSA.String()).ToIncoming(context.Background()),
+				want: codes.NotFound,
+			},
+			{
+				desc: "Admin",
+				ctx:  md().Set(phmw.OrgType, ppb.OrgType_PetNet.String()).ToIncoming(context.Background()),
+				want: codes.OK,
+			},
+		}
+		for _, test := range tests {
+			test := test
+			t.Run(test.desc, func(t *testing.T) {
+				_, err := s.BPSearch(test.ctx, &bp.BPSearchRequest{BillPaymentID: id, Partner: "MLP"})
+				var cErr *coreerror.Error
+				switch {
+				case test.want == codes.OK && err != nil:
+					t.Errorf("want no error, got %v", err)
+				case test.want != codes.OK && (!errors.As(err, &cErr) || cErr.Code != test.want):
+					t.Errorf("want %v, got %v", test.want, err)
+				}
+			})
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		s, err := New([]Biller{fakeBiller{}}, nil, &fakeStore{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPSearch(ctx, &bp.BPSearchRequest{BillPaymentID: id, Partner: "MLP"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.NotFound {
+			t.Errorf("want not found, got %v", err)
+		}
+	})
+
+	t.Run("OtherPartner", func(t *testing.T) {
+		st := &fakeStore{rows: map[string]storage.BillPayment{id: row}}
+		s, err := New([]Biller{fakeBiller{}}, nil, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = s.BPSearch(ctx, &bp.BPSearchRequest{BillPaymentID: id, Partner: "ECPAY"})
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.NotFound {
+			t.Errorf("want not found, got %v", err)
+		}
+	})
+}
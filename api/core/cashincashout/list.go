@@ -26,6 +26,10 @@ func (s *Svc) CICOTransactList(ctx context.Context, f *cbp.CICOTransactListReque
 	if err != nil {
 		dateUntil = time.Now()
 	}
+	after, err := storage.ParseCursor(f.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
 
 	fl := storage.CashInCashOutTrxListFilter{
 		From:             dateFrom,
@@ -37,6 +41,9 @@ func (s *Svc) CICOTransactList(ctx context.Context, f *cbp.CICOTransactListReque
 		ReferenceNumber:  f.GetReferenceNumber(),
 		ExcludeProviders: f.GetExcludeProviders(),
 		OrgID:            f.GetOrgID(),
+		BranchID:         f.GetBranchID(),
+		TellerID:         f.GetTellerID(),
+		After:            after,
 	}
 
 	rhs, err := s.st.ListCICOTrx(ctx, fl)
@@ -63,6 +70,8 @@ func (s *Svc) CICOTransactList(ctx context.Context, f *cbp.CICOTransactListReque
 			TransactFee:              svChrg,
 			TransactCommission:       &cbp.Amount{Amount: "0", Currency: "PHP"},
 			TransactionCompletedTime: timestamppb.New(v.TrxDate),
+			BranchID:                 v.BranchID,
+			TellerID:                 v.TellerID,
 		})
 	}
 
@@ -71,10 +80,15 @@ func (s *Svc) CICOTransactList(ctx context.Context, f *cbp.CICOTransactListReque
 		tot = rhs[0].Total
 	}
 
+	last := rhs[len(rhs)-1]
 	return &cbp.CICOTransactListResponse{
 		Next:          f.Offset + int32(len(cicotrx)),
 		CICOTransacts: cicotrx,
 		Total:         int32(tot),
+		NextPageToken: storage.NextPageToken(f.GetSortByColumn().String(), fl.Limit, len(rhs), storage.Cursor{
+			Created: last.Created,
+			ID:      last.ID,
+		}),
 	}, nil
 }
 
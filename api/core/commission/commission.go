@@ -0,0 +1,278 @@
+package commission
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc"
+
+	"brank.as/petnet/api/storage"
+	pcpb "brank.as/petnet/gunk/dsa/v2/partnercommission"
+	rspb "brank.as/petnet/gunk/dsa/v2/revenuesharing"
+)
+
+// Currency of the commission amounts.
+const Currency = "PHP"
+
+type RevenueSharingClient interface {
+	GetRevenueSharingList(ctx context.Context, in *rspb.GetRevenueSharingListRequest, opts ...grpc.CallOption) (*rspb.GetRevenueSharingListResponse, error)
+	GetRevenueSharingTierList(ctx context.Context, in *rspb.GetRevenueSharingTierListRequest, opts ...grpc.CallOption) (*rspb.GetRevenueSharingTierListResponse, error)
+}
+
+type PartnerCommissionClient interface {
+	GetPartnerCommissionsList(ctx context.Context, in *pcpb.GetPartnerCommissionsListRequest, opts ...grpc.CallOption) (*pcpb.GetPartnerCommissionsListResponse, error)
+	GetPartnerCommissionsTierList(ctx context.Context, in *pcpb.GetPartnerCommissionsTierListRequest, opts ...grpc.CallOption) (*pcpb.GetPartnerCommissionsTierListResponse, error)
+}
+
+// Transaction is a completed transaction, or an aggregate of Count
+// transactions, to calculate the commission of. Amounts are in PHP.
+type Transaction struct {
+	OrgID     string
+	Service   storage.ServiceType
+	Partner   string
+	BoundType string
+	Count     int
+	Amount    string
+	Charges   string
+	Completed time.Time
+}
+
+// Config is the commission configuration applying to a transaction, nil
+// fields are not configured.
+type Config struct {
+	Sharing      *rspb.RevenueSharing
+	SharingTiers []*rspb.RevenueSharingTier
+	Partner      *pcpb.PartnerCommission
+	PartnerTiers []*pcpb.PartnerCommissionTier
+}
+
+// Result is the commission earned on a transaction.
+type Result struct {
+	// Revenue earned from the partner, split between the DSA and PETNET.
+	Revenue currency.Amount
+	DSA     currency.Amount
+	PETNET  currency.Amount
+	// PartnerRate is the fee or percentage applied from the partner commission.
+	PartnerRate     string
+	PartnerTierType string
+	// SharePercent is the DSA share of the revenue in percent.
+	SharePercent    string
+	SharingTierType string
+}
+
+type Svc struct {
+	rs RevenueSharingClient
+	pc PartnerCommissionClient
+}
+
+// New commission calculation service.
+func New(rs RevenueSharingClient, pc PartnerCommissionClient) *Svc {
+	return &Svc{rs: rs, pc: pc}
+}
+
+// Config returns the commission configuration of the DSA for the transaction
+// partner and service in effect at the time the transaction completed.
+func (s *Svc) Config(ctx context.Context, t Transaction) (*Config, error) {
+	c := &Config{}
+	rsl, err := s.rs.GetRevenueSharingList(ctx, &rspb.GetRevenueSharingListRequest{
+		OrgID:     t.OrgID,
+		Partner:   t.Partner,
+		RemitType: rspb.RemitType(rspb.RemitType_value[string(t.Service)]),
+		BoundType: rspb.BoundType(rspb.BoundType_value[t.BoundType]),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(rsl.GetResults()) > 0 {
+		c.Sharing = rsl.GetResults()[0]
+		if c.Sharing.GetTierType() == rspb.TierType_TIERPERCENTAGE {
+			tl, err := s.rs.GetRevenueSharingTierList(ctx, &rspb.GetRevenueSharingTierListRequest{
+				RevenueSharingID: c.Sharing.GetID(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			c.SharingTiers = tl.GetResults()
+		}
+	}
+
+	pcl, err := s.pc.GetPartnerCommissionsList(ctx, &pcpb.GetPartnerCommissionsListRequest{
+		Partner:   t.Partner,
+		RemitType: pcpb.RemitType(pcpb.RemitType_value[string(t.Service)]),
+		BoundType: pcpb.BoundType(pcpb.BoundType_value[t.BoundType]),
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.Partner = activeCommission(pcl.GetResults(), t.Completed)
+	if tt := c.Partner.GetTierType(); tt == pcpb.TierType_TIERAMOUNT || tt == pcpb.TierType_TIERPERCENTAGE {
+		tl, err := s.pc.GetPartnerCommissionsTierList(ctx, &pcpb.GetPartnerCommissionsTierListRequest{
+			PartnerCommissionID: c.Partner.GetID(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.PartnerTiers = tl.GetResults()
+	}
+	return c, nil
+}
+
+// Calculate the commission earned on the transaction. The partner commission
+// gives the revenue earned from the partner, the charges collected if none is
+// configured, and the DSA revenue sharing the share of it paid to the DSA, the
+// rest goes to PETNET. volume is the monthly transaction count of the service
+// used to resolve volume-banded revenue sharing tiers.
+func Calculate(c *Config, t Transaction, volume int) (*Result, error) {
+	if c == nil {
+		c = &Config{}
+	}
+	rev, rate, err := revenue(c.Partner, c.PartnerTiers, t)
+	if err != nil {
+		return nil, err
+	}
+	res := &Result{
+		Revenue:     rev.Round(),
+		PartnerRate: rate,
+		DSA:         zero(),
+		PETNET:      rev.Round(),
+	}
+	if c.Partner != nil {
+		res.PartnerTierType = c.Partner.GetTierType().String()
+	}
+	if c.Sharing == nil {
+		return res, nil
+	}
+	res.SharingTierType = c.Sharing.GetTierType().String()
+	pct, ok := sharePercent(c.Sharing, c.SharingTiers, volume)
+	if !ok {
+		return res, nil
+	}
+	res.SharePercent = pct
+
+	d, err := rev.Mul(pct)
+	if err != nil {
+		return nil, err
+	}
+	if d, err = d.Div("100"); err != nil {
+		return nil, err
+	}
+	res.DSA = d.Round()
+	if res.PETNET, err = res.Revenue.Sub(res.DSA); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// sharePercent returns the share in percent of the revenue paid to the DSA.
+func sharePercent(rs *rspb.RevenueSharing, tiers []*rspb.RevenueSharingTier, volume int) (string, bool) {
+	switch rs.GetTierType() {
+	case rspb.TierType_PERCENTAGE:
+		return rs.GetAmount(), rs.GetAmount() != ""
+	case rspb.TierType_TIERPERCENTAGE:
+		for _, t := range tiers {
+			if inTier(t.GetMinValue(), t.GetMaxValue(), float64(volume)) {
+				return t.GetAmount(), t.GetAmount() != ""
+			}
+		}
+	}
+	return "", false
+}
+
+// activeCommission returns the partner commission in effect at t.
+func activeCommission(l []*pcpb.PartnerCommission, t time.Time) *pcpb.PartnerCommission {
+	for _, pc := range l {
+		if st := pc.GetStartDate(); st.IsValid() && st.AsTime().After(t) {
+			continue
+		}
+		if e := pc.GetEndDate(); e.IsValid() && e.AsTime().Unix() > 0 && e.AsTime().Before(t) {
+			continue
+		}
+		return pc
+	}
+	return nil
+}
+
+// revenue returns the revenue earned from the partner and the rate applied.
+// Principal tiers of an aggregate are resolved on the average principal.
+func revenue(pc *pcpb.PartnerCommission, tiers []*pcpb.PartnerCommissionTier, t Transaction) (currency.Amount, string, error) {
+	if pc == nil {
+		if t.Charges == "" {
+			return zero(), "", nil
+		}
+		c, err := currency.NewAmount(t.Charges, Currency)
+		return c, "", err
+	}
+	cnt := t.Count
+	if cnt <= 0 {
+		cnt = 1
+	}
+	amt := zero()
+	if t.Amount != "" {
+		var err error
+		if amt, err = currency.NewAmount(t.Amount, Currency); err != nil {
+			return zero(), "", err
+		}
+	}
+	n := strconv.Itoa(cnt)
+
+	rate, tt := pc.GetAmount(), pc.GetTierType()
+	if tt == pcpb.TierType_TIERAMOUNT || tt == pcpb.TierType_TIERPERCENTAGE {
+		avg, err := amt.Div(n)
+		if err != nil {
+			return zero(), "", err
+		}
+		a, _ := strconv.ParseFloat(avg.Number(), 64)
+		rate = ""
+		for _, t := range tiers {
+			if inTier(t.GetMinValue(), t.GetMaxValue(), a) {
+				rate = t.GetAmount()
+				break
+			}
+		}
+	}
+	if rate == "" {
+		return zero(), "", nil
+	}
+
+	switch tt {
+	case pcpb.TierType_FIXED, pcpb.TierType_TIERAMOUNT:
+		f, err := currency.NewAmount(rate, Currency)
+		if err != nil {
+			return zero(), "", err
+		}
+		f, err = f.Mul(n)
+		return f, rate, err
+	case pcpb.TierType_PERCENTAGE, pcpb.TierType_TIERPERCENTAGE:
+		f, err := amt.Mul(rate)
+		if err != nil {
+			return zero(), "", err
+		}
+		f, err = f.Div("100")
+		return f, rate, err
+	}
+	return zero(), "", nil
+}
+
+// inTier reports whether v is within the tier bounds, an empty maximum is
+// unbounded.
+func inTier(min, max string, v float64) bool {
+	mn, err := strconv.ParseFloat(min, 64)
+	if err != nil && min != "" {
+		return false
+	}
+	if v < mn {
+		return false
+	}
+	if max == "" {
+		return true
+	}
+	mx, err := strconv.ParseFloat(max, 64)
+	return err == nil && v <= mx
+}
+
+func zero() currency.Amount {
+	z, _ := currency.NewAmount("0", Currency)
+	return z.Round()
+}
@@ -0,0 +1,247 @@
+package commission
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pcpb "brank.as/petnet/gunk/dsa/v2/partnercommission"
+	rspb "brank.as/petnet/gunk/dsa/v2/revenuesharing"
+)
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	ptiers := []*pcpb.PartnerCommissionTier{
+		{MinValue: "0", MaxValue: "100", Amount: "5"},
+		{MinValue: "100.01", MaxValue: "1000", Amount: "10"},
+	}
+	stiers := []*rspb.RevenueSharingTier{
+		{MinValue: "1", MaxValue: "100", Amount: "10"},
+		{MinValue: "101", Amount: "20"},
+	}
+	share := &rspb.RevenueSharing{TierType: rspb.TierType_PERCENTAGE, Amount: "50"}
+	txn := Transaction{Count: 4, Amount: "2000", Charges: "40"}
+	tests := []struct {
+		desc    string
+		c       *Config
+		t       Transaction
+		volume  int
+		revenue string
+		dsa     string
+	}{
+		{
+			desc:    "No Config",
+			t:       txn,
+			revenue: "40.00",
+			dsa:     "0.00",
+		},
+		{
+			desc:    "Charges Shared",
+			c:       &Config{Sharing: share},
+			t:       txn,
+			revenue: "40.00",
+			dsa:     "20.00",
+		},
+		{
+			desc:    "Fixed",
+			c:       &Config{Sharing: share, Partner: &pcpb.PartnerCommission{TierType: pcpb.TierType_FIXED, Amount: "2.5"}},
+			t:       txn,
+			revenue: "10.00",
+			dsa:     "5.00",
+		},
+		{
+			desc:    "Percentage",
+			c:       &Config{Sharing: share, Partner: &pcpb.PartnerCommission{TierType: pcpb.TierType_PERCENTAGE, Amount: "1.5"}},
+			t:       txn,
+			revenue: "30.00",
+			dsa:     "15.00",
+		},
+		{
+			desc:    "Tier Amount On Average Principal",
+			c:       &Config{Sharing: share, Partner: &pcpb.PartnerCommission{TierType: pcpb.TierType_TIERAMOUNT}, PartnerTiers: ptiers},
+			t:       txn,
+			revenue: "40.00",
+			dsa:     "20.00",
+		},
+		{
+			desc:    "Tier Percentage",
+			c:       &Config{Sharing: share, Partner: &pcpb.PartnerCommission{TierType: pcpb.TierType_TIERPERCENTAGE}, PartnerTiers: ptiers},
+			t:       Transaction{Count: 1, Amount: "50"},
+			revenue: "2.50",
+			dsa:     "1.25",
+		},
+		{
+			desc:    "No Matching Partner Tier",
+			c:       &Config{Sharing: share, Partner: &pcpb.PartnerCommission{TierType: pcpb.TierType_TIERAMOUNT}, PartnerTiers: ptiers[:1]},
+			t:       txn,
+			revenue: "0.00",
+			dsa:     "0.00",
+		},
+		{
+			desc:    "Volume Band",
+			c:       &Config{Sharing: &rspb.RevenueSharing{TierType: rspb.TierType_TIERPERCENTAGE}, SharingTiers: stiers},
+			t:       txn,
+			volume:  150,
+			revenue: "40.00",
+			dsa:     "8.00",
+		},
+		{
+			desc:    "Volume Outside Bands",
+			c:       &Config{Sharing: &rspb.RevenueSharing{TierType: rspb.TierType_TIERPERCENTAGE}, SharingTiers: stiers},
+			t:       txn,
+			revenue: "40.00",
+			dsa:     "0.00",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			got, err := Calculate(test.c, test.t, test.volume)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Revenue.Number() != test.revenue {
+				t.Errorf("want revenue %s got %s", test.revenue, got.Revenue.Number())
+			}
+			if got.DSA.Number() != test.dsa {
+				t.Errorf("want dsa commission %s got %s", test.dsa, got.DSA.Number())
+			}
+			sum, err := got.DSA.Add(got.PETNET)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !sum.Equal(got.Revenue) {
+				t.Errorf("dsa %s and petnet %s commission do not add up to revenue %s", got.DSA, got.PETNET, got.Revenue)
+			}
+		})
+	}
+}
+
+func TestRevenue(t *testing.T) {
+	t.Parallel()
+	txn := Transaction{Count: 4, Amount: "2000", Charges: "40"}
+	tiers := []*pcpb.PartnerCommissionTier{
+		{MinValue: "0", MaxValue: "100", Amount: "5"},
+		{MinValue: "100.01", MaxValue: "1000", Amount: "10"},
+	}
+	tests := []struct {
+		desc  string
+		pc    *pcpb.PartnerCommission
+		tiers []*pcpb.PartnerCommissionTier
+		want  string
+	}{
+		{
+			desc: "No Commission Config",
+			want: "40",
+		},
+		{
+			desc: "Fixed",
+			pc:   &pcpb.PartnerCommission{TierType: pcpb.TierType_FIXED, Amount: "2.5"},
+			want: "10",
+		},
+		{
+			desc: "Percentage",
+			pc:   &pcpb.PartnerCommission{TierType: pcpb.TierType_PERCENTAGE, Amount: "1.5"},
+			want: "30",
+		},
+		{
+			desc:  "Tier Amount",
+			pc:    &pcpb.PartnerCommission{TierType: pcpb.TierType_TIERAMOUNT},
+			tiers: tiers,
+			want:  "40",
+		},
+		{
+			desc:  "Tier Percentage",
+			pc:    &pcpb.PartnerCommission{TierType: pcpb.TierType_TIERPERCENTAGE},
+			tiers: tiers,
+			want:  "200",
+		},
+		{
+			desc:  "No Matching Tier",
+			pc:    &pcpb.PartnerCommission{TierType: pcpb.TierType_TIERAMOUNT},
+			tiers: tiers[:1],
+			want:  "0",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			got, _, err := revenue(test.pc, test.tiers, txn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Round().String() != test.want+".00 PHP" {
+				t.Errorf("want %s got %s", test.want, got.Round())
+			}
+		})
+	}
+}
+
+func TestSharePercent(t *testing.T) {
+	t.Parallel()
+	tiers := []*rspb.RevenueSharingTier{
+		{MinValue: "1", MaxValue: "100", Amount: "10"},
+		{MinValue: "101", Amount: "20"},
+	}
+	tests := []struct {
+		desc   string
+		rs     *rspb.RevenueSharing
+		volume int
+		want   string
+		wantOK bool
+	}{
+		{
+			desc:   "Percentage",
+			rs:     &rspb.RevenueSharing{TierType: rspb.TierType_PERCENTAGE, Amount: "30"},
+			volume: 5,
+			want:   "30",
+			wantOK: true,
+		},
+		{
+			desc:   "First Tier",
+			rs:     &rspb.RevenueSharing{TierType: rspb.TierType_TIERPERCENTAGE},
+			volume: 100,
+			want:   "10",
+			wantOK: true,
+		},
+		{
+			desc:   "Unbounded Tier",
+			rs:     &rspb.RevenueSharing{TierType: rspb.TierType_TIERPERCENTAGE},
+			volume: 5000,
+			want:   "20",
+			wantOK: true,
+		},
+		{
+			desc: "Not Configured",
+			rs:   &rspb.RevenueSharing{},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			got, ok := sharePercent(test.rs, tiers, test.volume)
+			if got != test.want || ok != test.wantOK {
+				t.Errorf("want %q/%t got %q/%t", test.want, test.wantOK, got, ok)
+			}
+		})
+	}
+}
+
+func TestActiveCommission(t *testing.T) {
+	t.Parallel()
+	at := time.Date(2022, 5, 15, 0, 0, 0, 0, time.UTC)
+	expired := &pcpb.PartnerCommission{ID: "expired", EndDate: timestamppb.New(at.AddDate(0, -1, 0))}
+	future := &pcpb.PartnerCommission{ID: "future", StartDate: timestamppb.New(at.AddDate(0, 1, 0))}
+	open := &pcpb.PartnerCommission{ID: "open", StartDate: timestamppb.New(at.AddDate(-1, 0, 0)), EndDate: timestamppb.New(time.Time{})}
+
+	if got := activeCommission([]*pcpb.PartnerCommission{expired, future, open}, at); got.GetID() != "open" {
+		t.Errorf("want open got %q", got.GetID())
+	}
+	if got := activeCommission([]*pcpb.PartnerCommission{expired, future}, at); got != nil {
+		t.Errorf("want none got %q", got.GetID())
+	}
+}
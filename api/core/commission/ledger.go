@@ -0,0 +1,145 @@
+package commission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const (
+	defaultLookback = 7 * 24 * time.Hour
+	defaultLimit    = 500
+)
+
+type Store interface {
+	ListUnpostedCommission(context.Context, storage.CommissionFilter) ([]storage.CommissionLedger, error)
+	CountCompletedTransactions(context.Context, storage.CommissionFilter) (int, error)
+	CreateCommissionLedger(context.Context, storage.CommissionLedger) (*storage.CommissionLedger, error)
+}
+
+type Ledger struct {
+	st       Store
+	calc     *Svc
+	lookback time.Duration
+	limit    int
+}
+
+// LedgerOption is type for creating Ledger with options
+type LedgerOption func(*Ledger)
+
+// WithLookback sets how far back completed transactions are posted.
+func WithLookback(d time.Duration) LedgerOption {
+	return func(l *Ledger) {
+		if d > 0 {
+			l.lookback = d
+		}
+	}
+}
+
+// WithLimit sets the number of transactions posted per run.
+func WithLimit(n int) LedgerOption {
+	return func(l *Ledger) {
+		if n > 0 {
+			l.limit = n
+		}
+	}
+}
+
+// NewLedger commission ledger service.
+func NewLedger(st Store, calc *Svc, opts ...LedgerOption) *Ledger {
+	l := &Ledger{
+		st:       st,
+		calc:     calc,
+		lookback: defaultLookback,
+		limit:    defaultLimit,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Post calculates the commission of the completed transactions not yet in the
+// ledger and records them. Meant to run as a leader cron.
+func (l *Ledger) Post(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	now := time.Now()
+	ts, err := l.st.ListUnpostedCommission(ctx, storage.CommissionFilter{
+		From:  now.Add(-l.lookback),
+		Until: now,
+		Limit: l.limit,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("listing transactions to post")
+		return err
+	}
+
+	cfgs := map[string]*Config{}
+	for _, t := range ts {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := l.post(ctx, t, cfgs); err != nil {
+			logging.WithError(err, log.WithField("txID", t.TxnID)).Error("posting commission")
+		}
+	}
+	return nil
+}
+
+func (l *Ledger) post(ctx context.Context, e storage.CommissionLedger, cfgs map[string]*Config) error {
+	t := Transaction{
+		OrgID:     e.OrgID,
+		Service:   e.Service,
+		Partner:   e.Partner,
+		BoundType: e.BoundType,
+		Count:     1,
+		Amount:    e.Amount,
+		Charges:   e.Charges,
+		Completed: e.TxnCompletedTime,
+	}
+	k := fmt.Sprintf("%s/%s/%s/%s", t.OrgID, t.Service, t.Partner, t.BoundType)
+	c, ok := cfgs[k]
+	if !ok {
+		var err error
+		if c, err = l.calc.Config(ctx, t); err != nil {
+			return err
+		}
+		cfgs[k] = c
+	}
+
+	// the volume is the number of transactions completed in the month up to
+	// and including this one, so it does not depend on the order of posting.
+	n, err := l.st.CountCompletedTransactions(ctx, storage.CommissionFilter{
+		OrgID:   t.OrgID,
+		Service: t.Service,
+		From:    monthStart(t.Completed),
+		Until:   t.Completed.Add(time.Microsecond),
+	})
+	if err != nil {
+		return err
+	}
+	res, err := Calculate(c, t, n)
+	if err != nil {
+		return err
+	}
+
+	e.Revenue = res.Revenue.Number()
+	e.DSACommission = res.DSA.Number()
+	e.PETNETCommission = res.PETNET.Number()
+	e.PartnerTierType = res.PartnerTierType
+	e.PartnerRate = res.PartnerRate
+	e.SharingTierType = res.SharingTierType
+	e.SharePercent = res.SharePercent
+	if _, err := l.st.CreateCommissionLedger(ctx, e); err != nil && err != storage.Conflict {
+		return err
+	}
+	return nil
+}
+
+func monthStart(t time.Time) time.Time {
+	y, m, _ := t.Date()
+	return time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,115 @@
+package commission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"brank.as/petnet/api/storage"
+	pcpb "brank.as/petnet/gunk/dsa/v2/partnercommission"
+	rspb "brank.as/petnet/gunk/dsa/v2/revenuesharing"
+)
+
+type fakeStore struct {
+	unposted []storage.CommissionLedger
+	posted   []storage.CommissionLedger
+}
+
+func (f *fakeStore) ListUnpostedCommission(context.Context, storage.CommissionFilter) ([]storage.CommissionLedger, error) {
+	return f.unposted, nil
+}
+
+func (f *fakeStore) CountCompletedTransactions(_ context.Context, cf storage.CommissionFilter) (int, error) {
+	n := 0
+	for _, e := range f.unposted {
+		if e.OrgID == cf.OrgID && e.Service == cf.Service &&
+			!e.TxnCompletedTime.Before(cf.From) && e.TxnCompletedTime.Before(cf.Until) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (f *fakeStore) CreateCommissionLedger(_ context.Context, e storage.CommissionLedger) (*storage.CommissionLedger, error) {
+	f.posted = append(f.posted, e)
+	return &e, nil
+}
+
+type fakeConfig struct {
+	calls int
+}
+
+func (f *fakeConfig) GetRevenueSharingList(context.Context, *rspb.GetRevenueSharingListRequest, ...grpc.CallOption) (*rspb.GetRevenueSharingListResponse, error) {
+	f.calls++
+	return &rspb.GetRevenueSharingListResponse{Results: []*rspb.RevenueSharing{
+		{ID: "rs", TierType: rspb.TierType_TIERPERCENTAGE},
+	}}, nil
+}
+
+func (f *fakeConfig) GetRevenueSharingTierList(context.Context, *rspb.GetRevenueSharingTierListRequest, ...grpc.CallOption) (*rspb.GetRevenueSharingTierListResponse, error) {
+	return &rspb.GetRevenueSharingTierListResponse{Results: []*rspb.RevenueSharingTier{
+		{MinValue: "1", MaxValue: "1", Amount: "10"},
+		{MinValue: "2", Amount: "50"},
+	}}, nil
+}
+
+func (f *fakeConfig) GetPartnerCommissionsList(context.Context, *pcpb.GetPartnerCommissionsListRequest, ...grpc.CallOption) (*pcpb.GetPartnerCommissionsListResponse, error) {
+	return &pcpb.GetPartnerCommissionsListResponse{Results: []*pcpb.PartnerCommission{
+		{ID: "pc", TierType: pcpb.TierType_FIXED, Amount: "20"},
+	}}, nil
+}
+
+func (f *fakeConfig) GetPartnerCommissionsTierList(context.Context, *pcpb.GetPartnerCommissionsTierListRequest, ...grpc.CallOption) (*pcpb.GetPartnerCommissionsTierListResponse, error) {
+	return &pcpb.GetPartnerCommissionsTierListResponse{}, nil
+}
+
+func TestPost(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2022, 5, 15, 12, 0, 0, 0, time.UTC)
+	txn := storage.CommissionLedger{
+		OrgID:   "org",
+		Service: storage.CashInCashOutService,
+		Partner: "GCASH",
+		Amount:  "1000",
+		Charges: "15",
+	}
+	st := &fakeStore{}
+	// posted out of completion order, the volume must still follow it
+	for _, id := range []string{"second", "first"} {
+		txn.TxnID = id
+		txn.TxnCompletedTime = now
+		if id == "first" {
+			txn.TxnCompletedTime = now.Add(-time.Minute)
+		}
+		st.unposted = append(st.unposted, txn)
+	}
+	cfg := &fakeConfig{}
+	if err := NewLedger(st, New(cfg, cfg)).Post(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.calls != 1 {
+		t.Errorf("want config fetched once per run got %d", cfg.calls)
+	}
+	if len(st.posted) != 2 {
+		t.Fatalf("want 2 ledger entries got %d", len(st.posted))
+	}
+	want := []struct {
+		id, dsa, petnet, pct string
+	}{
+		{id: "second", dsa: "10.00", petnet: "10.00", pct: "50"},
+		{id: "first", dsa: "2.00", petnet: "18.00", pct: "10"},
+	}
+	for i, w := range want {
+		got := st.posted[i]
+		if got.TxnID != w.id || got.Revenue != "20.00" || got.DSACommission != w.dsa || got.PETNETCommission != w.petnet || got.SharePercent != w.pct {
+			t.Errorf("want %s dsa %s petnet %s at %s%% got %s dsa %s petnet %s at %s%%",
+				w.id, w.dsa, w.petnet, w.pct, got.TxnID, got.DSACommission, got.PETNETCommission, got.SharePercent)
+		}
+		if got.PartnerTierType != pcpb.TierType_FIXED.String() || got.SharingTierType != rspb.TierType_TIERPERCENTAGE.String() {
+			t.Errorf("unexpected tier types %s/%s", got.PartnerTierType, got.SharingTierType)
+		}
+	}
+}
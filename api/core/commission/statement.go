@@ -0,0 +1,227 @@
+package commission
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bojanz/currency"
+
+	"brank.as/petnet/api/storage"
+)
+
+// StatementLine is the total of the ledger entries of a service and partner
+// on which the same tiers applied.
+type StatementLine struct {
+	Service     string
+	Partner     string
+	BoundType   string
+	PartnerTier string
+	SharingTier string
+	Count       int
+	Amount      currency.Amount
+	GrossFees   currency.Amount
+	Revenue     currency.Amount
+	DSA         currency.Amount
+	PETNET      currency.Amount
+}
+
+// Statement is the commission statement of a DSA for a month.
+type Statement struct {
+	DsaCode   string
+	Company   string
+	YearMonth string
+	Generated time.Time
+	Lines     []StatementLine
+	Total     StatementLine
+}
+
+var statementHeader = []string{
+	"Service", "Partner", "Bound Type", "Transactions", "Principal Amount", "Gross Fees",
+	"Revenue", "Partner Tier", "Sharing Tier", "DSA Commission", "PETNET Commission",
+}
+
+// NewStatement totals the ledger entries of the statement period.
+func NewStatement(dsaCode, company, yearMonth string, es []storage.CommissionLedger) (*Statement, error) {
+	st := &Statement{
+		DsaCode:   dsaCode,
+		Company:   company,
+		YearMonth: yearMonth,
+		Generated: time.Now(),
+		Total:     newLine(),
+	}
+	st.Total.Service = "TOTAL"
+	lines := map[string]*StatementLine{}
+	for _, e := range es {
+		pt, sh := tierLabel(e.PartnerTierType, e.PartnerRate), tierLabel(e.SharingTierType, e.SharePercent)
+		k := strings.Join([]string{string(e.Service), e.Partner, e.BoundType, pt, sh}, "|")
+		l, ok := lines[k]
+		if !ok {
+			nl := newLine()
+			l = &nl
+			l.Service, l.Partner, l.BoundType, l.PartnerTier, l.SharingTier = string(e.Service), e.Partner, e.BoundType, pt, sh
+			lines[k] = l
+		}
+		for _, ln := range []*StatementLine{l, &st.Total} {
+			if err := ln.add(e); err != nil {
+				return nil, fmt.Errorf("ledger entry %s: %w", e.ID, err)
+			}
+		}
+	}
+	for _, l := range lines {
+		st.Lines = append(st.Lines, *l)
+	}
+	sort.Slice(st.Lines, func(i, j int) bool {
+		a, b := st.Lines[i], st.Lines[j]
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		if a.Partner != b.Partner {
+			return a.Partner < b.Partner
+		}
+		if a.BoundType != b.BoundType {
+			return a.BoundType < b.BoundType
+		}
+		return a.PartnerTier+a.SharingTier < b.PartnerTier+b.SharingTier
+	})
+	return st, nil
+}
+
+// CSV renders the statement as CSV, one line item per row followed by the
+// totals.
+func (st *Statement) CSV() ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	rows := [][]string{
+		{"DSA Code", st.DsaCode},
+		{"Company", st.Company},
+		{"Period", st.period()},
+		{},
+		statementHeader,
+	}
+	for _, l := range st.Lines {
+		rows = append(rows, l.row())
+	}
+	rows = append(rows, st.Total.row())
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// PDF renders the statement as a printable PDF.
+func (st *Statement) PDF() ([]byte, error) {
+	rows := [][]string{statementHeader}
+	for _, l := range st.Lines {
+		rows = append(rows, l.row())
+	}
+	rows = append(rows, st.Total.row())
+
+	lines := []string{
+		"PETNET COMMISSION STATEMENT",
+		"",
+		"DSA Code : " + st.DsaCode,
+		"Company  : " + st.Company,
+		"Period   : " + st.period(),
+		"Generated: " + st.Generated.Format("2006-01-02 15:04 MST"),
+		"",
+	}
+	lines = append(lines, table(rows)...)
+	return writePDF(lines), nil
+}
+
+func (st *Statement) period() string {
+	t, err := time.Parse("200601", st.YearMonth)
+	if err != nil {
+		return st.YearMonth
+	}
+	return t.Format("January 2006")
+}
+
+func newLine() StatementLine {
+	return StatementLine{Amount: zero(), GrossFees: zero(), Revenue: zero(), DSA: zero(), PETNET: zero()}
+}
+
+func (l *StatementLine) add(e storage.CommissionLedger) error {
+	l.Count++
+	for _, a := range []struct {
+		to *currency.Amount
+		v  string
+	}{
+		{to: &l.Amount, v: e.Amount},
+		{to: &l.GrossFees, v: e.Charges},
+		{to: &l.Revenue, v: e.Revenue},
+		{to: &l.DSA, v: e.DSACommission},
+		{to: &l.PETNET, v: e.PETNETCommission},
+	} {
+		if a.v == "" {
+			continue
+		}
+		v, err := currency.NewAmount(a.v, Currency)
+		if err != nil {
+			return err
+		}
+		if *a.to, err = a.to.Add(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l StatementLine) row() []string {
+	return []string{
+		l.Service, l.Partner, l.BoundType, strconv.Itoa(l.Count),
+		l.Amount.Round().Number(), l.GrossFees.Round().Number(), l.Revenue.Round().Number(),
+		l.PartnerTier, l.SharingTier, l.DSA.Round().Number(), l.PETNET.Round().Number(),
+	}
+}
+
+// tierLabel is the tier type with the rate applied, percentage tiers are
+// suffixed with %.
+func tierLabel(typ, rate string) string {
+	switch {
+	case typ == "":
+		return "-"
+	case rate == "":
+		return typ
+	case strings.Contains(typ, "PERCENTAGE"):
+		return typ + " " + rate + "%"
+	}
+	return typ + " " + rate
+}
+
+// table lays out the rows in fixed width columns.
+func table(rows [][]string) []string {
+	w := make([]int, len(statementHeader))
+	for _, r := range rows {
+		for i, c := range r {
+			if len(c) > w[i] {
+				w[i] = len(c)
+			}
+		}
+	}
+	var out []string
+	for n, r := range rows {
+		var b strings.Builder
+		for i, c := range r {
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			// text columns left aligned, amounts right aligned
+			if i < 3 || i == 7 || i == 8 {
+				fmt.Fprintf(&b, "%-*s", w[i], c)
+			} else {
+				fmt.Fprintf(&b, "%*s", w[i], c)
+			}
+		}
+		out = append(out, strings.TrimRight(b.String(), " "))
+		if n == 0 || n == len(rows)-2 {
+			out = append(out, strings.Repeat("-", b.Len()))
+		}
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package commission
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestStatement(t *testing.T) {
+	t.Parallel()
+	es := []storage.CommissionLedger{
+		{ID: "1", Service: storage.RemittanceService, Partner: "WU", BoundType: "OUTBOUND", Amount: "1000", Charges: "50", Revenue: "20.00", DSACommission: "10.00", PETNETCommission: "10.00", PartnerTierType: "FIXED", PartnerRate: "20", SharingTierType: "PERCENTAGE", SharePercent: "50"},
+		{ID: "2", Service: storage.RemittanceService, Partner: "WU", BoundType: "OUTBOUND", Amount: "500", Charges: "25", Revenue: "20.00", DSACommission: "10.00", PETNETCommission: "10.00", PartnerTierType: "FIXED", PartnerRate: "20", SharingTierType: "PERCENTAGE", SharePercent: "50"},
+		{ID: "3", Service: storage.CashInCashOutService, Partner: "GCASH", Amount: "1000", Charges: "15", Revenue: "15.00", DSACommission: "0.00", PETNETCommission: "15.00"},
+	}
+	st, err := NewStatement("DSA1", "Acme, Inc.", "202203", es)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(st.Lines) != 2 {
+		t.Fatalf("want 2 lines got %d", len(st.Lines))
+	}
+
+	b, err := st.CSV()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Join([]string{
+		"DSA Code,DSA1",
+		`Company,"Acme, Inc."`,
+		"Period,March 2022",
+		"",
+		"Service,Partner,Bound Type,Transactions,Principal Amount,Gross Fees,Revenue,Partner Tier,Sharing Tier,DSA Commission,PETNET Commission",
+		"CASHINCASHOUT,GCASH,,1,1000.00,15.00,15.00,-,-,0.00,15.00",
+		"REMITTANCE,WU,OUTBOUND,2,1500.00,75.00,40.00,FIXED 20,PERCENTAGE 50%,20.00,20.00",
+		"TOTAL,,,3,2500.00,90.00,55.00,,,20.00,35.00",
+		"",
+	}, "\n")
+	if got := string(b); got != want {
+		t.Errorf("CSV() = got\n%s\nwant\n%s", got, want)
+	}
+
+	p, err := st.PDF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(p, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(p, []byte("%%EOF\n")) {
+		t.Error("PDF() = want a complete PDF document")
+	}
+	if !bytes.Contains(p, []byte("(DSA Code : DSA1) '")) {
+		t.Error("PDF() = want DSA code in the statement")
+	}
+}
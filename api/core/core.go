@@ -56,6 +56,28 @@ type FeeInquiryReq struct {
 	DestCurrency      string
 	Promo             string
 	Message           string
+	// Email identifies the customer for partners that quote per customer.
+	Email string
+}
+
+// Delivery methods of a partner quote.
+const (
+	CashPickup   = "CASH_PICKUP"
+	BankDeposit  = "BANK_DEPOSIT"
+	MobileWallet = "MOBILE_WALLET"
+)
+
+// PartnerQuote is the normalized cost of sending through a partner. Fee is the
+// total charged on top of the principal in the source currency, ReceiveAmount
+// is in the destination currency. Err is set when the partner failed to quote.
+type PartnerQuote struct {
+	RemitPartner   string
+	RemitType      string
+	DeliveryMethod string
+	Fee            currency.Minor
+	ExchangeRate   string
+	ReceiveAmount  currency.Minor
+	Err            error
 }
 
 type FilterList struct {
@@ -68,6 +90,9 @@ type FilterList struct {
 	ControlNumbers []string
 	ExcludePartner string
 	ExcludeType    string
+	PageToken      string
+	BranchID       string
+	TellerID       string
 }
 
 type UserFilterList struct {
@@ -7,8 +7,9 @@ import (
 )
 
 type SearchRemitResponse struct {
-	Total        int
-	SearchRemits []SearchRemit
+	Total         int
+	SearchRemits  []SearchRemit
+	NextPageToken string
 }
 
 type SearchRemit struct {
@@ -44,4 +45,29 @@ type SearchRemit struct {
 
 	TxnStagedTime    time.Time
 	TxnCompletedTime time.Time
+	OTPUsed          bool
+	PayoutStatus     string
+	PaidOutTime      time.Time
+	BranchID         string
+	TellerID         string
+}
+
+// Payout statuses of a remittance normalized across partners.
+const (
+	PayoutAvailable = "AVAILABLE"
+	PayoutPaid      = "PAID"
+	PayoutCancelled = "CANCELLED"
+	PayoutUnknown   = "UNKNOWN"
+)
+
+// RemitStatus is the payout status of a remittance with the partner.
+type RemitStatus struct {
+	RemitPartner  string
+	ControlNo     string
+	Status        string
+	PartnerStatus string
+	RemitAmount   currency.Minor
+	SenderName    string
+	ReceiverName  string
+	FilingTime    time.Time
 }
@@ -0,0 +1,111 @@
+package eload
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const (
+	// Partner is the partner eload sales are recorded and revenue shared under.
+	Partner = "ELOAD"
+
+	currencyCode = "PHP"
+	// pageSize is the number of products fetched per catalog request.
+	pageSize = 100
+	// maxPages bounds the catalog paging in case the total row count is wrong.
+	maxPages = 50
+	// phSuccess is the perahub header error code of a successful request.
+	phSuccess = "1"
+	// defaultReviewGrace is how long a sale may stay pending before it is
+	// flagged for review.
+	defaultReviewGrace = 30 * time.Minute
+)
+
+type Client interface {
+	GetELoadProducts(ctx context.Context, req perahub.ELPRequest) (*perahub.ELPResponse, error)
+	SellLoad(ctx context.Context, req perahub.SELoadRequest) (*perahub.SELoadResponse, error)
+}
+
+type Store interface {
+	CreateELoadHistory(ctx context.Context, r storage.ELoadHistory) (*storage.ELoadHistory, error)
+	UpdateELoadHistory(ctx context.Context, r storage.ELoadHistory) (*storage.ELoadHistory, error)
+	GetELoadHistoryByIdempotencyKey(ctx context.Context, orgID, key string) (*storage.ELoadHistory, error)
+	ReviewPendingELoadHistory(ctx context.Context, before time.Time) (int64, error)
+}
+
+type Svc struct {
+	cl    Client
+	st    Store
+	grace time.Duration
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithReviewGrace sets how long a sale may stay pending before it is flagged
+// for review.
+func WithReviewGrace(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.grace = d
+		}
+	}
+}
+
+func New(cl Client, st Store, opts ...Option) *Svc {
+	s := &Svc{cl: cl, st: st, grace: defaultReviewGrace}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ReviewPending flags the sales PeraHub never answered as needing review.
+// PeraHub has no eload inquiry, so these are reconciled with the PeraHub
+// reports by hand. Meant to run as a leader cron.
+func (s *Svc) ReviewPending(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	n, err := s.st.ReviewPendingELoadHistory(ctx, time.Now().Add(-s.grace))
+	if err != nil {
+		logging.WithError(err, log).Error("flagging pending eload sales")
+		return err
+	}
+	if n > 0 {
+		log.WithField("count", n).Warn("eload sales need review")
+	}
+	return nil
+}
+
+// catalog fetches every product page from perahub.
+func (s *Svc) catalog(ctx context.Context) ([]perahub.Item, error) {
+	var items []perahub.Item
+	for p := 0; p < maxPages; p++ {
+		res, err := s.cl.GetELoadProducts(ctx, perahub.ELPRequest{
+			CurrentRow: len(items),
+			GroupCount: pageSize,
+		})
+		if err != nil {
+			return nil, coreerror.ToCoreError(err)
+		}
+		if res.WU.Header.ErrorCode != phSuccess {
+			return nil, coreerror.NewCoreError(codes.Unavailable, res.WU.Header.Message)
+		}
+		items = append(items, res.WU.Body.Items...)
+		total := 0
+		if len(res.WU.Body.ItemCounts) > 0 {
+			total, _ = strconv.Atoi(res.WU.Body.ItemCounts[0].TotalRows)
+		}
+		if len(res.WU.Body.Items) < pageSize || len(items) >= total {
+			break
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,303 @@
+package eload
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+	"brank.as/petnet/serviceutil/auth/hydra"
+)
+
+type fakeClient struct {
+	items   []perahub.Item
+	sellErr error
+	sellHdr perahub.ResponseHeader
+	sold    []perahub.SELoadRequest
+}
+
+func (f *fakeClient) GetELoadProducts(_ context.Context, req perahub.ELPRequest) (*perahub.ELPResponse, error) {
+	res := &perahub.ELPResponse{}
+	res.WU.Header.ErrorCode = phSuccess
+	end := req.CurrentRow + req.GroupCount
+	if end > len(f.items) {
+		end = len(f.items)
+	}
+	res.WU.Body.Items = f.items[req.CurrentRow:end]
+	res.WU.Body.ItemCounts = []perahub.ItemCount{{TotalRows: "150"}}
+	return res, nil
+}
+
+func (f *fakeClient) SellLoad(_ context.Context, req perahub.SELoadRequest) (*perahub.SELoadResponse, error) {
+	f.sold = append(f.sold, req)
+	if f.sellErr != nil {
+		return nil, f.sellErr
+	}
+	res := &perahub.SELoadResponse{}
+	res.WU.Header = f.sellHdr
+	res.WU.Body = perahub.SELResponseBody{RRN: "RRN-1", TID: "TID-1", EPIN: "1234"}
+	return res, nil
+}
+
+type fakeStore struct {
+	rows      []storage.ELoadHistory
+	createErr error
+	reviewed  time.Time
+}
+
+func (f *fakeStore) CreateELoadHistory(_ context.Context, r storage.ELoadHistory) (*storage.ELoadHistory, error) {
+	if f.createErr != nil {
+		return nil, f.createErr
+	}
+	for _, h := range f.rows {
+		if r.IdempotencyKey != "" && h.OrgID == r.OrgID && h.IdempotencyKey == r.IdempotencyKey {
+			return nil, storage.Conflict
+		}
+	}
+	r.ID = "eload-id"
+	f.rows = append(f.rows, r)
+	return &r, nil
+}
+
+func (f *fakeStore) UpdateELoadHistory(_ context.Context, r storage.ELoadHistory) (*storage.ELoadHistory, error) {
+	for i, h := range f.rows {
+		if h.ID == r.ID {
+			f.rows[i] = r
+			return &r, nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
+
+func (f *fakeStore) GetELoadHistoryByIdempotencyKey(_ context.Context, orgID, key string) (*storage.ELoadHistory, error) {
+	for _, h := range f.rows {
+		if h.OrgID == orgID && h.IdempotencyKey == key {
+			return &h, nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
+
+func (f *fakeStore) ReviewPendingELoadHistory(_ context.Context, before time.Time) (int64, error) {
+	f.reviewed = before
+	var n int64
+	for i, h := range f.rows {
+		if h.TxnStatus == string(storage.PendingStatus) {
+			f.rows[i].TxnStatus = string(storage.ReviewStatus)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func sellCtx(key string) context.Context {
+	md := metautils.ExtractIncoming(context.Background()).Set(hydra.OrgIDKey, "org-1").
+		Set(phmw.BranchID, "branch-1").Set(phmw.TellerID, "teller-1")
+	if key != "" {
+		md = md.Set(phmw.IdempotencyKey, key)
+	}
+	return md.ToIncoming(context.Background())
+}
+
+func catalog() []perahub.Item {
+	items := make([]perahub.Item, 150)
+	for i := range items {
+		items[i] = perahub.Item{ProductCode: "GLOBE10", Amount: "10", Provider: "GLOBE", ProductType: "Eload"}
+	}
+	items[120] = perahub.Item{ProductCode: "SMGS50", Amount: "50.00", Provider: "SMART", ProductType: "Eload"}
+	return items
+}
+
+func errCode(err error) codes.Code {
+	var cErr *coreerror.Error
+	if errors.As(err, &cErr) {
+		return cErr.Code
+	}
+	return codes.Unknown
+}
+
+func TestELoadProducts(t *testing.T) {
+	s := New(&fakeClient{items: catalog()}, &fakeStore{})
+	res, err := s.ELoadProducts(context.Background(), &epb.ELoadProductsRequest{Provider: "smart"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Products) != 1 || res.Products[0].ProductCode != "SMGS50" {
+		t.Errorf("ELoadProducts() = got %v, want the SMART product from the second page", res.Products)
+	}
+}
+
+func TestELoadValidate(t *testing.T) {
+	s := New(&fakeClient{items: catalog()}, &fakeStore{})
+	tests := []struct {
+		name string
+		req  *epb.ELoadValidateRequest
+		mob  string
+		code codes.Code
+	}{
+		{
+			name: "Valid",
+			req:  &epb.ELoadValidateRequest{ProductCode: "SMGS50", Amount: "50", TargetMobileNo: "+639170000000"},
+			mob:  "09170000000",
+		},
+		{
+			name: "AmountMismatch",
+			req:  &epb.ELoadValidateRequest{ProductCode: "SMGS50", Amount: "20", TargetMobileNo: "09170000000"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "UnknownProduct",
+			req:  &epb.ELoadValidateRequest{ProductCode: "NOPE", Amount: "50", TargetMobileNo: "09170000000"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "InvalidMobile",
+			req:  &epb.ELoadValidateRequest{ProductCode: "SMGS50", Amount: "50", TargetMobileNo: "12345"},
+			code: codes.InvalidArgument,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			res, err := s.ELoadValidate(context.Background(), test.req)
+			if test.code != codes.OK {
+				if errCode(err) != test.code {
+					t.Fatalf("ELoadValidate() = got error %v, want code %v", err, test.code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.TargetMobileNo != test.mob {
+				t.Errorf("ELoadValidate() = got mobile %q, want %q", res.TargetMobileNo, test.mob)
+			}
+		})
+	}
+}
+
+func TestELoadSell(t *testing.T) {
+	req := &epb.ELoadSellRequest{
+		SessionID:      "session",
+		Password:       "password",
+		ProductCode:    "SMGS50",
+		Amount:         "50",
+		TargetMobileNo: "09170000000",
+	}
+	ctx := sellCtx("")
+
+	cl := &fakeClient{items: catalog(), sellHdr: perahub.ResponseHeader{ErrorCode: phSuccess}}
+	st := &fakeStore{}
+	res, err := New(cl, st).ELoadSell(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ELoadID != "eload-id" || res.ReferenceNumber != "RRN-1" {
+		t.Errorf("ELoadSell() = got %v", res)
+	}
+	if len(cl.sold) != 1 || cl.sold[0].Provider != "SMART" {
+		t.Errorf("SellLoad() = got %v", cl.sold)
+	}
+	if len(st.rows) != 1 || st.rows[0].TxnStatus != string(storage.SuccessStatus) || st.rows[0].Amount != 5000 || st.rows[0].OrgID != "org-1" ||
+		st.rows[0].BranchID != "branch-1" || st.rows[0].TellerID != "teller-1" {
+		t.Errorf("CreateELoadHistory() = got %v", st.rows)
+	}
+
+	cl = &fakeClient{items: catalog(), sellHdr: perahub.ResponseHeader{ErrorCode: "0", Message: "insufficient wallet"}}
+	st = &fakeStore{}
+	if _, err := New(cl, st).ELoadSell(ctx, req); errCode(err) != codes.FailedPrecondition {
+		t.Fatalf("ELoadSell() = got error %v, want failed precondition", err)
+	}
+	if len(st.rows) != 1 || st.rows[0].TxnStatus != string(storage.FailStatus) || st.rows[0].ErrorMessage != "insufficient wallet" {
+		t.Errorf("CreateELoadHistory() = got %v", st.rows)
+	}
+
+	// the sale may have gone through, it is kept pending
+	cl = &fakeClient{items: catalog(), sellErr: errors.New("connection reset")}
+	st = &fakeStore{}
+	if _, err := New(cl, st).ELoadSell(ctx, req); errCode(err) != codes.Unknown {
+		t.Fatalf("ELoadSell() = got error %v, want unknown", err)
+	}
+	if len(st.rows) != 1 || st.rows[0].TxnStatus != string(storage.PendingStatus) || st.rows[0].ErrorMessage != "connection reset" {
+		t.Errorf("CreateELoadHistory() = got %v", st.rows)
+	}
+
+	// nothing is sold when the sale cannot be recorded
+	cl = &fakeClient{items: catalog(), sellHdr: perahub.ResponseHeader{ErrorCode: phSuccess}}
+	if _, err := New(cl, &fakeStore{createErr: errors.New("db down")}).ELoadSell(ctx, req); errCode(err) != codes.Internal || len(cl.sold) != 0 {
+		t.Errorf("ELoadSell() = got error %v and %d sales, want internal and none", err, len(cl.sold))
+	}
+
+	cl = &fakeClient{items: catalog()}
+	bad := proto.Clone(req).(*epb.ELoadSellRequest)
+	bad.Amount = "20"
+	if _, err := New(cl, &fakeStore{}).ELoadSell(ctx, bad); err == nil || len(cl.sold) != 0 {
+		t.Error("ELoadSell() = want invalid sale rejected before selling")
+	}
+}
+
+func TestELoadSellIdempotent(t *testing.T) {
+	req := &epb.ELoadSellRequest{
+		SessionID:      "session",
+		Password:       "password",
+		ProductCode:    "SMGS50",
+		Amount:         "50",
+		TargetMobileNo: "09170000000",
+	}
+	ctx := sellCtx("key-1")
+
+	cl := &fakeClient{items: catalog(), sellHdr: perahub.ResponseHeader{ErrorCode: phSuccess}}
+	st := &fakeStore{}
+	s := New(cl, st)
+	for i := 0; i < 2; i++ {
+		res, err := s.ELoadSell(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.ELoadID != "eload-id" || res.ReferenceNumber != "RRN-1" {
+			t.Errorf("ELoadSell() = got %v", res)
+		}
+	}
+	if len(cl.sold) != 1 || len(st.rows) != 1 {
+		t.Fatalf("want 1 sale, got %d sold and %d recorded", len(cl.sold), len(st.rows))
+	}
+
+	other := proto.Clone(req).(*epb.ELoadSellRequest)
+	other.TargetMobileNo = "09171111111"
+	if _, err := s.ELoadSell(ctx, other); errCode(err) != codes.AlreadyExists {
+		t.Errorf("ELoadSell() = got error %v, want already exists", err)
+	}
+
+	st.rows[0].TxnStatus = string(storage.PendingStatus)
+	if _, err := s.ELoadSell(ctx, req); errCode(err) != codes.Aborted {
+		t.Errorf("ELoadSell() = got error %v, want aborted", err)
+	}
+	if len(cl.sold) != 1 {
+		t.Errorf("want no new sale, got %d", len(cl.sold)-1)
+	}
+}
+
+func TestReviewPending(t *testing.T) {
+	st := &fakeStore{rows: []storage.ELoadHistory{
+		{ID: "1", TxnStatus: string(storage.PendingStatus)},
+		{ID: "2", TxnStatus: string(storage.SuccessStatus)},
+	}}
+	if err := New(&fakeClient{}, st, WithReviewGrace(time.Hour)).ReviewPending(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if st.rows[0].TxnStatus != string(storage.ReviewStatus) || st.rows[1].TxnStatus != string(storage.SuccessStatus) {
+		t.Errorf("ReviewPending() = got %v", st.rows)
+	}
+	if d := time.Since(st.reviewed); d < time.Hour || d > time.Hour+time.Minute {
+		t.Errorf("ReviewPending() = got cutoff %v ago, want an hour", d)
+	}
+}
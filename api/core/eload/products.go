@@ -0,0 +1,39 @@
+package eload
+
+import (
+	"context"
+	"strings"
+
+	"brank.as/petnet/api/integration/perahub"
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+)
+
+func (s *Svc) ELoadProducts(ctx context.Context, req *epb.ELoadProductsRequest) (*epb.ELoadProductsResponse, error) {
+	items, err := s.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &epb.ELoadProductsResponse{}
+	for _, it := range items {
+		if req.GetProvider() != "" && !strings.EqualFold(req.GetProvider(), it.Provider) {
+			continue
+		}
+		if req.GetProductType() != "" && !strings.EqualFold(req.GetProductType(), it.ProductType) {
+			continue
+		}
+		res.Products = append(res.Products, toProduct(it))
+	}
+	return res, nil
+}
+
+func toProduct(it perahub.Item) *epb.ELoadProduct {
+	return &epb.ELoadProduct{
+		ProductCode: it.ProductCode,
+		ProductName: it.ProductName,
+		Provider:    it.Provider,
+		ProductType: it.ProductType,
+		Amount:      it.Amount,
+		Commission:  it.Commission,
+		Remarks:     it.Remarks,
+	}
+}
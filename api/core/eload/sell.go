@@ -0,0 +1,143 @@
+package eload
+
+import (
+	"context"
+	"time"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/auth/hydra"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// ELoadSell sells the load and records the outcome in the eload history,
+// failed sales included. The sale is recorded as pending before it is sent so
+// a sale PeraHub does not answer is kept for review. A repeated request with
+// the same idempotency key gets the recorded sale instead of a new one.
+func (s *Svc) ELoadSell(ctx context.Context, req *epb.ELoadSellRequest) (*epb.ELoadSellResponse, error) {
+	log := logging.FromContext(ctx)
+	it, mob, err := s.validate(ctx, req.GetProductCode(), req.GetAmount(), req.GetTargetMobileNo())
+	if err != nil {
+		return nil, err
+	}
+
+	h := storage.ELoadHistory{
+		OrgID:          getDSAOrgID(ctx),
+		UserID:         phmw.GetUserID(ctx),
+		Partner:        Partner,
+		ProductCode:    it.ProductCode,
+		ProductType:    it.ProductType,
+		Provider:       it.Provider,
+		TargetMobileNo: mob,
+		Currency:       currencyCode,
+		TxnStatus:      string(storage.PendingStatus),
+		IdempotencyKey: phmw.GetIdempotencyKey(ctx),
+		BranchID:       phmw.GetBranchID(ctx),
+		TellerID:       phmw.GetTellerID(ctx),
+		TrxDate:        time.Now().UTC(),
+	}
+	if a, err := currency.NewAmount(req.GetAmount(), currencyCode); err == nil {
+		h.Amount = a.ToMinorUnits()
+	}
+	if h.OrgID == "" {
+		return nil, coreerror.NewCoreError(codes.Unauthenticated, "missing org")
+	}
+
+	rec, err := s.st.CreateELoadHistory(ctx, h)
+	switch {
+	case err == storage.Conflict:
+		return s.replay(ctx, h, it)
+	case err != nil:
+		logging.WithError(err, log).WithField("eload", h).Error("recording eload history")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+
+	res, err := s.cl.SellLoad(ctx, perahub.SELoadRequest{
+		SessionID:      req.GetSessionID(),
+		ProductCode:    it.ProductCode,
+		Amount:         req.GetAmount(),
+		Provider:       it.Provider,
+		ProductType:    it.ProductType,
+		TargetMobileNo: mob,
+		Password:       req.GetPassword(),
+	})
+	switch {
+	case err != nil:
+		// the load may have been sold, the sale stays pending until it is
+		// reviewed.
+		logging.WithError(err, log).WithField("eload_id", rec.ID).Error("selling eload")
+		rec.ErrorMessage = err.Error()
+		err = coreerror.NewCoreError(codes.Unknown, "eload sale status unknown, it will be reviewed")
+	case res.WU.Header.ErrorCode != phSuccess:
+		rec.TxnStatus = string(storage.FailStatus)
+		rec.ErrorCode = res.WU.Header.ErrorCode
+		rec.ErrorMessage = res.WU.Header.Message
+		err = coreerror.NewCoreError(codes.FailedPrecondition, res.WU.Header.Message)
+	default:
+		rec.TxnStatus = string(storage.SuccessStatus)
+		rec.ReferenceNumber = res.WU.Body.RRN
+		rec.TraceID = res.WU.Body.TID
+	}
+
+	if _, uErr := s.st.UpdateELoadHistory(ctx, *rec); uErr != nil {
+		// the sale stays pending and is flagged for review
+		logging.WithError(uErr, log).WithField("eload", rec).Error("recording eload sale outcome")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &epb.ELoadSellResponse{
+		ELoadID:         rec.ID,
+		Product:         toProduct(*it),
+		TargetMobileNo:  mob,
+		ReferenceNumber: res.WU.Body.RRN,
+		TraceID:         res.WU.Body.TID,
+		EPIN:            res.WU.Body.EPIN,
+		TrxDate:         timestamppb.New(rec.TrxDate),
+	}, nil
+}
+
+// replay returns the sale recorded with the idempotency key of h. The EPIN is
+// not recorded so it is not part of a replayed response.
+func (s *Svc) replay(ctx context.Context, h storage.ELoadHistory, it *perahub.Item) (*epb.ELoadSellResponse, error) {
+	log := logging.FromContext(ctx).WithField("idempotency_key", h.IdempotencyKey)
+	rec, err := s.st.GetELoadHistoryByIdempotencyKey(ctx, h.OrgID, h.IdempotencyKey)
+	if err != nil {
+		logging.WithError(err, log).Error("getting eload history")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	if rec.ProductCode != h.ProductCode || rec.TargetMobileNo != h.TargetMobileNo || rec.Amount != h.Amount {
+		return nil, coreerror.NewCoreError(codes.AlreadyExists, "idempotency key already used for a different sale")
+	}
+	switch rec.TxnStatus {
+	case string(storage.SuccessStatus):
+	case string(storage.FailStatus):
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, rec.ErrorMessage)
+	default:
+		return nil, coreerror.NewCoreError(codes.Aborted, "eload sale with this idempotency key is being processed")
+	}
+	return &epb.ELoadSellResponse{
+		ELoadID:         rec.ID,
+		Product:         toProduct(*it),
+		TargetMobileNo:  rec.TargetMobileNo,
+		ReferenceNumber: rec.ReferenceNumber,
+		TraceID:         rec.TraceID,
+		TrxDate:         timestamppb.New(rec.TrxDate),
+	}, nil
+}
+
+func getDSAOrgID(ctx context.Context) string {
+	switch phmw.GetOrgType(ctx) {
+	case ppb.OrgType_PetNet.String(), ppb.OrgType_DSA.String():
+		return phmw.GetDSAOrgID(ctx)
+	}
+	return hydra.OrgID(ctx)
+}
@@ -0,0 +1,68 @@
+package eload
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/integration/perahub"
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+)
+
+var mobileNo = regexp.MustCompile(`^09\d{9}$`)
+
+func (s *Svc) ELoadValidate(ctx context.Context, req *epb.ELoadValidateRequest) (*epb.ELoadValidateResponse, error) {
+	it, mob, err := s.validate(ctx, req.GetProductCode(), req.GetAmount(), req.GetTargetMobileNo())
+	if err != nil {
+		return nil, err
+	}
+	return &epb.ELoadValidateResponse{
+		Product:        toProduct(*it),
+		TargetMobileNo: mob,
+	}, nil
+}
+
+// validate looks up the product and checks the amount matches its
+// denomination. The mobile number is returned in the 09XXXXXXXXX format
+// perahub expects.
+func (s *Svc) validate(ctx context.Context, code, amt, mob string) (*perahub.Item, string, error) {
+	mob = normalizeMobileNo(mob)
+	if !mobileNo.MatchString(mob) {
+		return nil, "", coreerror.NewCoreError(codes.InvalidArgument, "invalid target mobile number")
+	}
+	a, err := currency.NewAmount(amt, currencyCode)
+	if err != nil || !a.IsPositive() {
+		return nil, "", coreerror.NewCoreError(codes.InvalidArgument, "invalid amount")
+	}
+
+	items, err := s.catalog(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, it := range items {
+		if !strings.EqualFold(it.ProductCode, code) {
+			continue
+		}
+		pa, err := currency.NewAmount(it.Amount, currencyCode)
+		if err != nil || !pa.Equal(a) {
+			return nil, "", coreerror.NewCoreError(codes.InvalidArgument, "amount does not match the product amount")
+		}
+		return &it, mob, nil
+	}
+	return nil, "", coreerror.NewCoreError(codes.InvalidArgument, "unknown product code")
+}
+
+func normalizeMobileNo(mob string) string {
+	mob = strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(mob))
+	switch {
+	case strings.HasPrefix(mob, "+63"):
+		return "0" + mob[3:]
+	case strings.HasPrefix(mob, "63") && len(mob) == 12:
+		return "0" + mob[2:]
+	}
+	return mob
+}
@@ -0,0 +1,65 @@
+package cebuana
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const (
+	agentCode  = "01030063" // todo: will change, gotten from petnet
+	currencyID = "6"
+)
+
+func (s *Svc) FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]string, error) {
+	log := logging.FromContext(ctx)
+
+	chg, err := s.inquire(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := r.PrincipalAmount.Add(chg)
+	if err != nil {
+		logging.WithError(err, log).Error("adding service fee to principal")
+		return nil, err
+	}
+
+	return map[string]string{
+		"principal_amount": r.PrincipalAmount.Number(),
+		"service_charge":   chg.Number(),
+		"total_amount":     ttl.Number(),
+	}, nil
+}
+
+// inquire returns the service fee Cebuana charges for sending the principal.
+func (s *Svc) inquire(ctx context.Context, r core.FeeInquiryReq) (currency.Minor, error) {
+	log := logging.FromContext(ctx)
+
+	fee, err := s.ph.CebuanaSFInquiry(ctx, perahub.CebuanaSFInquiryRequest{
+		PrincipalAmount: json.Number(r.PrincipalAmount.Amount.Round().Number()),
+		CurrencyID:      currencyID,
+		AgentCode:       agentCode,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("fee inquiry")
+		return currency.Minor{}, err
+	}
+	if fee.Message != perahub.CebuanaSFInquire {
+		log.WithField("message", fee.Message).Error("fee inquiry unsuccessful")
+		return currency.Minor{}, status.Error(codes.Internal, "fee inquiry unsuccessful")
+	}
+
+	chg, err := currency.NewAmount(fee.Result.ServiceFee, r.PrincipalAmount.CurrencyCode())
+	if err != nil {
+		logging.WithError(err, log).Error("invalid service fee amount")
+		return currency.Minor{}, err
+	}
+	return currency.ToMinor(chg.Round()), nil
+}
@@ -0,0 +1,28 @@
+package cebuana
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+)
+
+// Quote normalizes the service fee inquiry. Cebuana only sends pesos for cash
+// pickup so the receive amount is the principal.
+func (s *Svc) Quote(ctx context.Context, r core.FeeInquiryReq) (*core.PartnerQuote, error) {
+	if r.DestCountry != "PH" || r.DestCurrency != "PHP" {
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "cebuana only sends pesos within the philippines")
+	}
+	chg, err := s.inquire(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return &core.PartnerQuote{
+		DeliveryMethod: core.CashPickup,
+		Fee:            chg,
+		ExchangeRate:   "1",
+		ReceiveAmount:  r.PrincipalAmount,
+	}, nil
+}
@@ -0,0 +1,130 @@
+package fee
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// CompareQuotes asks the partners of the requests for a quote concurrently.
+// Requests for partners without quote support are skipped. Partners that fail
+// or don't answer within their quote timeout are listed with Err set, after
+// the quotes ordered by the highest receive amount and then the lowest fee.
+func (s *Svc) CompareQuotes(ctx context.Context, rs []core.FeeInquiryReq) []core.PartnerQuote {
+	log := logging.FromContext(ctx)
+	type result struct {
+		i  int
+		pq core.PartnerQuote
+	}
+	ch := make(chan result, len(rs))
+	pending := map[int]core.FeeInquiryReq{}
+	var wait time.Duration
+	for i, r := range rs {
+		q, ok := s.quoters[r.RemitPartner]
+		if !ok {
+			continue
+		}
+		pending[i] = r
+		d := s.partnerQuoteTimeout(r.RemitPartner)
+		if d > wait {
+			wait = d
+		}
+		go func(i int, r core.FeeInquiryReq, d time.Duration) {
+			qctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			pq, err := q.Quote(qctx, r)
+			if err != nil && qctx.Err() == context.DeadlineExceeded {
+				err = errQuoteTimeout()
+			}
+			if err != nil {
+				logging.WithError(err, log).WithField("partner", r.RemitPartner).Error("partner quote")
+				ch <- result{i: i, pq: failedQuote(r, err)}
+				return
+			}
+			pq.RemitPartner, pq.RemitType = r.RemitPartner, r.RemitType.Code
+			ch <- result{i: i, pq: *pq}
+		}(i, r, d)
+	}
+
+	// a partner ignoring its deadline is not waited for past the longest one
+	wctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+	qs := make([]core.PartnerQuote, 0, len(pending))
+collect:
+	for len(pending) > 0 {
+		select {
+		case res := <-ch:
+			delete(pending, res.i)
+			qs = append(qs, res.pq)
+		case <-wctx.Done():
+			break collect
+		}
+	}
+	for _, r := range pending {
+		log.WithField("partner", r.RemitPartner).Error("partner quote timed out")
+		qs = append(qs, failedQuote(r, errQuoteTimeout()))
+	}
+	sortQuotes(qs)
+	return qs
+}
+
+// partnerQuoteTimeout is how long the partner is given to quote.
+func (s *Svc) partnerQuoteTimeout(partner string) time.Duration {
+	if d, ok := s.quoteTimeouts[partner]; ok {
+		return d
+	}
+	return s.quoteTimeout
+}
+
+func errQuoteTimeout() error {
+	return coreerror.NewCoreError(codes.DeadlineExceeded, "partner did not quote in time")
+}
+
+func failedQuote(r core.FeeInquiryReq, err error) core.PartnerQuote {
+	return core.PartnerQuote{
+		RemitPartner: r.RemitPartner,
+		RemitType:    r.RemitType.Code,
+		Err:          err,
+	}
+}
+
+// sortQuotes puts the best deal for the customer first.
+func sortQuotes(qs []core.PartnerQuote) {
+	sort.SliceStable(qs, func(i, j int) bool {
+		a, b := qs[i], qs[j]
+		if (a.Err == nil) != (b.Err == nil) {
+			return a.Err == nil
+		}
+		if a.Err != nil {
+			return a.RemitPartner < b.RemitPartner
+		}
+		if c := cmpMinor(a.ReceiveAmount, b.ReceiveAmount); c != 0 {
+			return c > 0
+		}
+		if c := cmpMinor(a.Fee, b.Fee); c != 0 {
+			return c < 0
+		}
+		return a.RemitPartner < b.RemitPartner
+	})
+}
+
+// cmpMinor compares amounts in minor units, a missing amount is the lowest.
+func cmpMinor(a, b currency.Minor) int {
+	x, y := a.MinorUnits(), b.MinorUnits()
+	switch {
+	case x == nil && y == nil:
+		return 0
+	case x == nil:
+		return -1
+	case y == nil:
+		return 1
+	}
+	return x.Cmp(y)
+}
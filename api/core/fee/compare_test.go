@@ -0,0 +1,82 @@
+package fee
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"brank.as/petnet/api/core"
+)
+
+type fakeQuoter struct {
+	kind  string
+	delay time.Duration
+	fee   string
+	rcv   string
+	err   error
+}
+
+func (f *fakeQuoter) Kind() string { return f.kind }
+
+func (f *fakeQuoter) Quote(ctx context.Context, r core.FeeInquiryReq) (*core.PartnerQuote, error) {
+	select {
+	case <-time.After(f.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &core.PartnerQuote{
+		DeliveryMethod: core.CashPickup,
+		Fee:            core.MustMinor(f.fee, "PHP"),
+		ReceiveAmount:  core.MustMinor(f.rcv, "PHP"),
+	}, nil
+}
+
+func TestCompareQuotes(t *testing.T) {
+	s := &Svc{quoters: map[string]Quoter{}, quoteTimeout: 100 * time.Millisecond}
+	WithQuoters(
+		&fakeQuoter{kind: "A", fee: "5000", rcv: "100000"},
+		&fakeQuoter{kind: "B", fee: "3000", rcv: "100000"},
+		&fakeQuoter{kind: "C", fee: "1000", rcv: "99000"},
+		&fakeQuoter{kind: "SLOW", delay: time.Second, fee: "0", rcv: "200000"},
+		&fakeQuoter{kind: "ERR", err: errors.New("partner down")},
+		&fakeQuoter{kind: "PATIENT", delay: 200 * time.Millisecond, fee: "0", rcv: "150000"},
+	)(s)
+	WithPartnerQuoteTimeout("PATIENT", 400*time.Millisecond)(s)
+
+	var rs []core.FeeInquiryReq
+	for _, p := range []string{"A", "B", "C", "SLOW", "ERR", "PATIENT", "NOQUOTE"} {
+		rs = append(rs, core.FeeInquiryReq{RemitPartner: p, RemitType: core.SendRemitType{Code: "SO"}})
+	}
+	start := time.Now()
+	got := s.CompareQuotes(context.Background(), rs)
+	if el := time.Since(start); el > 500*time.Millisecond {
+		t.Errorf("CompareQuotes() = took %s, want the slow partner cut off", el)
+	}
+
+	want := []struct {
+		partner string
+		failed  bool
+	}{
+		{"PATIENT", false}, // answers within its own timeout
+		{"B", false},       // same receive amount as A, lower fee
+		{"A", false},
+		{"C", false},
+		{"ERR", true},
+		{"SLOW", true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CompareQuotes() = got %d quotes, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].RemitPartner != w.partner || (got[i].Err != nil) != w.failed {
+			t.Errorf("CompareQuotes()[%d] = got %s with error %v, want %s failed %t", i, got[i].RemitPartner, got[i].Err, w.partner, w.failed)
+		}
+		if got[i].RemitType != "SO" {
+			t.Errorf("CompareQuotes()[%d] = got remit type %q, want SO", i, got[i].RemitType)
+		}
+	}
+}
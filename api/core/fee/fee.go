@@ -3,12 +3,18 @@ package fee
 import (
 	"context"
 	"log"
+	"time"
 
 	"brank.as/petnet/api/core"
+	cebf "brank.as/petnet/api/core/fee/cebuana"
+	"brank.as/petnet/api/core/fee/feetable"
 	usscf "brank.as/petnet/api/core/fee/ussc"
 	wuf "brank.as/petnet/api/core/fee/wu"
+	"brank.as/petnet/api/core/static"
 	"brank.as/petnet/api/integration/perahub"
 	"brank.as/petnet/api/storage/postgres"
+
+	fpb "brank.as/petnet/gunk/dsa/v2/fees"
 )
 
 type Fee interface {
@@ -16,17 +22,80 @@ type Fee interface {
 	Kind() string
 }
 
+// Quoter is implemented by partners that report a normalized quote for
+// CompareQuotes. Fees implementing it are registered automatically.
+type Quoter interface {
+	Quote(ctx context.Context, r core.FeeInquiryReq) (*core.PartnerQuote, error)
+	Kind() string
+}
+
 type Svc struct {
-	fee map[string]Fee
-	st  *postgres.Storage
-	ph  *perahub.Svc
+	fee          map[string]Fee
+	quoters      map[string]Quoter
+	quoteTimeout time.Duration
+	// quoteTimeouts overrides quoteTimeout by partner code.
+	quoteTimeouts map[string]time.Duration
+	st            *postgres.Storage
+	ph            *perahub.Svc
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithQuoters adds partners that quote without a fee inquiry.
+func WithQuoters(qs ...Quoter) Option {
+	return func(s *Svc) {
+		for _, q := range qs {
+			s.quoters[q.Kind()] = q
+		}
+	}
+}
+
+// WithFeeTable serves the fee inquiries of the sending partners without a
+// live fee endpoint from the fee tables configured for the DSA.
+func WithFeeTable(cl fpb.OrgFeesServiceClient) Option {
+	return func(s *Svc) {
+		for _, p := range static.Partners["PH"] {
+			if len(p.SendTypes) == 0 {
+				continue
+			}
+			if _, ok := s.fee[p.Code]; !ok {
+				s.fee[p.Code] = feetable.New(cl, p.Code)
+			}
+		}
+	}
+}
+
+// WithQuoteTimeout sets how long CompareQuotes waits for the partners.
+func WithQuoteTimeout(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.quoteTimeout = d
+		}
+	}
+}
+
+// WithPartnerQuoteTimeout sets how long CompareQuotes waits for the partner,
+// overriding the quote timeout.
+func WithPartnerQuoteTimeout(partner string, d time.Duration) Option {
+	return func(s *Svc) {
+		if d <= 0 {
+			return
+		}
+		if s.quoteTimeouts == nil {
+			s.quoteTimeouts = map[string]time.Duration{}
+		}
+		s.quoteTimeouts[partner] = d
+	}
 }
 
-func New(st *postgres.Storage, ph *perahub.Svc) *Svc {
-	fs := []Fee{wuf.New(ph), usscf.New(ph)}
+func New(st *postgres.Storage, ph *perahub.Svc, opts ...Option) *Svc {
+	fs := []Fee{wuf.New(ph), usscf.New(ph), cebf.New(ph)}
 	s := &Svc{
-		fee: make(map[string]Fee, len(fs)),
-		ph:  ph,
+		fee:          make(map[string]Fee, len(fs)),
+		quoters:      make(map[string]Quoter, len(fs)),
+		quoteTimeout: 5 * time.Second,
+		ph:           ph,
 	}
 	for i, r := range fs {
 		switch {
@@ -36,6 +105,12 @@ func New(st *postgres.Storage, ph *perahub.Svc) *Svc {
 			log.Fatalf("fee %d missing partner type", i)
 		}
 		s.fee[r.Kind()] = r
+		if q, ok := r.(Quoter); ok {
+			s.quoters[r.Kind()] = q
+		}
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 	return s
 }
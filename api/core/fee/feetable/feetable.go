@@ -0,0 +1,117 @@
+// Package feetable serves fee inquiries for partners without a live fee
+// endpoint from the fee tables configured for the DSA.
+package feetable
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/serviceutil/auth/hydra"
+	"brank.as/petnet/serviceutil/logging"
+	"github.com/bojanz/currency"
+
+	fpb "brank.as/petnet/gunk/dsa/v2/fees"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+)
+
+type Svc struct {
+	cl   fpb.OrgFeesServiceClient
+	kind string
+}
+
+// New fee table for the partner kind.
+func New(cl fpb.OrgFeesServiceClient, kind string) *Svc {
+	return &Svc{
+		cl:   cl,
+		kind: kind,
+	}
+}
+
+func (s *Svc) Kind() string {
+	return s.kind
+}
+
+func (s *Svc) FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]string, error) {
+	log := logging.FromContext(ctx)
+
+	oid := getDSAOrgID(ctx)
+	if oid == "" {
+		return nil, status.Error(codes.PermissionDenied, "missing org id")
+	}
+	res, err := s.cl.ListFees(ctx, &fpb.ListFeesRequest{
+		OrgID: oid,
+		Type:  fpb.FeeType_TypeFee.String(),
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("listing dsa fees")
+		return nil, err
+	}
+
+	chg, err := charge(res.GetFees(), r.PrincipalAmount.Amount)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := r.PrincipalAmount.Add(chg)
+	if err != nil {
+		logging.WithError(err, log).Error("adding service charge to principal")
+		return nil, err
+	}
+
+	return map[string]string{
+		"principal_amount": r.PrincipalAmount.Number(),
+		"service_charge":   chg.Number(),
+		"total_amount":     ttl.Number(),
+	}, nil
+}
+
+// charge returns the rate of the active fee whose volume band covers the
+// amount. A rate without a max volume covers every amount above its min.
+func charge(fs []*fpb.Fee, amt currency.Amount) (currency.Minor, error) {
+	cur := amt.CurrencyCode()
+	for _, f := range fs {
+		if f.GetType() != fpb.FeeType_TypeFee || f.GetSchedule().GetStatus() != fpb.FeeStatus_Active {
+			continue
+		}
+		for _, r := range f.GetRates() {
+			min, err := currency.NewAmount(r.GetMinVolume(), cur)
+			if err != nil {
+				continue
+			}
+			if c, _ := amt.Cmp(min); c < 0 {
+				continue
+			}
+			if r.GetMaxVolume() != "" {
+				max, err := currency.NewAmount(r.GetMaxVolume(), cur)
+				if err != nil {
+					continue
+				}
+				if c, _ := amt.Cmp(max); c > 0 {
+					continue
+				}
+			}
+			chg, err := currency.NewAmount(r.GetTxnRate(), cur)
+			if err != nil {
+				return currency.Minor{}, status.Error(codes.Internal, "invalid fee rate configured")
+			}
+			return currency.ToMinor(chg.Round()), nil
+		}
+	}
+	return currency.Minor{}, status.Error(codes.NotFound, "no fee configured for the amount")
+}
+
+func getDSAOrgID(ctx context.Context) string {
+	ot := phmw.GetOrgType(ctx)
+	switch ot {
+	// this happens when API is used internally and means that either the dsa
+	// or admin platform is used to get the fees
+	case ppb.OrgType_PetNet.String(), ppb.OrgType_DSA.String():
+		return phmw.GetDSAOrgID(ctx)
+	}
+	// this happens when API is used externally and means the user authenticated
+	// with api client credentials to get token
+	return hydra.OrgID(ctx)
+}
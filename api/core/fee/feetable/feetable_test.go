@@ -0,0 +1,68 @@
+package feetable
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+
+	fpb "brank.as/petnet/gunk/dsa/v2/fees"
+)
+
+func TestCharge(t *testing.T) {
+	active := &fpb.Schedule{Status: fpb.FeeStatus_Active}
+	fs := []*fpb.Fee{
+		{
+			Type:     fpb.FeeType_TypeFee,
+			Schedule: &fpb.Schedule{Status: fpb.FeeStatus_Disabled},
+			Rates:    []*fpb.Rate{{MinVolume: "0", MaxVolume: "100000", TxnRate: "99"}},
+		},
+		{
+			Type:     fpb.FeeType_TypeCommission,
+			Schedule: active,
+			Rates:    []*fpb.Rate{{MinVolume: "0", MaxVolume: "100000", TxnRate: "98"}},
+		},
+		{
+			Type:     fpb.FeeType_TypeFee,
+			Schedule: active,
+			Rates: []*fpb.Rate{
+				{MinVolume: "1", MaxVolume: "1000", TxnRate: "25"},
+				{MinVolume: "1000.01", MaxVolume: "5000", TxnRate: "50.50"},
+				{MinVolume: "5000.01", TxnRate: "100"},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc string
+		amt  string
+		want string
+		code codes.Code
+	}{
+		{desc: "Lowest Band", amt: "50000", want: "2500"},
+		{desc: "Band Edge", amt: "100000", want: "2500"},
+		{desc: "Middle Band", amt: "100001", want: "5050"},
+		{desc: "Open Band", amt: "10000000", want: "10000"},
+		{desc: "Below Bands", amt: "50", code: codes.NotFound},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			got, err := charge(fs, core.MustMinor(test.amt, "PHP").Amount)
+			if test.code != codes.OK {
+				if status.Code(err) != test.code {
+					t.Fatalf("want code %v, got error %v", test.code, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Number() != test.want {
+				t.Errorf("want charge %s, got %s", test.want, got.Number())
+			}
+		})
+	}
+}
@@ -12,16 +12,8 @@ import (
 func (s *Svc) FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]string, error) {
 	log := logging.FromContext(ctx)
 
-	fee, err := s.ph.USSCFeeInquiry(ctx, perahub.USSCFeeInquiryRequest{
-		// don't change, should be statically empty
-		Panalokard: "",
-		Amount:     r.PrincipalAmount.Amount.Number(),
-		// don't change, should be statically empty
-		USSCPromo:  "",
-		BranchCode: "branch1", // todo: will change, gotten from petnet
-	})
+	fee, err := s.inquire(ctx, r)
 	if err != nil {
-		logging.WithError(err, log).Error("fee inquiry")
 		return nil, err
 	}
 
@@ -54,3 +46,21 @@ func (s *Svc) FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]
 		"send_otp":         fee.Result.SendOTP,
 	}, nil
 }
+
+func (s *Svc) inquire(ctx context.Context, r core.FeeInquiryReq) (*perahub.USSCFeeInquiryRespBody, error) {
+	log := logging.FromContext(ctx)
+
+	fee, err := s.ph.USSCFeeInquiry(ctx, perahub.USSCFeeInquiryRequest{
+		// don't change, should be statically empty
+		Panalokard: "",
+		Amount:     r.PrincipalAmount.Amount.Number(),
+		// don't change, should be statically empty
+		USSCPromo:  "",
+		BranchCode: "branch1", // todo: will change, gotten from petnet
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("fee inquiry")
+		return nil, err
+	}
+	return fee, nil
+}
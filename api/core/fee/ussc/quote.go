@@ -0,0 +1,37 @@
+package ussc
+
+import (
+	"context"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+)
+
+// Quote normalizes the fee inquiry. USSC only sends pesos for cash pickup so
+// the receive amount is the principal.
+func (s *Svc) Quote(ctx context.Context, r core.FeeInquiryReq) (*core.PartnerQuote, error) {
+	if r.DestCountry != "PH" || r.DestCurrency != "PHP" {
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "ussc only sends pesos within the philippines")
+	}
+	fee, err := s.inquire(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	pnpl, err := currency.NewAmount(fee.Result.PnplAmount, "PHP")
+	if err != nil {
+		return nil, err
+	}
+	chg, err := currency.NewAmount(fee.Result.ServiceCharge, "PHP")
+	if err != nil {
+		return nil, err
+	}
+	return &core.PartnerQuote{
+		DeliveryMethod: core.CashPickup,
+		Fee:            currency.ToMinor(chg.Round()),
+		ExchangeRate:   "1",
+		ReceiveAmount:  currency.ToMinor(pnpl.Round()),
+	}, nil
+}
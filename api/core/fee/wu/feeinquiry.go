@@ -18,32 +18,8 @@ const (
 )
 
 func (s *Svc) FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]string, error) {
-	log := logging.FromContext(ctx)
-
-	fmFlag := "N"
-	if r.DestinationAmount {
-		fmFlag = "F"
-	}
-
-	usrMsg := strings.Split(r.Message, "\\n")
-	fee, err := s.ph.FeeInquiry(ctx, perahub.FIRequest{
-		FrgnRefNo:       random.InvitationCode(20),
-		PrincipalAmount: json.Number(r.PrincipalAmount.Number()),
-		FixedAmountFlag: fmFlag,
-		DestCountry:     r.DestCountry,
-		DestCurrency:    r.DestCurrency,
-		TransactionType: static.WUTxType(r.RemitType.Code),
-		PromoCode:       r.Promo,
-		Message:         usrMsg,
-		// todo(robin): enable dynamic terminalid and operatorid once petnet has set it up for
-		// sandbox
-		// TerminalID:      phmw.GetTerminalID(ctx),
-		// OperatorID:      phmw.GetOperatorID(ctx),
-		TerminalID: terminalID,
-		OperatorID: operatorID,
-	})
+	fee, err := s.inquire(ctx, r)
 	if err != nil {
-		logging.WithError(err, log).Error("fee inquiry")
 		return nil, err
 	}
 
@@ -74,3 +50,35 @@ func (s *Svc) FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]
 		"incremental_message_limit":      fee.IncMsgLimit,
 	}, nil
 }
+
+func (s *Svc) inquire(ctx context.Context, r core.FeeInquiryReq) (*perahub.FIResponseBody, error) {
+	log := logging.FromContext(ctx)
+
+	fmFlag := "N"
+	if r.DestinationAmount {
+		fmFlag = "F"
+	}
+
+	usrMsg := strings.Split(r.Message, "\\n")
+	fee, err := s.ph.FeeInquiry(ctx, perahub.FIRequest{
+		FrgnRefNo:       random.InvitationCode(20),
+		PrincipalAmount: json.Number(r.PrincipalAmount.Number()),
+		FixedAmountFlag: fmFlag,
+		DestCountry:     r.DestCountry,
+		DestCurrency:    r.DestCurrency,
+		TransactionType: static.WUTxType(r.RemitType.Code),
+		PromoCode:       r.Promo,
+		Message:         usrMsg,
+		// todo(robin): enable dynamic terminalid and operatorid once petnet has set it up for
+		// sandbox
+		// TerminalID:      phmw.GetTerminalID(ctx),
+		// OperatorID:      phmw.GetOperatorID(ctx),
+		TerminalID: terminalID,
+		OperatorID: operatorID,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("fee inquiry")
+		return nil, err
+	}
+	return fee, nil
+}
@@ -0,0 +1,55 @@
+package wu
+
+import (
+	"context"
+
+	"github.com/bojanz/currency"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/core/static"
+)
+
+// Quote normalizes the fee inquiry. The fee is everything charged on top of
+// the principal, taxes and message charges included.
+func (s *Svc) Quote(ctx context.Context, r core.FeeInquiryReq) (*core.PartnerQuote, error) {
+	fee, err := s.inquire(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	oc := fee.OrigCurrency
+	if oc == "" {
+		oc = r.PrincipalAmount.CurrencyCode()
+	}
+	gross, err := currency.NewMinor(fee.GrossTotal.String(), oc)
+	if err != nil {
+		return nil, err
+	}
+	pnpl, err := currency.NewMinor(fee.OrigPrincipal.String(), oc)
+	if err != nil {
+		return nil, err
+	}
+	chg, err := gross.Sub(pnpl)
+	if err != nil {
+		return nil, err
+	}
+	rcv, err := currency.NewMinor(fee.DestPrincipal.String(), r.DestCurrency)
+	if err != nil {
+		return nil, err
+	}
+	return &core.PartnerQuote{
+		DeliveryMethod: deliveryMethod(r.RemitType.Code),
+		Fee:            chg,
+		ExchangeRate:   fee.ExchangeRate.String(),
+		ReceiveAmount:  rcv,
+	}, nil
+}
+
+func deliveryMethod(code string) string {
+	switch code {
+	case static.WUDirectBank:
+		return core.BankDeposit
+	case static.WUMobileTransfer:
+		return core.MobileWallet
+	}
+	return core.CashPickup
+}
@@ -30,6 +30,11 @@ func (s *MICoreSvc) GetTransactionList(ctx context.Context, req *migunk.GetTrans
 		return nil, coreerror.NewCoreError(codes.InvalidArgument, "invalid date to")
 	}
 
+	after, err := storage.ParseCursor(req.GetPageToken())
+	if err != nil {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "invalid page token")
+	}
+
 	res, err := s.storage.ListMicroInsuranceHistory(ctx, storage.MicroInsuranceFilter{
 		From:         dateFrom,
 		Until:        dateTo,
@@ -37,6 +42,11 @@ func (s *MICoreSvc) GetTransactionList(ctx context.Context, req *migunk.GetTrans
 		OrgID:        req.OrgID,
 		SortOrder:    storage.SortOrder(req.GetSortOrder().String()),
 		SortByColumn: storage.MicroInsuranceSort(req.GetSortByColumn().String()),
+		Limit:        int(req.GetLimit()),
+		Offset:       int(req.GetOffset()),
+		BranchID:     req.GetBranchID(),
+		TellerID:     req.GetTellerID(),
+		After:        after,
 	})
 	if err != nil {
 		if err == storage.ErrNotFound {
@@ -46,7 +56,17 @@ func (s *MICoreSvc) GetTransactionList(ctx context.Context, req *migunk.GetTrans
 		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDRPInternalError)
 	}
 
-	return toTransactionListResult(res, log), nil
+	lr := toTransactionListResult(res, log)
+	if len(res) > 0 {
+		last := res[len(res)-1]
+		lr.Next = req.GetOffset() + int32(len(res))
+		lr.Total = int32(res[0].Total)
+		lr.NextPageToken = storage.NextPageToken(req.GetSortByColumn().String(), int(req.GetLimit()), len(res), storage.Cursor{
+			Created: last.Created,
+			ID:      last.ID,
+		})
+	}
+	return lr, nil
 }
 
 func toTransactionListResult(res []storage.MicroInsuranceHistory, log *logrus.Entry) *migunk.TransactionListResult {
@@ -126,6 +146,8 @@ func toTransactionListResult(res []storage.MicroInsuranceHistory, log *logrus.En
 			ProvinceCode:   h.ProvinceCode,
 			CityCode:       h.CityCode,
 			Address:        h.Address,
+			BranchID:       h.BranchID,
+			TellerID:       h.TellerID,
 		}
 
 		list = append(list, trans)
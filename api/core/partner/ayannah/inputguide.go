@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
 )
 
+// OtherInfoFieldsAYA are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsAYA = []*ppb.Input{
 	{Name: "address"},
 	{Name: "city"},
@@ -25,10 +26,6 @@ var OtherInfoFieldsAYA = []*ppb.Input{
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
 	return &ppb.InputGuideResponse{
-		InputGuide: map[string]*ppb.Guide{
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsAYA,
-			},
-		},
+		InputGuide: map[string]*ppb.Guide{},
 	}, nil
 }
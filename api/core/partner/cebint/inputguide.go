@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
 )
 
+// OtherInfoFieldsCEBINT are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsCEBINT = []*ppb.Input{
 	{Name: "beneficiary_id"},
 	{Name: "birth_date"},
@@ -24,10 +25,6 @@ var OtherInfoFieldsCEBINT = []*ppb.Input{
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
 	return &ppb.InputGuideResponse{
-		InputGuide: map[string]*ppb.Guide{
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsCEBINT,
-			},
-		},
+		InputGuide: map[string]*ppb.Guide{},
 	}, nil
 }
@@ -12,8 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// OtherInfoFieldsCEB ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsCEB are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsCEB = []*ppb.Input{
 	{Name: "beneficiary_id"},
 	{Name: "birth_date"},
@@ -36,70 +36,11 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			logging.WithError(err, log).Error("get input guide from storage")
 			return nil, status.Error(codes.Internal, "processing")
 		}
-		// get Countries
-		ips := make(storage.InputGuideData)
-		cntryRes, err := s.ph.CEBgetCountries(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get countries")
-			return nil, err
-		}
-		ip := make([]storage.Input, len(cntryRes.Result.Country))
-		for i, v := range cntryRes.Result.Country {
-			ip[i] = storage.Input{
-				Value:       string(v.CountryID),
-				Name:        v.CountryName,
-				CountryCode: v.CountryCodeAplha2,
-			}
-		}
-		ips[storage.IGCountryGroup] = ip
-
-		// get Currencies
-		stateRes, err := s.ph.CEBgetCurrencies(ctx, &perahub.CEBCurrencyReq{
-			AgentCode: req.AgentCode,
-		}) // agent code
-		if err != nil {
-			logging.WithError(err, log).Error("get states")
-			return nil, err
-		}
-		ip = make([]storage.Input, 1)
-		ip[0] = storage.Input{
-			Value:        string(stateRes.Result.Currency.CurrencyID),
-			Description:  stateRes.Result.Currency.Description,
-			CurrencyCode: stateRes.Result.Currency.Code,
-		}
-		ips[storage.IGCurrencyGroup] = ip
 
-		// get Source of fund
-		fundsRes, err := s.ph.CEBgetSourceFunds(ctx)
+		ips, err := s.fetchInputGuide(ctx, req.AgentCode)
 		if err != nil {
-			logging.WithError(err, log).Error("get Currencies")
 			return nil, err
 		}
-		ip = make([]storage.Input, len(fundsRes.Result.ClientSourceOfFund))
-		for i, v := range fundsRes.Result.ClientSourceOfFund {
-			ip[i] = storage.Input{
-				Value: string(v.SourceOfFundID),
-				Name:  v.SourceOfFund,
-			}
-		}
-		ips[storage.IGFundsGroup] = ip
-
-		// get identification types
-		idTypesRes, err := s.ph.CEBgetIdTypes(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get Currencies")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(*idTypesRes))
-		for i, v := range *idTypesRes {
-			ip[i] = storage.Input{
-				Value:       string(v.IdentificationTypeID),
-				Name:        v.SmsCode,
-				Description: v.Description,
-			}
-		}
-		ips[storage.IGIDsGroup] = ip
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
 			Data:    ips,
@@ -129,9 +70,87 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 				Field:  "receiver.identification.type,sender.identification.type",
 				Inputs: ig.ToGuide(storage.IGIDsGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsCEB,
-			},
 		},
 	}, nil
 }
+
+// RefreshInputGuide fetches the input guide from the partner again. The
+// currencies depend on the agent of the request and are kept as cached.
+func (s *Svc) RefreshInputGuide(ctx context.Context, old storage.InputGuideData) (storage.InputGuideData, error) {
+	ips, err := s.fetchInputGuide(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := old[storage.IGCurrencyGroup]; ok {
+		ips[storage.IGCurrencyGroup] = c
+	}
+	return ips, nil
+}
+
+func (s *Svc) fetchInputGuide(ctx context.Context, agentCode string) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	// get Countries
+	ips := make(storage.InputGuideData)
+	cntryRes, err := s.ph.CEBgetCountries(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get countries")
+		return nil, err
+	}
+	ip := make([]storage.Input, len(cntryRes.Result.Country))
+	for i, v := range cntryRes.Result.Country {
+		ip[i] = storage.Input{
+			Value:       string(v.CountryID),
+			Name:        v.CountryName,
+			CountryCode: v.CountryCodeAplha2,
+		}
+	}
+	ips[storage.IGCountryGroup] = ip
+
+	// get Currencies, these are only available for an agent
+	if agentCode != "" {
+		stateRes, err := s.ph.CEBgetCurrencies(ctx, &perahub.CEBCurrencyReq{
+			AgentCode: agentCode,
+		}) // agent code
+		if err != nil {
+			logging.WithError(err, log).Error("get states")
+			return nil, err
+		}
+		ips[storage.IGCurrencyGroup] = []storage.Input{{
+			Value:        string(stateRes.Result.Currency.CurrencyID),
+			Description:  stateRes.Result.Currency.Description,
+			CurrencyCode: stateRes.Result.Currency.Code,
+		}}
+	}
+
+	// get Source of fund
+	fundsRes, err := s.ph.CEBgetSourceFunds(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get Currencies")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(fundsRes.Result.ClientSourceOfFund))
+	for i, v := range fundsRes.Result.ClientSourceOfFund {
+		ip[i] = storage.Input{
+			Value: string(v.SourceOfFundID),
+			Name:  v.SourceOfFund,
+		}
+	}
+	ips[storage.IGFundsGroup] = ip
+
+	// get identification types
+	idTypesRes, err := s.ph.CEBgetIdTypes(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get Currencies")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(*idTypesRes))
+	for i, v := range *idTypesRes {
+		ip[i] = storage.Input{
+			Value:       string(v.IdentificationTypeID),
+			Name:        v.SmsCode,
+			Description: v.Description,
+		}
+	}
+	ips[storage.IGIDsGroup] = ip
+	return ips, nil
+}
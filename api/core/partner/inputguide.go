@@ -5,7 +5,9 @@ import (
 
 	"brank.as/petnet/api/core"
 	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
+	"brank.as/petnet/serviceutil/logging"
 	"google.golang.org/grpc/codes"
 )
 
@@ -14,5 +16,15 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 	if !ok {
 		return nil, coreerror.NewCoreError(codes.NotFound, "missing input guide for partner")
 	}
-	return g.InputGuide(ctx, req)
+	res, err := g.InputGuide(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	oi, err := s.otherInfoGuide(ctx, req.Ptnr)
+	if err != nil {
+		logging.WithError(err, logging.FromContext(ctx)).Error("get other info fields")
+		return nil, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	res.InputGuide[storage.IGOtherInfoLabel] = oi
+	return res, nil
 }
@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
 )
 
+// OtherInfoFieldsIC are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsIC = []*ppb.Input{
 	{Name: "destination_country"},
 	{Name: "originating_country"},
@@ -20,10 +21,6 @@ var OtherInfoFieldsIC = []*ppb.Input{
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
 	return &ppb.InputGuideResponse{
-		InputGuide: map[string]*ppb.Guide{
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsIC,
-			},
-		},
+		InputGuide: map[string]*ppb.Guide{},
 	}, nil
 }
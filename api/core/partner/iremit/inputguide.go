@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
 )
 
+// OtherInfoFieldsIR are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsIR = []*ppb.Input{
 	{Name: "address"},
 	{Name: "contact_number"},
@@ -22,10 +23,6 @@ var OtherInfoFieldsIR = []*ppb.Input{
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
 	return &ppb.InputGuideResponse{
-		InputGuide: map[string]*ppb.Guide{
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsIR,
-			},
-		},
+		InputGuide: map[string]*ppb.Guide{},
 	}, nil
 }
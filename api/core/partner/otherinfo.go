@@ -0,0 +1,58 @@
+package partner
+
+import (
+	"context"
+	"regexp"
+
+	"google.golang.org/grpc/codes"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/storage"
+	ppb "brank.as/petnet/gunk/drp/v1/partner"
+)
+
+// OtherInfoFields lists the other info fields of a partner. The defaults are
+// seeded by migration.
+func (s *Svc) OtherInfoFields(ctx context.Context, partner string) ([]storage.OtherInfoField, error) {
+	return s.st.ListOtherInfoField(ctx, partner)
+}
+
+// SetOtherInfoField adds or replaces an other info field of a partner.
+func (s *Svc) SetOtherInfoField(ctx context.Context, f storage.OtherInfoField) (*storage.OtherInfoField, error) {
+	switch f.Type {
+	case "", storage.OtherInfoText, storage.OtherInfoNumber, storage.OtherInfoDate, storage.OtherInfoEmail:
+	default:
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "invalid field type")
+	}
+	if _, err := regexp.Compile(f.Pattern); err != nil {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "invalid field pattern")
+	}
+	return s.st.UpsertOtherInfoField(ctx, f)
+}
+
+// DeleteOtherInfoField removes an other info field of a partner.
+func (s *Svc) DeleteOtherInfoField(ctx context.Context, partner, name string) error {
+	return s.st.DeleteOtherInfoField(ctx, partner, name)
+}
+
+// otherInfoGuide lists the other info fields of a partner for its input guide.
+func (s *Svc) otherInfoGuide(ctx context.Context, partner string) (*ppb.Guide, error) {
+	fs, err := s.OtherInfoFields(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	ips := make([]*ppb.Input, 0, len(fs))
+	for _, f := range fs {
+		ip := &ppb.Input{
+			Name:     f.Name,
+			Type:     f.Type,
+			Required: f.Required,
+			Pattern:  f.Pattern,
+		}
+		if len(f.Options) > 0 {
+			ip.Options = f.Options
+		}
+		ips = append(ips, ip)
+	}
+	return &ppb.Guide{OtherInfo: ips}, nil
+}
@@ -0,0 +1,68 @@
+package partner
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	aya "brank.as/petnet/api/core/partner/ayannah"
+	bpi "brank.as/petnet/api/core/partner/bpi"
+	cebint "brank.as/petnet/api/core/partner/cebint"
+	ceb "brank.as/petnet/api/core/partner/cebuana"
+	ic "brank.as/petnet/api/core/partner/instacash"
+	ie "brank.as/petnet/api/core/partner/intelexpress"
+	ir "brank.as/petnet/api/core/partner/iremit"
+	jpr "brank.as/petnet/api/core/partner/japanremit"
+	mb "brank.as/petnet/api/core/partner/metrobank"
+	pr "brank.as/petnet/api/core/partner/perahubremit"
+	rmg "brank.as/petnet/api/core/partner/remitly"
+	ria "brank.as/petnet/api/core/partner/ria"
+	tfg "brank.as/petnet/api/core/partner/transfast"
+	unt "brank.as/petnet/api/core/partner/uniteller"
+	ussc "brank.as/petnet/api/core/partner/ussc"
+	wise "brank.as/petnet/api/core/partner/wise"
+	wug "brank.as/petnet/api/core/partner/wu"
+	"brank.as/petnet/api/core/static"
+	ppb "brank.as/petnet/gunk/drp/v1/partner"
+)
+
+var otherInfoDefaults = map[string][]*ppb.Input{
+	static.WUCode:       wug.OtherInfoFieldsWU,
+	static.RMCode:       rmg.OtherInfoFieldsRM,
+	static.TFCode:       tfg.OtherInfoFieldsTF,
+	static.WISECode:     wise.OtherInfoFieldsWISE,
+	static.UNTCode:      unt.OtherInfoFieldsUNT,
+	static.CEBCode:      ceb.OtherInfoFieldsCEB,
+	static.USSCCode:     ussc.OtherInfoFieldsUSSC,
+	static.IRCode:       ir.OtherInfoFieldsIR,
+	static.RIACode:      ria.OtherInfoFieldsRIA,
+	static.MBCode:       mb.OtherInfoFieldsMB,
+	static.BPICode:      bpi.OtherInfoFieldsBPI,
+	static.ICCode:       ic.OtherInfoFieldsIC,
+	static.JPRCode:      jpr.OtherInfoFieldsJPR,
+	static.AYACode:      aya.OtherInfoFieldsAYA,
+	static.CEBINTCode:   cebint.OtherInfoFieldsCEBINT,
+	static.IECode:       ie.OtherInfoFieldsIE,
+	static.PerahubRemit: pr.OtherInfoFieldsPerahubRemit,
+}
+
+func TestOtherInfoDefaults(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("..", "..", "migrations", "sql", "00051_seed_other_info_field.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed := string(b)
+	s := New(nil, nil)
+	for p := range s.guiders {
+		ips, ok := otherInfoDefaults[p]
+		if !ok {
+			t.Errorf("missing default other info fields for partner %s", p)
+		}
+		for _, ip := range ips {
+			if !strings.Contains(seed, "('"+p+"', '"+ip.GetName()+"',") {
+				t.Errorf("default other info field %s of partner %s not seeded", ip.GetName(), p)
+			}
+		}
+	}
+}
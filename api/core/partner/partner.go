@@ -3,6 +3,8 @@ package partner
 import (
 	"context"
 	"log"
+	"strings"
+	"time"
 
 	"brank.as/petnet/api/core"
 	aya "brank.as/petnet/api/core/partner/ayannah"
@@ -38,17 +40,46 @@ type Guider interface {
 }
 
 type Svc struct {
-	guiders map[string]Guider
-	st      *postgres.Storage
-	ph      *perahub.Svc
+	guiders    map[string]Guider
+	refreshers map[string]Refresher
+	st         *postgres.Storage
+	ph         *perahub.Svc
+	ttl        time.Duration
+	ttls       map[string]time.Duration
 }
 
-func New(st *postgres.Storage, ph *perahub.Svc) *Svc {
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithInputGuideTTL sets how long a cached input guide is served before it's
+// refreshed from the partner.
+func WithInputGuideTTL(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.ttl = d
+		}
+	}
+}
+
+// WithPartnerInputGuideTTL overrides the input guide TTL of a partner. The
+// partner code is case insensitive, as config keys are.
+func WithPartnerInputGuideTTL(partner string, d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.ttls[strings.ToLower(partner)] = d
+		}
+	}
+}
+
+func New(st *postgres.Storage, ph *perahub.Svc, opts ...Option) *Svc {
 	gs := []Guider{wug.New(st, ph), rmg.New(st, ph), tfg.New(st, ph), wise.New(st, ph), unt.New(st, ph), ceb.New(st, ph), ussc.New(), ir.New(), ria.New(), mb.New(), bpi.New(), ic.New(), jpr.New(), aya.New(), cebint.New(), ie.New(), pr.New(st, ph)}
 	s := &Svc{
-		guiders: make(map[string]Guider, len(gs)),
-		st:      st,
-		ph:      ph,
+		guiders:    make(map[string]Guider, len(gs)),
+		refreshers: map[string]Refresher{},
+		st:         st,
+		ph:         ph,
+		ttl:        defaultInputGuideTTL,
+		ttls:       map[string]time.Duration{},
 	}
 	for i, r := range gs {
 		switch {
@@ -58,6 +89,12 @@ func New(st *postgres.Storage, ph *perahub.Svc) *Svc {
 			log.Fatalf("guider %d missing partner type", i)
 		}
 		s.guiders[r.Kind()] = r
+		if rf, ok := r.(Refresher); ok {
+			s.refreshers[r.Kind()] = rf
+		}
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
 	return s
 }
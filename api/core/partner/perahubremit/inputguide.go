@@ -12,8 +12,8 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
-// OtherInfoFieldsUNT ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsPerahubRemit are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsPerahubRemit = []*ppb.Input{
 	{Name: "address"},
 	{Name: "city"},
@@ -38,140 +38,10 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			return nil, coreerror.NewCoreError(codes.Internal, "processing")
 		}
 
-		// Get ProvincesCity List
-		ips := make(storage.InputGuideData)
-		pcRes, err := s.ph.GetProvincesCityList(ctx, perahub.GetProvincesCityListRequest{
-			ID:          req.ID,
-			PartnerCode: req.Ptnr,
-		})
+		ips, err := s.fetchInputGuide(ctx, req)
 		if err != nil {
-			logging.WithError(err, log).Error("get provinces city")
-			return nil, coreerror.ToCoreError(err)
-		}
-		pclCnt := 0
-		for _, v := range pcRes.Result {
-			pclCnt += len(v.CityList)
-		}
-		ip := make([]storage.Input, pclCnt)
-		cnt := 0
-		for _, v := range pcRes.Result {
-			for _, cl := range v.CityList {
-				ip[cnt] = storage.Input{
-					Value:     cl,
-					Name:      cl,
-					StateName: v.Province,
-				}
-				cnt++
-			}
-		}
-		ips[storage.IGProvincesCityGroup] = ip
-		// Get Brgy List
-		blRes, err := s.ph.GetBrgyList(ctx, perahub.GetBrgyListRequest{
-			City: req.City,
-		})
-		if err != nil {
-			logging.WithError(err, log).Error("get brgy")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(blRes.Result))
-		for i, v := range blRes.Result {
-			ip[i] = storage.Input{
-				Value: v.Zipcode,
-				Name:  v.Barangay,
-			}
-		}
-		ips[storage.IGBrgyGroup] = ip
-
-		// Get Purpose List
-		upRes, err := s.ph.GetUtilityPurpose(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get purpose")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(upRes.Result))
-		for i, v := range upRes.Result {
-			ip[i] = storage.Input{
-				Value: v,
-				Name:  v,
-			}
-		}
-		ips[storage.IGPurposesGroup] = ip
-
-		// Get Utility Relationship
-		urRes, err := s.ph.GetUtilityRelationship(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get relationship")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(urRes.Result))
-		for i, v := range urRes.Result {
-			ip[i] = storage.Input{
-				Value: v,
-				Name:  v,
-			}
-		}
-		ips[storage.IGRelationsGroup] = ip
-
-		// Get Utility partner
-		uplRes, err := s.ph.GetUtilityPartner(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get partner")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(uplRes.Result))
-		for i, v := range uplRes.Result {
-			ip[i] = storage.Input{
-				Value: v.PartnerCode,
-				Name:  v.PartnerName,
-			}
-		}
-		ips[storage.IGPartnerGroup] = ip
-
-		// Get Utility occupation
-		uolRes, err := s.ph.GetUtilityOccupation(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get occupation")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(uolRes.Result))
-		for i, v := range uolRes.Result {
-			ip[i] = storage.Input{
-				Value: v,
-				Name:  v,
-			}
-		}
-		ips[storage.IGOccupationsGroup] = ip
-
-		// Get Utility employement
-		uelRes, err := s.ph.GetUtilityEmployment(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get employement")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(uelRes.Result))
-		for i, v := range uelRes.Result {
-			ip[i] = storage.Input{
-				Value: v,
-				Name:  v,
-			}
+			return nil, err
 		}
-		ips[storage.IGEmploymentGroup] = ip
-
-		// Get Utility sourcefund
-		usflRes, err := s.ph.GetUtilitySourceFund(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get sourcefund")
-			return nil, coreerror.ToCoreError(err)
-		}
-		ip = make([]storage.Input, len(usflRes.Result))
-		for i, v := range usflRes.Result {
-			ip[i] = storage.Input{
-				Value: v,
-				Name:  v,
-			}
-		}
-		ips[storage.IGFundsGroup] = ip
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
 			Data:    ips,
@@ -209,9 +79,173 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			storage.IGEmploymentLabel: {
 				Inputs: ig.ToGuide(storage.IGEmploymentGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsPerahubRemit,
-			},
 		},
 	}, nil
 }
+
+func (s *Svc) fetchInputGuide(ctx context.Context, req core.InputGuideRequest) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	// Get ProvincesCity List
+	ips := make(storage.InputGuideData)
+	pcRes, err := s.ph.GetProvincesCityList(ctx, perahub.GetProvincesCityListRequest{
+		ID:          req.ID,
+		PartnerCode: req.Ptnr,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("get provinces city")
+		return nil, coreerror.ToCoreError(err)
+	}
+	pclCnt := 0
+	for _, v := range pcRes.Result {
+		pclCnt += len(v.CityList)
+	}
+	ip := make([]storage.Input, pclCnt)
+	cnt := 0
+	for _, v := range pcRes.Result {
+		for _, cl := range v.CityList {
+			ip[cnt] = storage.Input{
+				Value:     cl,
+				Name:      cl,
+				StateName: v.Province,
+			}
+			cnt++
+		}
+	}
+	ips[storage.IGProvincesCityGroup] = ip
+	// Get Brgy List
+	blRes, err := s.ph.GetBrgyList(ctx, perahub.GetBrgyListRequest{
+		City: req.City,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("get brgy")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip = make([]storage.Input, len(blRes.Result))
+	for i, v := range blRes.Result {
+		ip[i] = storage.Input{
+			Value: v.Zipcode,
+			Name:  v.Barangay,
+		}
+	}
+	ips[storage.IGBrgyGroup] = ip
+
+	uts, err := s.fetchUtilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range uts {
+		ips[k] = v
+	}
+	return ips, nil
+}
+
+// RefreshInputGuide fetches the input guide from the partner again. The
+// provinces and barangays depend on the request and are kept as cached.
+func (s *Svc) RefreshInputGuide(ctx context.Context, old storage.InputGuideData) (storage.InputGuideData, error) {
+	ips, err := s.fetchUtilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range []string{storage.IGProvincesCityGroup, storage.IGBrgyGroup} {
+		if ip, ok := old[g]; ok {
+			ips[g] = ip
+		}
+	}
+	return ips, nil
+}
+
+func (s *Svc) fetchUtilities(ctx context.Context) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	ips := make(storage.InputGuideData)
+
+	// Get Purpose List
+	upRes, err := s.ph.GetUtilityPurpose(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get purpose")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip := make([]storage.Input, len(upRes.Result))
+	for i, v := range upRes.Result {
+		ip[i] = storage.Input{
+			Value: v,
+			Name:  v,
+		}
+	}
+	ips[storage.IGPurposesGroup] = ip
+
+	// Get Utility Relationship
+	urRes, err := s.ph.GetUtilityRelationship(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get relationship")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip = make([]storage.Input, len(urRes.Result))
+	for i, v := range urRes.Result {
+		ip[i] = storage.Input{
+			Value: v,
+			Name:  v,
+		}
+	}
+	ips[storage.IGRelationsGroup] = ip
+
+	// Get Utility partner
+	uplRes, err := s.ph.GetUtilityPartner(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get partner")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip = make([]storage.Input, len(uplRes.Result))
+	for i, v := range uplRes.Result {
+		ip[i] = storage.Input{
+			Value: v.PartnerCode,
+			Name:  v.PartnerName,
+		}
+	}
+	ips[storage.IGPartnerGroup] = ip
+
+	// Get Utility occupation
+	uolRes, err := s.ph.GetUtilityOccupation(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get occupation")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip = make([]storage.Input, len(uolRes.Result))
+	for i, v := range uolRes.Result {
+		ip[i] = storage.Input{
+			Value: v,
+			Name:  v,
+		}
+	}
+	ips[storage.IGOccupationsGroup] = ip
+
+	// Get Utility employement
+	uelRes, err := s.ph.GetUtilityEmployment(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get employement")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip = make([]storage.Input, len(uelRes.Result))
+	for i, v := range uelRes.Result {
+		ip[i] = storage.Input{
+			Value: v,
+			Name:  v,
+		}
+	}
+	ips[storage.IGEmploymentGroup] = ip
+
+	// Get Utility sourcefund
+	usflRes, err := s.ph.GetUtilitySourceFund(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get sourcefund")
+		return nil, coreerror.ToCoreError(err)
+	}
+	ip = make([]storage.Input, len(usflRes.Result))
+	for i, v := range usflRes.Result {
+		ip[i] = storage.Input{
+			Value: v,
+			Name:  v,
+		}
+	}
+	ips[storage.IGFundsGroup] = ip
+	return ips, nil
+}
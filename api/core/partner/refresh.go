@@ -0,0 +1,164 @@
+package partner
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const defaultInputGuideTTL = 24 * time.Hour
+
+// Refresher is a guider whose input guide is cached in storage and can be
+// fetched from the partner again.
+type Refresher interface {
+	Guider
+	RefreshInputGuide(ctx context.Context, old storage.InputGuideData) (storage.InputGuideData, error)
+}
+
+// RefreshInputGuide fetches the input guide of the partner again, replacing the
+// cached one, and records what changed.
+func (s *Svc) RefreshInputGuide(ctx context.Context, partner string) (*storage.InputGuide, []storage.InputGuideDiff, error) {
+	log := logging.FromContext(ctx).WithField("partner", partner)
+	r, ok := s.refreshers[partner]
+	if !ok {
+		return nil, nil, coreerror.NewCoreError(codes.NotFound, "no cached input guide for partner")
+	}
+
+	var old storage.InputGuideData
+	ig, err := s.st.GetInputGuide(ctx, partner)
+	switch {
+	case err == nil:
+		old = ig.Data
+	case err != storage.ErrNotFound:
+		logging.WithError(err, log).Error("get input guide from storage")
+		return nil, nil, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+
+	data, err := r.RefreshInputGuide(ctx, old)
+	if err != nil {
+		logging.WithError(err, log).Error("refreshing input guide")
+		return nil, nil, err
+	}
+	ig, err = s.st.RefreshInputGuide(ctx, storage.InputGuide{
+		Partner: partner,
+		Data:    data,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("storing input guide")
+		return nil, nil, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+
+	ds := DiffInputGuide(partner, old, data)
+	for i, d := range ds {
+		log.WithField("group", d.Group).
+			WithField("added", d.Added).
+			WithField("removed", d.Removed).
+			WithField("changed", d.Changed).
+			Info("input guide changed")
+		nd, err := s.st.CreateInputGuideDiff(ctx, d)
+		if err != nil {
+			logging.WithError(err, log).Error("storing input guide diff")
+			continue
+		}
+		ds[i] = *nd
+	}
+	return ig, ds, nil
+}
+
+// RefreshStaleInputGuides refreshes the cached input guides older than their
+// partner's TTL. Input guides not cached yet are left to be fetched on request.
+func (s *Svc) RefreshStaleInputGuides(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	ps := make([]string, 0, len(s.refreshers))
+	for p := range s.refreshers {
+		ps = append(ps, p)
+	}
+	sort.Strings(ps)
+	var rerr error
+	for _, p := range ps {
+		ig, err := s.st.GetInputGuide(ctx, p)
+		if err != nil {
+			if err != storage.ErrNotFound {
+				logging.WithError(err, log).WithField("partner", p).Error("get input guide from storage")
+			}
+			continue
+		}
+		if time.Since(ig.Refreshed) < s.inputGuideTTL(p) {
+			continue
+		}
+		// the other partners are still refreshed if one fails.
+		if _, _, err := s.RefreshInputGuide(ctx, p); err != nil {
+			rerr = err
+		}
+	}
+	return rerr
+}
+
+func (s *Svc) inputGuideTTL(partner string) time.Duration {
+	if d, ok := s.ttls[strings.ToLower(partner)]; ok {
+		return d
+	}
+	return s.ttl
+}
+
+// DiffInputGuide compares the codes of each group of two input guides.
+func DiffInputGuide(partner string, old, new storage.InputGuideData) []storage.InputGuideDiff {
+	gs := map[string]bool{}
+	for g := range old {
+		gs[g] = true
+	}
+	for g := range new {
+		gs[g] = true
+	}
+	groups := make([]string, 0, len(gs))
+	for g := range gs {
+		groups = append(groups, g)
+	}
+	sort.Strings(groups)
+
+	var ds []storage.InputGuideDiff
+	for _, g := range groups {
+		o := make(map[string]storage.Input, len(old[g]))
+		for _, ip := range old[g] {
+			o[ip.Value] = ip
+		}
+		d := storage.InputGuideDiff{
+			Partner: partner,
+			Group:   g,
+			Added:   []string{},
+			Removed: []string{},
+			Changed: []string{},
+		}
+		n := make(map[string]bool, len(new[g]))
+		for _, ip := range new[g] {
+			n[ip.Value] = true
+			op, ok := o[ip.Value]
+			switch {
+			case !ok:
+				d.Added = append(d.Added, ip.Value)
+			case op != ip:
+				d.Changed = append(d.Changed, ip.Value)
+			}
+		}
+		for _, ip := range old[g] {
+			if !n[ip.Value] {
+				d.Removed = append(d.Removed, ip.Value)
+			}
+		}
+		if len(d.Added)+len(d.Removed)+len(d.Changed) == 0 {
+			continue
+		}
+		sort.Strings(d.Added)
+		sort.Strings(d.Removed)
+		sort.Strings(d.Changed)
+		ds = append(ds, d)
+	}
+	return ds
+}
@@ -0,0 +1,68 @@
+package partner
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestDiffInputGuide(t *testing.T) {
+	old := storage.InputGuideData{
+		storage.IGIDsGroup: {
+			{Value: "PP", Name: "Passport"},
+			{Value: "DL", Name: "Driver's License"},
+			{Value: "SSS", Name: "SSS ID"},
+		},
+		storage.IGFundsGroup: {
+			{Value: "SAL", Name: "Salary"},
+		},
+		storage.IGPurposesGroup: {
+			{Value: "GIFT", Name: "Gift"},
+		},
+	}
+	new := storage.InputGuideData{
+		storage.IGIDsGroup: {
+			{Value: "PP", Name: "Passport"},
+			{Value: "DL", Name: "Driving License"},
+			{Value: "UMID", Name: "UMID"},
+		},
+		storage.IGFundsGroup: {
+			{Value: "SAL", Name: "Salary"},
+		},
+		storage.IGRelationsGroup: {
+			{Value: "SIB", Name: "Sibling"},
+		},
+	}
+	want := []storage.InputGuideDiff{
+		{
+			Partner: "TF",
+			Group:   storage.IGIDsGroup,
+			Added:   []string{"UMID"},
+			Removed: []string{"SSS"},
+			Changed: []string{"DL"},
+		},
+		{
+			Partner: "TF",
+			Group:   storage.IGPurposesGroup,
+			Added:   []string{},
+			Removed: []string{"GIFT"},
+			Changed: []string{},
+		},
+		{
+			Partner: "TF",
+			Group:   storage.IGRelationsGroup,
+			Added:   []string{"SIB"},
+			Removed: []string{},
+			Changed: []string{},
+		},
+	}
+	got := DiffInputGuide("TF", old, new)
+	if !cmp.Equal(want, got) {
+		t.Error(cmp.Diff(want, got))
+	}
+	if d := DiffInputGuide("TF", old, old); len(d) != 0 {
+		t.Errorf("DiffInputGuide() = got %v, want no changes", d)
+	}
+}
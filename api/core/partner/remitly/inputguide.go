@@ -11,8 +11,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// OtherInfoFieldsRM ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsRM are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsRM = []*ppb.Input{
 	{Name: "address"},
 	{Name: "city"},
@@ -32,25 +32,14 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			return nil, status.Error(codes.Internal, "processing")
 
 		}
-		res, err := s.ph.RMIDs(ctx)
+
+		ips, err := s.fetchInputGuide(ctx)
 		if err != nil {
-			logging.WithError(err, log).Error("get ids")
 			return nil, err
 		}
-
-		ip := make([]storage.Input, len(res.Result))
-		for i, v := range res.Result {
-			ip[i] = storage.Input{
-				Value: v.Value,
-				Name:  v.Name,
-			}
-		}
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
-			Data: storage.InputGuideData{
-				storage.IGIDsGroup: ip,
-			},
+			Data:    ips,
 		}
 		_, err = s.st.CreateInputGuide(ctx, *ig)
 		if err != nil {
@@ -65,9 +54,31 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 				Field:  "receiver.identification.type",
 				Inputs: ig.ToGuide(storage.IGIDsGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsRM,
-			},
 		},
 	}, nil
 }
+
+// RefreshInputGuide fetches the input guide from the partner again.
+func (s *Svc) RefreshInputGuide(ctx context.Context, _ storage.InputGuideData) (storage.InputGuideData, error) {
+	return s.fetchInputGuide(ctx)
+}
+
+func (s *Svc) fetchInputGuide(ctx context.Context) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	res, err := s.ph.RMIDs(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get ids")
+		return nil, err
+	}
+
+	ip := make([]storage.Input, len(res.Result))
+	for i, v := range res.Result {
+		ip[i] = storage.Input{
+			Value: v.Value,
+			Name:  v.Name,
+		}
+	}
+	return storage.InputGuideData{
+		storage.IGIDsGroup: ip,
+	}, nil
+}
@@ -11,8 +11,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// OtherInfoFieldsTF ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsTF are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsTF = []*ppb.Input{
 	{Name: "Desc"},
 	{Name: "address"},
@@ -45,71 +45,10 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			return nil, status.Error(codes.Internal, "processing")
 		}
 
-		// id types
-		ips := make(storage.InputGuideData)
-		idRes, err := s.ph.TFIDs(ctx)
+		ips, err := s.fetchInputGuide(ctx)
 		if err != nil {
-			logging.WithError(err, log).Error("get ids")
 			return nil, err
 		}
-		ip := make([]storage.Input, len(idRes.Result.IDs))
-		for i, v := range idRes.Result.IDs {
-			ip[i] = storage.Input{
-				Value:         v.ID.String(),
-				Name:          v.Name,
-				HasIssueDate:  v.RequiredIssueDate,
-				HasExpiration: v.RequiredExpirationDate,
-				CountryCode:   v.CountryIsoCode,
-			}
-		}
-		ips[storage.IGIDsGroup] = ip
-
-		// relationships
-		rlRes, err := s.ph.TFRelations(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get relationships")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(rlRes.Result.Relations))
-		for i, v := range rlRes.Result.Relations {
-			ip[i] = storage.Input{
-				Value: v.ID.String(),
-				Name:  v.Name,
-			}
-		}
-		ips[storage.IGRelationsGroup] = ip
-
-		// occupations
-		ocRes, err := s.ph.TFOccupations(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get occupations")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(ocRes.Result.Occups))
-		for i, v := range ocRes.Result.Occups {
-			ip[i] = storage.Input{
-				Value: v.ID.String(),
-				Name:  v.Name,
-			}
-		}
-		ips[storage.IGOccupationsGroup] = ip
-
-		// purposes
-		ppRes, err := s.ph.TFPrps(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get purposes")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(ppRes.Result.Prps))
-		for i, v := range ppRes.Result.Prps {
-			ip[i] = storage.Input{
-				Value:       v.ID.String(),
-				Name:        v.Name,
-				CountryCode: v.CountryIsoCode,
-			}
-		}
-		ips[storage.IGPurposesGroup] = ip
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
 			Data:    ips,
@@ -139,9 +78,80 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 				Field:  "receiver.transaction_purpose",
 				Inputs: ig.ToGuide(storage.IGPurposesGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsTF,
-			},
 		},
 	}, nil
 }
+
+// RefreshInputGuide fetches the input guide from the partner again.
+func (s *Svc) RefreshInputGuide(ctx context.Context, _ storage.InputGuideData) (storage.InputGuideData, error) {
+	return s.fetchInputGuide(ctx)
+}
+
+func (s *Svc) fetchInputGuide(ctx context.Context) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	// id types
+	ips := make(storage.InputGuideData)
+	idRes, err := s.ph.TFIDs(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get ids")
+		return nil, err
+	}
+	ip := make([]storage.Input, len(idRes.Result.IDs))
+	for i, v := range idRes.Result.IDs {
+		ip[i] = storage.Input{
+			Value:         v.ID.String(),
+			Name:          v.Name,
+			HasIssueDate:  v.RequiredIssueDate,
+			HasExpiration: v.RequiredExpirationDate,
+			CountryCode:   v.CountryIsoCode,
+		}
+	}
+	ips[storage.IGIDsGroup] = ip
+
+	// relationships
+	rlRes, err := s.ph.TFRelations(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get relationships")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(rlRes.Result.Relations))
+	for i, v := range rlRes.Result.Relations {
+		ip[i] = storage.Input{
+			Value: v.ID.String(),
+			Name:  v.Name,
+		}
+	}
+	ips[storage.IGRelationsGroup] = ip
+
+	// occupations
+	ocRes, err := s.ph.TFOccupations(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get occupations")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(ocRes.Result.Occups))
+	for i, v := range ocRes.Result.Occups {
+		ip[i] = storage.Input{
+			Value: v.ID.String(),
+			Name:  v.Name,
+		}
+	}
+	ips[storage.IGOccupationsGroup] = ip
+
+	// purposes
+	ppRes, err := s.ph.TFPrps(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get purposes")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(ppRes.Result.Prps))
+	for i, v := range ppRes.Result.Prps {
+		ip[i] = storage.Input{
+			Value:       v.ID.String(),
+			Name:        v.Name,
+			CountryCode: v.CountryIsoCode,
+		}
+	}
+	ips[storage.IGPurposesGroup] = ip
+	return ips, nil
+}
@@ -11,8 +11,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// OtherInfoFieldsUNT ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsUNT are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsUNT = []*ppb.Input{
 	{Name: "address"},
 	{Name: "city"},
@@ -38,101 +38,10 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			return nil, status.Error(codes.Internal, "processing")
 		}
 
-		// countries
-		ips := make(storage.InputGuideData)
-		ppRes, err := s.ph.UNTgetCountries(ctx)
+		ips, err := s.fetchInputGuide(ctx)
 		if err != nil {
-			logging.WithError(err, log).Error("get countries")
 			return nil, err
 		}
-		ip := make([]storage.Input, len(ppRes.Data))
-		for i, v := range ppRes.Data {
-			ip[i] = storage.Input{
-				Value: v.Code,
-				Name:  v.Name,
-			}
-		}
-		ips[storage.IGCountryGroup] = ip
-
-		// currency
-		gcRes, err := s.ph.UNTgetCurrencies(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get currency")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(gcRes.Data))
-		for i, v := range gcRes.Data {
-			ip[i] = storage.Input{
-				Value: v.Code,
-				Name:  v.Name,
-			}
-		}
-		ips[storage.IGCurrencyGroup] = ip
-
-		// id types
-		idRes, err := s.ph.UNTgetIds(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get ids")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(idRes.Data))
-		for i, v := range idRes.Data {
-			ip[i] = storage.Input{
-				Value:       v.Code,
-				Name:        v.Country,
-				Description: v.Description,
-			}
-		}
-		ips[storage.IGIDsGroup] = ip
-
-		// occupations
-		ocRes, err := s.ph.UNTgetOccupations(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get occupations")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(ocRes.Data))
-		for i, v := range ocRes.Data {
-			ip[i] = storage.Input{
-				Value:       v.Code,
-				Name:        v.Country,
-				Description: v.Description,
-			}
-		}
-		ips[storage.IGOccupationsGroup] = ip
-
-		// ph states
-		gsRes, err := s.ph.UNTgetStates(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get ph states")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(gsRes.Data))
-		for i, v := range gsRes.Data {
-			ip[i] = storage.Input{
-				Value:       v.UtlCode,
-				StateName:   v.StateName,
-				CountryName: v.Country,
-			}
-		}
-		ips[storage.IGStateGroup] = ip
-
-		// usa states
-		ugsRes, err := s.ph.UNTgetUsStates(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get usa states")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(ugsRes.Data))
-		for i, v := range ugsRes.Data {
-			ip[i] = storage.Input{
-				Value:       v.UtlCode,
-				StateName:   v.StateName,
-				CountryName: v.Country,
-			}
-		}
-		ips[storage.IGUsStateGroup] = ip
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
 			Data:    ips,
@@ -170,9 +79,110 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 				Field:  "receiver.usa-states",
 				Inputs: ig.ToGuide(storage.IGUsStateGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsUNT,
-			},
 		},
 	}, nil
 }
+
+// RefreshInputGuide fetches the input guide from the partner again.
+func (s *Svc) RefreshInputGuide(ctx context.Context, _ storage.InputGuideData) (storage.InputGuideData, error) {
+	return s.fetchInputGuide(ctx)
+}
+
+func (s *Svc) fetchInputGuide(ctx context.Context) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	// countries
+	ips := make(storage.InputGuideData)
+	ppRes, err := s.ph.UNTgetCountries(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get countries")
+		return nil, err
+	}
+	ip := make([]storage.Input, len(ppRes.Data))
+	for i, v := range ppRes.Data {
+		ip[i] = storage.Input{
+			Value: v.Code,
+			Name:  v.Name,
+		}
+	}
+	ips[storage.IGCountryGroup] = ip
+
+	// currency
+	gcRes, err := s.ph.UNTgetCurrencies(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get currency")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(gcRes.Data))
+	for i, v := range gcRes.Data {
+		ip[i] = storage.Input{
+			Value: v.Code,
+			Name:  v.Name,
+		}
+	}
+	ips[storage.IGCurrencyGroup] = ip
+
+	// id types
+	idRes, err := s.ph.UNTgetIds(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get ids")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(idRes.Data))
+	for i, v := range idRes.Data {
+		ip[i] = storage.Input{
+			Value:       v.Code,
+			Name:        v.Country,
+			Description: v.Description,
+		}
+	}
+	ips[storage.IGIDsGroup] = ip
+
+	// occupations
+	ocRes, err := s.ph.UNTgetOccupations(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get occupations")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(ocRes.Data))
+	for i, v := range ocRes.Data {
+		ip[i] = storage.Input{
+			Value:       v.Code,
+			Name:        v.Country,
+			Description: v.Description,
+		}
+	}
+	ips[storage.IGOccupationsGroup] = ip
+
+	// ph states
+	gsRes, err := s.ph.UNTgetStates(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get ph states")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(gsRes.Data))
+	for i, v := range gsRes.Data {
+		ip[i] = storage.Input{
+			Value:       v.UtlCode,
+			StateName:   v.StateName,
+			CountryName: v.Country,
+		}
+	}
+	ips[storage.IGStateGroup] = ip
+
+	// usa states
+	ugsRes, err := s.ph.UNTgetUsStates(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get usa states")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(ugsRes.Data))
+	for i, v := range ugsRes.Data {
+		ip[i] = storage.Input{
+			Value:       v.UtlCode,
+			StateName:   v.StateName,
+			CountryName: v.Country,
+		}
+	}
+	ips[storage.IGUsStateGroup] = ip
+	return ips, nil
+}
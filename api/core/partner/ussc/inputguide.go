@@ -4,10 +4,11 @@ import (
 	"context"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
 )
 
+// OtherInfoFieldsUSSC are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsUSSC = []*ppb.Input{
 	{Name: "contact_number"},
 	{Name: "purpose_transaction"},
@@ -28,10 +29,6 @@ var OtherInfoFieldsUSSC = []*ppb.Input{
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
 	return &ppb.InputGuideResponse{
-		InputGuide: map[string]*ppb.Guide{
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsUSSC,
-			},
-		},
+		InputGuide: map[string]*ppb.Guide{},
 	}, nil
 }
@@ -2,6 +2,7 @@ package wise
 
 import (
 	"context"
+	"strings"
 
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/api/storage"
@@ -11,8 +12,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// OtherInfoFieldsWISE ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsWISE are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsWISE = []*ppb.Input{}
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
@@ -24,52 +25,11 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			logging.WithError(err, log).Error("get input guide from storage")
 			return nil, status.Error(codes.Internal, "processing")
 		}
-		// get Countries
-		ips := make(storage.InputGuideData)
-		cntryRes, err := s.ph.WISEgetCountries(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get countries")
-			return nil, err
-		}
-		ip := make([]storage.Input, len(*cntryRes))
-		for i, v := range *cntryRes {
-			ip[i] = storage.Input{
-				Value: v.Key,
-				Name:  v.Name,
-			}
-		}
-		ips[storage.IGCountryGroup] = ip
 
-		// get States
-		stateRes, err := s.ph.WISEgetStates(ctx, req.CtryCode)
+		ips, err := s.fetchInputGuide(ctx, []string{req.CtryCode})
 		if err != nil {
-			logging.WithError(err, log).Error("get states")
 			return nil, err
 		}
-		ip = make([]storage.Input, len(*stateRes))
-		for i, v := range *stateRes {
-			ip[i] = storage.Input{
-				Value: v.Key,
-				Name:  v.Name,
-			}
-		}
-		ips[storage.IGStateGroup+"-"+req.CtryCode] = ip
-
-		// get Currencies
-		currRes, err := s.ph.WISEgetCurrencies(ctx)
-		if err != nil {
-			logging.WithError(err, log).Error("get Currencies")
-			return nil, err
-		}
-		ip = make([]storage.Input, len(*currRes))
-		for i, v := range *currRes {
-			ip[i] = storage.Input{
-				Value:       v.Currency,
-				Description: v.Description,
-			}
-		}
-		ips[storage.IGCurrencyGroup] = ip
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
 			Data:    ips,
@@ -82,18 +42,10 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 	}
 
 	if _, ok := ig.Data[storage.IGStateGroup+"-"+req.CtryCode]; !ok {
-		stateRes, err := s.ph.WISEgetStates(ctx, req.CtryCode)
+		ip, err := s.fetchStates(ctx, req.CtryCode)
 		if err != nil {
-			logging.WithError(err, log).Error("get states")
 			return nil, err
 		}
-		ip := make([]storage.Input, len(*stateRes))
-		for i, v := range *stateRes {
-			ip[i] = storage.Input{
-				Value: v.Key,
-				Name:  v.Name,
-			}
-		}
 
 		ig.Data[storage.IGStateGroup+"-"+req.CtryCode] = ip
 		_, err = s.st.UpdateInputGuide(ctx, *ig)
@@ -117,9 +69,79 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 				Field:  "receiver.currency",
 				Inputs: ig.ToGuide(storage.IGCurrencyGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsWISE,
-			},
 		},
 	}, nil
 }
+
+// RefreshInputGuide fetches the input guide from the partner again, including
+// the states of every country already cached.
+func (s *Svc) RefreshInputGuide(ctx context.Context, old storage.InputGuideData) (storage.InputGuideData, error) {
+	var ctrys []string
+	for k := range old {
+		if strings.HasPrefix(k, storage.IGStateGroup+"-") {
+			ctrys = append(ctrys, strings.TrimPrefix(k, storage.IGStateGroup+"-"))
+		}
+	}
+	return s.fetchInputGuide(ctx, ctrys)
+}
+
+func (s *Svc) fetchInputGuide(ctx context.Context, ctrys []string) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	// get Countries
+	ips := make(storage.InputGuideData)
+	cntryRes, err := s.ph.WISEgetCountries(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get countries")
+		return nil, err
+	}
+	ip := make([]storage.Input, len(*cntryRes))
+	for i, v := range *cntryRes {
+		ip[i] = storage.Input{
+			Value: v.Key,
+			Name:  v.Name,
+		}
+	}
+	ips[storage.IGCountryGroup] = ip
+
+	// get States
+	for _, c := range ctrys {
+		ip, err := s.fetchStates(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		ips[storage.IGStateGroup+"-"+c] = ip
+	}
+
+	// get Currencies
+	currRes, err := s.ph.WISEgetCurrencies(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("get Currencies")
+		return nil, err
+	}
+	ip = make([]storage.Input, len(*currRes))
+	for i, v := range *currRes {
+		ip[i] = storage.Input{
+			Value:       v.Currency,
+			Description: v.Description,
+		}
+	}
+	ips[storage.IGCurrencyGroup] = ip
+	return ips, nil
+}
+
+func (s *Svc) fetchStates(ctx context.Context, ctry string) ([]storage.Input, error) {
+	log := logging.FromContext(ctx)
+	stateRes, err := s.ph.WISEgetStates(ctx, ctry)
+	if err != nil {
+		logging.WithError(err, log).Error("get states")
+		return nil, err
+	}
+	ip := make([]storage.Input, len(*stateRes))
+	for i, v := range *stateRes {
+		ip[i] = storage.Input{
+			Value: v.Key,
+			Name:  v.Name,
+		}
+	}
+	return ip, nil
+}
@@ -13,8 +13,8 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// OtherInfoFieldsWU ...
-// TODO(vitthal): make it dynamic and save in DB after petnet confirmation
+// OtherInfoFieldsWU are the default other info fields of the partner,
+// seeded by migration.
 var OtherInfoFieldsWU = []*ppb.Input{}
 
 func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error) {
@@ -27,74 +27,10 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 			return nil, status.Error(codes.Internal, "processing")
 		}
 
-		res, err := s.ph.SDQs(ctx, perahub.SDQsRequest{
-			Remco:   s.Kind(),
-			SDQType: "all",
-		})
+		ips, err := s.fetchInputGuide(ctx)
 		if err != nil {
-			logging.WithError(err, log).Error("getting sdq ids")
 			return nil, err
 		}
-
-		ips := make(storage.InputGuideData)
-
-		ip := make([]storage.Input, len(res.ID))
-		for i, v := range res.ID {
-			ip[i] = storage.Input{
-				Value:         v.TemplateValue,
-				Name:          v.DocumentType,
-				HasIssueDate:  v.HasIssueDate,
-				HasExpiration: v.HasExpiration,
-				Description:   v.DocDescEng,
-			}
-		}
-		ips[storage.IGIDsGroup] = ip
-
-		oc := make([]storage.Input, len(res.Occupation))
-		for i, v := range res.Occupation {
-			oc[i] = storage.Input{
-				Value: v.OccupationValue,
-				Name:  v.Occupation,
-			}
-		}
-		ips[storage.IGOccupationsGroup] = oc
-
-		po := make([]storage.Input, len(res.Position))
-		for i, v := range res.Position {
-			po[i] = storage.Input{
-				Value: v.PositionValue,
-				Name:  v.Position,
-			}
-		}
-		ips[storage.IGPositionGroup] = po
-
-		pu := make([]storage.Input, len(res.Purpose))
-		for i, v := range res.Purpose {
-			pu[i] = storage.Input{
-				Value: v.PurposeValue,
-				Name:  v.Purpose,
-			}
-		}
-		ips[storage.IGPurposesGroup] = pu
-
-		re := make([]storage.Input, len(res.Relationship))
-		for i, v := range res.Relationship {
-			re[i] = storage.Input{
-				Value: v.RelationshipValue,
-				Name:  v.Relationship,
-			}
-		}
-		ips[storage.IGRelationsGroup] = re
-
-		sf := make([]storage.Input, len(res.SourceOfFund))
-		for i, v := range res.SourceOfFund {
-			sf[i] = storage.Input{
-				Value: v.SourceOfFundValue,
-				Name:  v.SourceOfFund,
-			}
-		}
-		ips[storage.IGFundsGroup] = sf
-
 		ig = &storage.InputGuide{
 			Partner: s.Kind(),
 			Data:    ips,
@@ -141,13 +77,87 @@ func (s *Svc) InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.
 				Field:  "sender.source_funds",
 				Inputs: ig.ToGuide(storage.IGFundsGroup),
 			},
-			storage.IGOtherInfoLabel: {
-				OtherInfo: OtherInfoFieldsWU,
-			},
 		},
 	}, nil
 }
 
+// RefreshInputGuide fetches the input guide from the partner again.
+func (s *Svc) RefreshInputGuide(ctx context.Context, _ storage.InputGuideData) (storage.InputGuideData, error) {
+	return s.fetchInputGuide(ctx)
+}
+
+func (s *Svc) fetchInputGuide(ctx context.Context) (storage.InputGuideData, error) {
+	log := logging.FromContext(ctx)
+	res, err := s.ph.SDQs(ctx, perahub.SDQsRequest{
+		Remco:   s.Kind(),
+		SDQType: "all",
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("getting sdq ids")
+		return nil, err
+	}
+
+	ips := make(storage.InputGuideData)
+
+	ip := make([]storage.Input, len(res.ID))
+	for i, v := range res.ID {
+		ip[i] = storage.Input{
+			Value:         v.TemplateValue,
+			Name:          v.DocumentType,
+			HasIssueDate:  v.HasIssueDate,
+			HasExpiration: v.HasExpiration,
+			Description:   v.DocDescEng,
+		}
+	}
+	ips[storage.IGIDsGroup] = ip
+
+	oc := make([]storage.Input, len(res.Occupation))
+	for i, v := range res.Occupation {
+		oc[i] = storage.Input{
+			Value: v.OccupationValue,
+			Name:  v.Occupation,
+		}
+	}
+	ips[storage.IGOccupationsGroup] = oc
+
+	po := make([]storage.Input, len(res.Position))
+	for i, v := range res.Position {
+		po[i] = storage.Input{
+			Value: v.PositionValue,
+			Name:  v.Position,
+		}
+	}
+	ips[storage.IGPositionGroup] = po
+
+	pu := make([]storage.Input, len(res.Purpose))
+	for i, v := range res.Purpose {
+		pu[i] = storage.Input{
+			Value: v.PurposeValue,
+			Name:  v.Purpose,
+		}
+	}
+	ips[storage.IGPurposesGroup] = pu
+
+	re := make([]storage.Input, len(res.Relationship))
+	for i, v := range res.Relationship {
+		re[i] = storage.Input{
+			Value: v.RelationshipValue,
+			Name:  v.Relationship,
+		}
+	}
+	ips[storage.IGRelationsGroup] = re
+
+	sf := make([]storage.Input, len(res.SourceOfFund))
+	for i, v := range res.SourceOfFund {
+		sf[i] = storage.Input{
+			Value: v.SourceOfFundValue,
+			Name:  v.SourceOfFund,
+		}
+	}
+	ips[storage.IGFundsGroup] = sf
+	return ips, nil
+}
+
 type CombinedCountryCurrency struct {
 	CountryCd  string
 	Name       string
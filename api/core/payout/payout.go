@@ -0,0 +1,154 @@
+package payout
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/core/static"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const (
+	defaultLookback = 30 * 24 * time.Hour
+	defaultRecheck  = time.Hour
+	defaultLimit    = 100
+)
+
+type Store interface {
+	ListPayoutPending(context.Context, storage.PayoutFilter) ([]storage.RemitHistory, error)
+	UpdateRemitPayoutStatus(ctx context.Context, txnID, status string, paidOut sql.NullTime) error
+}
+
+type Inquirer interface {
+	RemitStatus(ctx context.Context, controlNo, partner string) (*core.RemitStatus, error)
+}
+
+type Svc struct {
+	st       Store
+	inq      Inquirer
+	partners []string
+	lookback time.Duration
+	recheck  time.Duration
+	limit    int
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithPartners sets the partners whose sends are polled.
+func WithPartners(p []string) Option {
+	return func(s *Svc) {
+		if len(p) > 0 {
+			s.partners = p
+		}
+	}
+}
+
+// WithLookback sets how long after completion a send is polled.
+func WithLookback(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.lookback = d
+		}
+	}
+}
+
+// WithRecheck sets how long a send is left alone after it was checked.
+func WithRecheck(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.recheck = d
+		}
+	}
+}
+
+// WithLimit sets the number of sends polled per partner per run.
+func WithLimit(n int) Option {
+	return func(s *Svc) {
+		if n > 0 {
+			s.limit = n
+		}
+	}
+}
+
+// New payout status poller.
+func New(st Store, inq Inquirer, opts ...Option) *Svc {
+	s := &Svc{
+		st:       st,
+		inq:      inq,
+		partners: []string{static.WUCode},
+		lookback: defaultLookback,
+		recheck:  defaultRecheck,
+		limit:    defaultLimit,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Poll inquires the payout status of the sent remittances not yet picked up
+// and records it in the remit history. Meant to run as a leader cron.
+func (s *Svc) Poll(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	now := time.Now()
+	for _, p := range s.partners {
+		rs, err := s.st.ListPayoutPending(ctx, storage.PayoutFilter{
+			Partner:       p,
+			From:          now.Add(-s.lookback),
+			Until:         now,
+			CheckedBefore: now.Add(-s.recheck),
+			Limit:         s.limit,
+		})
+		if err != nil {
+			logging.WithError(err, log).WithField("partner", p).Error("listing payout pending remittances")
+			continue
+		}
+		for _, r := range rs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			err := s.poll(ctx, r)
+			if status.Code(err) == codes.Unimplemented {
+				log.WithField("partner", p).Error("partner has no status inquiry, skipping")
+				break
+			}
+			if err != nil {
+				logging.WithError(err, log.WithField("txID", r.TxnID)).Error("polling payout status")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Svc) poll(ctx context.Context, r storage.RemitHistory) error {
+	ctx = metautils.ExtractIncoming(ctx).
+		Set(phmw.Partner, r.RemcoID).
+		Set(phmw.DSAOrgID, r.DsaID).
+		ToIncoming(ctx)
+	rs, err := s.inq.RemitStatus(ctx, r.RemcoControlNo, r.RemcoID)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return err
+		}
+		// keep the status but mark the send checked so one failing
+		// inquiry does not hold up the others.
+		if uErr := s.st.UpdateRemitPayoutStatus(ctx, r.TxnID, r.PayoutStatus, sql.NullTime{}); uErr != nil {
+			return uErr
+		}
+		return err
+	}
+	var paid sql.NullTime
+	if rs.Status == core.PayoutPaid {
+		paid = sql.NullTime{Valid: true, Time: time.Now()}
+	}
+	return s.st.UpdateRemitPayoutStatus(ctx, r.TxnID, rs.Status, paid)
+}
@@ -0,0 +1,114 @@
+package payout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/storage"
+)
+
+type update struct {
+	status string
+	paid   bool
+}
+
+type fakeStore struct {
+	rows    map[string][]storage.RemitHistory
+	updates map[string]update
+	listErr map[string]error
+}
+
+func (f *fakeStore) ListPayoutPending(_ context.Context, pf storage.PayoutFilter) ([]storage.RemitHistory, error) {
+	if err := f.listErr[pf.Partner]; err != nil {
+		return nil, err
+	}
+	return f.rows[pf.Partner], nil
+}
+
+func (f *fakeStore) UpdateRemitPayoutStatus(_ context.Context, txnID, sts string, paidOut sql.NullTime) error {
+	f.updates[txnID] = update{status: sts, paid: paidOut.Valid}
+	return nil
+}
+
+type fakeInquirer struct {
+	status map[string]string
+	calls  int
+}
+
+func (f *fakeInquirer) RemitStatus(_ context.Context, controlNo, partner string) (*core.RemitStatus, error) {
+	f.calls++
+	if partner != "WU" {
+		return nil, status.Error(codes.Unimplemented, "status inquiry not supported for partner")
+	}
+	sts, ok := f.status[controlNo]
+	if !ok {
+		return nil, errors.New("partner down")
+	}
+	return &core.RemitStatus{Status: sts}, nil
+}
+
+func TestPoll(t *testing.T) {
+	st := &fakeStore{
+		rows: map[string][]storage.RemitHistory{
+			"WU": {
+				{TxnID: "paid", RemcoID: "WU", RemcoControlNo: "1"},
+				{TxnID: "waiting", RemcoID: "WU", RemcoControlNo: "2"},
+				{TxnID: "error", RemcoID: "WU", RemcoControlNo: "3", PayoutStatus: core.PayoutAvailable},
+			},
+			"TF": {
+				{TxnID: "tf1", RemcoID: "TF", RemcoControlNo: "4"},
+				{TxnID: "tf2", RemcoID: "TF", RemcoControlNo: "5"},
+			},
+		},
+		updates: map[string]update{},
+	}
+	inq := &fakeInquirer{status: map[string]string{
+		"1": core.PayoutPaid,
+		"2": core.PayoutAvailable,
+	}}
+	if err := New(st, inq, WithPartners([]string{"WU", "TF"})).Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]update{
+		"paid":    {status: core.PayoutPaid, paid: true},
+		"waiting": {status: core.PayoutAvailable},
+		"error":   {status: core.PayoutAvailable},
+	}
+	if len(st.updates) != len(want) {
+		t.Errorf("Poll() = got updates %v, want %v", st.updates, want)
+	}
+	for id, w := range want {
+		if got := st.updates[id]; got != w {
+			t.Errorf("Poll() = got update %v for %s, want %v", got, id, w)
+		}
+	}
+	// the unsupported partner is skipped after the first inquiry
+	if inq.calls != 4 {
+		t.Errorf("Poll() = got %d inquiries, want 4", inq.calls)
+	}
+}
+
+func TestPollListError(t *testing.T) {
+	st := &fakeStore{
+		rows: map[string][]storage.RemitHistory{
+			"WU": {{TxnID: "paid", RemcoID: "WU", RemcoControlNo: "1"}},
+		},
+		updates: map[string]update{},
+		listErr: map[string]error{"TF": errors.New("db down")},
+	}
+	inq := &fakeInquirer{status: map[string]string{"1": core.PayoutPaid}}
+	// the failing partner does not stop the others from being polled
+	if err := New(st, inq, WithPartners([]string{"TF", "WU"})).Poll(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if got := st.updates["paid"]; got != (update{status: core.PayoutPaid, paid: true}) {
+		t.Errorf("Poll() = got update %v, want paid", got)
+	}
+}
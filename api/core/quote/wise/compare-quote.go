@@ -0,0 +1,45 @@
+package wise
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/integration/perahub"
+)
+
+// Quote reports the Wise quote for CompareQuotes. Wise quotes per customer so
+// the customer email is required and the amount is always the source amount.
+func (s *Svc) Quote(ctx context.Context, r core.FeeInquiryReq) (*core.PartnerQuote, error) {
+	if r.Email == "" {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "customer email is required for a wise quote")
+	}
+	res, err := s.ph.WISECreateQuote(ctx, perahub.WISECreateQuoteReq{
+		Email:          r.Email,
+		SourceCurrency: r.PrincipalAmount.CurrencyCode(),
+		TargetCurrency: r.DestCurrency,
+		SourceAmount:   json.Number(r.PrincipalAmount.Amount.Number()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	qs := res.QuoteSummary
+	fee, err := currency.NewAmount(qs.TotalFee.String(), qs.SourceCurrency)
+	if err != nil {
+		return nil, err
+	}
+	rcv, err := currency.NewAmount(string(qs.TargetAmount), qs.TargetCurrency)
+	if err != nil {
+		return nil, err
+	}
+	return &core.PartnerQuote{
+		DeliveryMethod: core.BankDeposit,
+		Fee:            currency.ToMinor(fee.Round()),
+		ExchangeRate:   string(qs.Rate),
+		ReceiveAmount:  currency.ToMinor(rcv.Round()),
+	}, nil
+}
@@ -0,0 +1,268 @@
+package reconcile
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+)
+
+const (
+	defaultGrace    = 30 * time.Minute
+	defaultLookback = 7 * 24 * time.Hour
+	defaultRetry    = time.Hour
+	defaultLimit    = 100
+)
+
+// openStatus lists the partner statuses of remittances still awaiting payout.
+var openStatus = map[string][]string{
+	static.WUCode:     {perahub.AwaitPayment},
+	static.TFCode:     {perahub.TFAwaitPayment},
+	static.BPICode:    {perahub.BPAwaitPayment, perahub.BPAwaitPaymentD},
+	static.CEBCode:    {perahub.CEBAwaitPayment},
+	static.CEBINTCode: {perahub.CEBINTAwaitPayment},
+	static.AYACode:    {"AVAILABLE"},
+	static.ICCode:     {"For Pick Up"},
+}
+
+var (
+	paidStatus   = []string{"PAID", "PAID OUT", "CLAIMED", "COMPLETED"}
+	cancelStatus = []string{"CANCELLED", "CANCELED", "REFUNDED", "VOID", "EXPIRED"}
+)
+
+type Store interface {
+	ListReconcileRemit(context.Context, storage.ReconcileFilter) ([]storage.RemitHistory, error)
+	ReconcileRemit(context.Context, storage.RemitReconcile, storage.TxnStep) (*storage.RemitReconcile, error)
+	RecordReconcileAttempt(context.Context, string) error
+}
+
+type Inquirer interface {
+	SearchRemit(context.Context, core.SearchRemit, string) (*core.SearchRemit, error)
+}
+
+type Svc struct {
+	st       Store
+	inq      Inquirer
+	grace    time.Duration
+	lookback time.Duration
+	retry    time.Duration
+	limit    int
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithGrace sets how long a transaction is left alone after its last update
+// before it is reconciled.
+func WithGrace(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.grace = d
+		}
+	}
+}
+
+// WithLookback sets how far back transactions are reconciled.
+func WithLookback(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.lookback = d
+		}
+	}
+}
+
+// WithRetry sets how long a transaction the partner could not be asked about
+// is left alone before it is tried again.
+func WithRetry(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.retry = d
+		}
+	}
+}
+
+// WithLimit sets the number of transactions reconciled per run.
+func WithLimit(n int) Option {
+	return func(s *Svc) {
+		if n > 0 {
+			s.limit = n
+		}
+	}
+}
+
+// New reconciliation service.
+func New(st Store, inq Inquirer, opts ...Option) *Svc {
+	s := &Svc{
+		st:       st,
+		inq:      inq,
+		grace:    defaultGrace,
+		lookback: defaultLookback,
+		retry:    defaultRetry,
+		limit:    defaultLimit,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Reconcile inquires the partner status of the transactions that were staged
+// but never confirmed or that failed on confirm and moves them to SUCCESS, FAIL
+// or NEEDS_REVIEW. Meant to run as a leader cron.
+func (s *Svc) Reconcile(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	now := time.Now()
+	rs, err := s.st.ListReconcileRemit(ctx, storage.ReconcileFilter{
+		From:            now.Add(-s.lookback),
+		Until:           now.Add(-s.grace),
+		AttemptedBefore: now.Add(-s.retry),
+		Limit:           s.limit,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("listing transactions to reconcile")
+		return err
+	}
+
+	for _, r := range rs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.reconcile(ctx, r); err != nil {
+			logging.WithError(err, log.WithField("txID", r.TxnID)).Error("reconciling transaction")
+		}
+	}
+	return nil
+}
+
+func (s *Svc) reconcile(ctx context.Context, r storage.RemitHistory) error {
+	log := logging.FromContext(ctx).WithField("txID", r.TxnID)
+
+	rr := storage.RemitReconcile{
+		TxnID:          r.TxnID,
+		RemcoID:        r.RemcoID,
+		RemType:        r.RemType,
+		RemcoControlNo: r.RemcoControlNo,
+		FromStatus:     r.TxnStatus,
+		FromStep:       r.TxnStep,
+		ToStep:         r.TxnStep,
+	}
+	sts, msg, ptnrSts := s.inquire(ctx, r)
+	if sts == "" {
+		// leave the transaction untouched, it is picked up again after the
+		// others once the retry wait is over
+		log.WithField("message", msg).Info("partner inquire unavailable, skipping")
+		return s.st.RecordReconcileAttempt(ctx, r.TxnID)
+	}
+	rr.ToStatus, rr.Message, rr.PartnerStatus = string(sts), msg, ptnrSts
+
+	var step storage.TxnStep
+	switch sts {
+	case storage.SuccessStatus:
+		rr.ToStep = string(storage.ConfirmStep)
+		step = storage.ConfirmStep
+	case storage.FailStatus:
+		step = storage.FailStep
+	}
+
+	log.WithField("reconcile", rr).Debug("reconciled")
+	_, err := s.st.ReconcileRemit(ctx, rr, step)
+	return err
+}
+
+// inquire returns the reconciled status of the transaction, the reason and the
+// status reported by the partner. The status is empty if the partner could not
+// be reached and the transaction should be retried later.
+func (s *Svc) inquire(ctx context.Context, r storage.RemitHistory) (storage.TxnStatus, string, string) {
+	log := logging.FromContext(ctx).WithField("txID", r.TxnID)
+	staged := r.TxnStep == string(storage.StageStep)
+	disburse := r.RemType == string(storage.DisburseType)
+
+	if r.RemcoControlNo == "" {
+		if staged {
+			return storage.FailStatus, "staged and never confirmed, no partner control number", ""
+		}
+		return storage.ReviewStatus, "no partner control number to inquire", ""
+	}
+
+	ctx = metautils.ExtractIncoming(ctx).
+		Set(phmw.Partner, r.RemcoID).
+		Set(phmw.DSAOrgID, r.DsaID).
+		ToIncoming(ctx)
+	res, err := s.inq.SearchRemit(ctx, core.SearchRemit{
+		DsaID:        r.DsaID,
+		UserID:       r.UserID,
+		RemitPartner: r.RemcoID,
+		RemitType:    r.Remittance.TxnType,
+		ControlNo:    r.RemcoControlNo,
+	}, r.RemcoID)
+	if err != nil {
+		logging.WithError(err, log).Info("partner inquire")
+		if transient(err) {
+			return "", "partner inquire failed: " + status.Convert(err).Message(), ""
+		}
+		if !disburse && status.Code(err) == codes.NotFound {
+			return storage.FailStatus, "not found by partner: " + status.Convert(err).Message(), ""
+		}
+		return storage.ReviewStatus, "partner inquire failed: " + status.Convert(err).Message(), ""
+	}
+
+	ps := res.Status
+	switch {
+	case hasStatus(paidStatus, ps):
+		return storage.SuccessStatus, "paid out by partner", ps
+	case hasStatus(cancelStatus, ps):
+		return storage.FailStatus, "cancelled by partner", ps
+	case hasStatus(openStatus[r.RemcoID], ps):
+		switch {
+		case disburse:
+			return storage.FailStatus, "awaiting payout with partner, payout not completed", ps
+		case !staged:
+			return storage.SuccessStatus, "remittance available with partner", ps
+		}
+	}
+	return storage.ReviewStatus, "unknown partner status", ps
+}
+
+// transient reports whether err is a temporary partner failure as opposed to
+// a definitive answer about the transaction.
+func transient(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	c := status.Code(err)
+	var pe *perahub.Error
+	if errors.As(err, &pe) {
+		if n, err := strconv.Atoi(pe.Code); err == nil && n >= http.StatusInternalServerError {
+			return true
+		}
+		c = pe.GRPCCode
+	}
+	switch c {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+func hasStatus(l []string, s string) bool {
+	s = strings.TrimSpace(s)
+	for _, v := range l {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
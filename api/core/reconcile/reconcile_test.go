@@ -0,0 +1,206 @@
+package reconcile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/api/storage"
+)
+
+type fakeStore struct {
+	rs       []storage.RemitHistory
+	audit    []storage.RemitReconcile
+	steps    []storage.TxnStep
+	attempts []string
+}
+
+func (f *fakeStore) ListReconcileRemit(context.Context, storage.ReconcileFilter) ([]storage.RemitHistory, error) {
+	return f.rs, nil
+}
+
+func (f *fakeStore) ReconcileRemit(_ context.Context, r storage.RemitReconcile, s storage.TxnStep) (*storage.RemitReconcile, error) {
+	f.audit = append(f.audit, r)
+	f.steps = append(f.steps, s)
+	return &r, nil
+}
+
+func (f *fakeStore) RecordReconcileAttempt(_ context.Context, txnID string) error {
+	f.attempts = append(f.attempts, txnID)
+	return nil
+}
+
+type fakeInquirer map[string]struct {
+	status string
+	err    error
+}
+
+func (f fakeInquirer) SearchRemit(_ context.Context, r core.SearchRemit, _ string) (*core.SearchRemit, error) {
+	res := f[r.ControlNo]
+	if res.err != nil {
+		return nil, res.err
+	}
+	return &core.SearchRemit{ControlNo: r.ControlNo, Status: res.status}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	disburse, send := string(storage.DisburseType), string(storage.SendType)
+	stage, confirm := string(storage.StageStep), string(storage.ConfirmStep)
+	success, fail := string(storage.SuccessStatus), string(storage.FailStatus)
+
+	inq := fakeInquirer{
+		"paid":      {status: "PAID"},
+		"open":      {status: perahub.AwaitPayment},
+		"cancelled": {status: "Cancelled"},
+		"unknown":   {status: "??"},
+		"notfound":  {err: status.Error(codes.NotFound, "not found")},
+		"error":     {err: status.Error(codes.Unavailable, "down")},
+		"timeout":   {err: status.Error(codes.DeadlineExceeded, "timeout")},
+		"5xx":       {err: &perahub.Error{Code: "502", GRPCCode: codes.Internal, Msg: "bad gateway"}},
+		"rejected":  {err: status.Error(codes.InvalidArgument, "bad request")},
+	}
+	tests := []struct {
+		desc     string
+		in       storage.RemitHistory
+		want     storage.TxnStatus
+		wantStep string
+		cache    storage.TxnStep
+	}{
+		{
+			desc:     "Disburse Paid",
+			in:       storage.RemitHistory{RemType: disburse, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "paid"},
+			want:     storage.SuccessStatus,
+			wantStep: confirm,
+			cache:    storage.ConfirmStep,
+		},
+		{
+			desc:     "Disburse Awaiting Payout",
+			in:       storage.RemitHistory{RemType: disburse, TxnStep: stage, TxnStatus: success, RemcoControlNo: "open"},
+			want:     storage.FailStatus,
+			wantStep: stage,
+			cache:    storage.FailStep,
+		},
+		{
+			desc:     "Send Available",
+			in:       storage.RemitHistory{RemType: send, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "open"},
+			want:     storage.SuccessStatus,
+			wantStep: confirm,
+			cache:    storage.ConfirmStep,
+		},
+		{
+			desc:     "Staged Send Available",
+			in:       storage.RemitHistory{RemType: send, TxnStep: stage, TxnStatus: success, RemcoControlNo: "open"},
+			want:     storage.ReviewStatus,
+			wantStep: stage,
+		},
+		{
+			desc:     "Cancelled",
+			in:       storage.RemitHistory{RemType: send, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "cancelled"},
+			want:     storage.FailStatus,
+			wantStep: confirm,
+			cache:    storage.FailStep,
+		},
+		{
+			desc:     "Unknown Status",
+			in:       storage.RemitHistory{RemType: disburse, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "unknown"},
+			want:     storage.ReviewStatus,
+			wantStep: confirm,
+		},
+		{
+			desc:     "Send Not Found",
+			in:       storage.RemitHistory{RemType: send, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "notfound"},
+			want:     storage.FailStatus,
+			wantStep: confirm,
+			cache:    storage.FailStep,
+		},
+		{
+			desc:     "Disburse Not Found",
+			in:       storage.RemitHistory{RemType: disburse, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "notfound"},
+			want:     storage.ReviewStatus,
+			wantStep: confirm,
+		},
+		{
+			desc: "Inquire Unavailable",
+			in:   storage.RemitHistory{RemType: disburse, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "error"},
+		},
+		{
+			desc: "Inquire Timeout",
+			in:   storage.RemitHistory{RemType: send, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "timeout"},
+		},
+		{
+			desc: "Inquire Partner 5xx",
+			in:   storage.RemitHistory{RemType: send, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "5xx"},
+		},
+		{
+			desc:     "Inquire Rejected",
+			in:       storage.RemitHistory{RemType: disburse, TxnStep: confirm, TxnStatus: fail, RemcoControlNo: "rejected"},
+			want:     storage.ReviewStatus,
+			wantStep: confirm,
+		},
+		{
+			desc:     "Staged No Control Number",
+			in:       storage.RemitHistory{RemType: send, TxnStep: stage, TxnStatus: success},
+			want:     storage.FailStatus,
+			wantStep: stage,
+			cache:    storage.FailStep,
+		},
+		{
+			desc:     "Failed No Control Number",
+			in:       storage.RemitHistory{RemType: send, TxnStep: confirm, TxnStatus: fail},
+			want:     storage.ReviewStatus,
+			wantStep: confirm,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			test.in.TxnID = test.desc
+			test.in.RemcoID = static.WUCode
+			st := &fakeStore{rs: []storage.RemitHistory{test.in}}
+			if err := New(st, inq).Reconcile(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			if test.want == "" {
+				if len(st.audit) != 0 {
+					t.Fatalf("want transaction untouched got %+v", st.audit)
+				}
+				if len(st.attempts) != 1 || st.attempts[0] != test.in.TxnID {
+					t.Fatalf("want attempt recorded got %v", st.attempts)
+				}
+				return
+			}
+			if len(st.audit) != 1 {
+				t.Fatalf("want 1 audit entry got %d", len(st.audit))
+			}
+			if len(st.attempts) != 0 {
+				t.Errorf("want no attempt recorded got %v", st.attempts)
+			}
+			want := storage.RemitReconcile{
+				TxnID:          test.in.TxnID,
+				RemcoID:        test.in.RemcoID,
+				RemType:        test.in.RemType,
+				RemcoControlNo: test.in.RemcoControlNo,
+				FromStatus:     test.in.TxnStatus,
+				FromStep:       test.in.TxnStep,
+				ToStatus:       string(test.want),
+				ToStep:         test.wantStep,
+			}
+			o := cmpopts.IgnoreFields(storage.RemitReconcile{}, "Message", "PartnerStatus")
+			if !cmp.Equal(want, st.audit[0], o) {
+				t.Error(cmp.Diff(want, st.audit[0], o))
+			}
+			if st.steps[0] != test.cache {
+				t.Errorf("want cache step %q got %q", test.cache, st.steps[0])
+			}
+		})
+	}
+}
@@ -84,6 +84,10 @@ type Remittance struct {
 	FixedAmountFlag string
 
 	IntlPrtCode string
+
+	// OtherInfo holds the other info fields of the partner, they are sent to
+	// the partner with the send or payout request.
+	OtherInfo map[string]string
 }
 
 type TransactionDetails struct {
@@ -175,6 +179,7 @@ type ProcessRemit struct {
 	TransactionID string
 	AuthSource    string
 	AuthCode      string
+	OTP           string
 	ControlNumber string
 	Processed     time.Time
 	RemitCache    storage.RemitCache
@@ -0,0 +1,34 @@
+package remit
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// EnrolledRemitter returns the KYC of a customer enrolled with the partner
+// through the org, looked up by customer code or WU card number.
+func (s *Svc) EnrolledRemitter(ctx context.Context, partner, id string) (*core.UserKYC, error) {
+	log := logging.FromContext(ctx)
+	e, err := s.st.GetEnrolledCustomer(ctx, getDSAOrgID(ctx), partner, id)
+	switch {
+	case err == storage.ErrNotFound:
+		return nil, coreerror.NewCoreError(codes.NotFound, "customer not enrolled")
+	case err != nil:
+		logging.WithError(err, log).Error("getting enrolled customer")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	var kyc core.UserKYC
+	if err := json.Unmarshal(e.Details, &kyc); err != nil {
+		logging.WithError(err, log).Error("reading enrolled customer")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	kyc.PartnerMemberID = e.CustomerCode
+	return &kyc, nil
+}
@@ -15,7 +15,10 @@ import (
 // ListRemit historical transactions.
 func (s *Svc) ListRemit(ctx context.Context, f core.FilterList) (*core.SearchRemitResponse, error) {
 	log := logging.FromContext(ctx)
-	var err error
+	after, err := storage.ParseCursor(f.PageToken)
+	if err != nil {
+		return nil, err
+	}
 	fl := storage.LRHFilter{
 		TxnStep:        string(storage.ConfirmStep),
 		TxnStatus:      string(storage.SuccessStatus),
@@ -28,6 +31,9 @@ func (s *Svc) ListRemit(ctx context.Context, f core.FilterList) (*core.SearchRem
 		ControlNo:      f.ControlNumbers,
 		ExcludePartner: f.ExcludePartner,
 		ExcludeType:    f.ExcludeType,
+		BranchID:       f.BranchID,
+		TellerID:       f.TellerID,
+		After:          after,
 	}
 	fl.DsaOrgID = getDSAOrgID(ctx)
 	if fl.DsaOrgID == "" {
@@ -103,18 +109,30 @@ func (s *Svc) ListRemit(ctx context.Context, f core.FilterList) (*core.SearchRem
 			Charge:           rm.Charge,
 			TxnStagedTime:    rh.TxnStagedTime.Time,
 			TxnCompletedTime: rh.TxnCompletedTime.Time,
+			OTPUsed:          rh.OTPUsed,
+			PayoutStatus:     rh.PayoutStatus,
+			PaidOutTime:      rh.PaidOutTime.Time,
+			BranchID:         rh.BranchID,
+			TellerID:         rh.TellerID,
 		}
 		lst = append(lst, rmt)
 	}
 
 	tot := 0
+	next := ""
 	if len(rhs) > 0 {
 		tot = rhs[0].Total
+		last := rhs[len(rhs)-1]
+		next = storage.NextPageToken(f.SortByColumn, f.Limit, len(rhs), storage.Cursor{
+			Created: last.Created,
+			ID:      last.TxnID,
+		})
 	}
 
 	return &core.SearchRemitResponse{
-		Total:        tot,
-		SearchRemits: lst,
+		Total:         tot,
+		SearchRemits:  lst,
+		NextPageToken: next,
 	}, nil
 }
 
@@ -7,5 +7,9 @@ import (
 )
 
 func (s *Svc) SearchRemit(ctx context.Context, r core.SearchRemit, partner string) (*core.SearchRemit, error) {
-	return s.remitters[partner].Search(ctx, r)
+	rm, err := s.remitter(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	return rm.Search(ctx, r)
 }
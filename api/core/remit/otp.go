@@ -0,0 +1,224 @@
+package remit
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+	mpb "brank.as/rbac/gunk/v1/mfa"
+)
+
+const (
+	defaultOTPTTL      = 5 * time.Minute
+	defaultOTPAttempts = 3
+)
+
+type otpStore interface {
+	UpsertRemitOTP(context.Context, storage.RemitOTP) (*storage.RemitOTP, error)
+	GetRemitOTP(context.Context, string) (*storage.RemitOTP, error)
+	AttemptRemitOTP(context.Context, string) (*storage.RemitOTP, error)
+	VerifyRemitOTP(context.Context, string) (*storage.RemitOTP, error)
+	SetRemitHistoryOTPUsed(context.Context, string) error
+}
+
+// otpPolicy decides which remittances need an OTP before they are confirmed.
+// OTPs are issued and validated through the RBAC MFA service.
+type otpPolicy struct {
+	st       otpStore
+	mfa      mpb.MFAAuthServiceClient
+	typ      mpb.MFA
+	dsas     map[string]bool
+	partners map[string]bool
+	ttl      time.Duration
+	attempts int
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithOTP enables OTP confirmation of remittances. Which remittances need it
+// is set with WithOTPDSAs and WithOTPPartners.
+func WithOTP(st otpStore, mfa mpb.MFAAuthServiceClient) Option {
+	return func(s *Svc) {
+		s.otp.st = st
+		s.otp.mfa = mfa
+	}
+}
+
+// WithOTPType sets the MFA type the OTPs are issued as, such as "SMS" or
+// "EMAIL". Unknown types are ignored.
+func WithOTPType(typ string) Option {
+	return func(s *Svc) {
+		if t, ok := mpb.MFA_value[strings.ToUpper(typ)]; ok {
+			s.otp.typ = mpb.MFA(t)
+		}
+	}
+}
+
+// WithOTPDSAs requires an OTP for the remittances of the DSAs.
+func WithOTPDSAs(ids ...string) Option {
+	return func(s *Svc) {
+		for _, id := range ids {
+			s.otp.dsas[id] = true
+		}
+	}
+}
+
+// WithOTPPartners requires an OTP for the remittances of the partners.
+func WithOTPPartners(codes ...string) Option {
+	return func(s *Svc) {
+		for _, c := range codes {
+			s.otp.partners[c] = true
+		}
+	}
+}
+
+// WithOTPTTL sets how long an issued OTP can be used.
+func WithOTPTTL(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.otp.ttl = d
+		}
+	}
+}
+
+// WithOTPAttempts sets how many times an OTP can be tried before a new one
+// has to be requested.
+func WithOTPAttempts(n int) Option {
+	return func(s *Svc) {
+		if n > 0 {
+			s.otp.attempts = n
+		}
+	}
+}
+
+func (p *otpPolicy) required(dsaID, partner string) bool {
+	if p.st == nil || p.mfa == nil {
+		return false
+	}
+	return p.dsas[dsaID] || p.partners[partner]
+}
+
+// RequestOTP issues the OTP needed to confirm a staged remittance, replacing
+// the one issued before.
+func (s *Svc) RequestOTP(ctx context.Context, txnID string) (*storage.RemitOTP, error) {
+	log := logging.FromContext(ctx).WithField("transaction_id", txnID)
+	rc, err := s.st.GetRemitCache(ctx, txnID)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, coreerror.NewCoreError(codes.NotFound, "transaction not found")
+		}
+		logging.WithError(err, log).Error("get remit cache")
+		return nil, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	// OTPs are only issued to the DSA that staged the transaction.
+	if dsa := getDSAOrgID(ctx); dsa == "" || dsa != rc.DsaID {
+		return nil, coreerror.NewCoreError(codes.NotFound, "transaction not found")
+	}
+	if !s.otp.required(rc.DsaID, rc.RemcoID) {
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "otp not required")
+	}
+
+	ev, err := s.otp.mfa.InitiateMFA(ctx, &mpb.InitiateMFARequest{
+		UserID:      rc.UserID,
+		Type:        s.otp.typ,
+		Description: "confirm remittance " + txnID,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("initiating mfa")
+		return nil, err
+	}
+	o, err := s.otp.st.UpsertRemitOTP(ctx, storage.RemitOTP{
+		TxnID:   txnID,
+		DsaID:   rc.DsaID,
+		UserID:  rc.UserID,
+		RemcoID: rc.RemcoID,
+		EventID: ev.GetEventID(),
+		Expires: time.Now().Add(s.otp.ttl),
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("storing remit otp")
+		return nil, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	return o, nil
+}
+
+// checkOTP validates the OTP of a remittance if the policy requires one. It
+// reports whether an OTP was used.
+func (s *Svc) checkOTP(ctx context.Context, r core.ProcessRemit, partner string) (bool, error) {
+	log := logging.FromContext(ctx).WithField("transaction_id", r.TransactionID)
+	if s.otp.st == nil || s.otp.mfa == nil {
+		return false, nil
+	}
+	rc, err := s.st.GetRemitCache(ctx, r.TransactionID)
+	switch {
+	case err == storage.ErrNotFound:
+		// left to the remitter, which reports the missing transaction.
+		return false, nil
+	case err != nil:
+		logging.WithError(err, log).Error("get remit cache")
+		return false, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	if !s.otp.required(rc.DsaID, partner) {
+		return false, nil
+	}
+
+	o, err := s.otp.st.GetRemitOTP(ctx, r.TransactionID)
+	switch {
+	case err == storage.ErrNotFound:
+		return false, coreerror.NewCoreError(codes.FailedPrecondition, "otp required")
+	case err != nil:
+		logging.WithError(err, log).Error("get remit otp")
+		return false, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	// a verified otp stays valid so a failed confirm can be retried.
+	if o.Verified.Valid {
+		return true, nil
+	}
+	switch {
+	case r.OTP == "":
+		return false, coreerror.NewCoreError(codes.FailedPrecondition, "otp required")
+	case time.Now().After(o.Expires):
+		return false, coreerror.NewCoreError(codes.FailedPrecondition, "otp expired")
+	}
+
+	// the attempt is counted before it is checked so concurrent confirms
+	// cannot try more codes than allowed.
+	a, err := s.otp.st.AttemptRemitOTP(ctx, r.TransactionID)
+	if err != nil {
+		logging.WithError(err, log).Error("counting remit otp attempt")
+		return false, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	if a.Attempts > s.otp.attempts {
+		return false, coreerror.NewCoreError(codes.ResourceExhausted, "too many otp attempts")
+	}
+	if _, err := s.otp.mfa.ValidateMFA(ctx, &mpb.ValidateMFARequest{
+		UserID:  o.UserID,
+		Type:    s.otp.typ,
+		Token:   r.OTP,
+		EventID: o.EventID,
+	}); err != nil {
+		logging.WithError(err, log).Error("validating mfa")
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			// the mfa service rejects a wrong or already used token as
+			// invalid argument
+			return false, coreerror.NewCoreError(codes.InvalidArgument, "invalid otp")
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return false, coreerror.NewCoreError(codes.Unavailable, "otp validation unavailable")
+		}
+		return false, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	if _, err := s.otp.st.VerifyRemitOTP(ctx, r.TransactionID); err != nil {
+		logging.WithError(err, log).Error("verifying remit otp")
+		return false, coreerror.NewCoreError(codes.Internal, "processing")
+	}
+	return true, nil
+}
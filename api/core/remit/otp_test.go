@@ -0,0 +1,208 @@
+package remit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/auth/hydra"
+	mpb "brank.as/rbac/gunk/v1/mfa"
+)
+
+type fakeOTPStore struct {
+	remitStore
+	rc    storage.RemitCache
+	rcErr error
+	otp   *storage.RemitOTP
+	used  bool
+}
+
+func (f *fakeOTPStore) GetRemitCache(context.Context, string) (*storage.RemitCache, error) {
+	if f.rcErr != nil {
+		return nil, f.rcErr
+	}
+	return &f.rc, nil
+}
+
+func (f *fakeOTPStore) UpsertRemitOTP(_ context.Context, r storage.RemitOTP) (*storage.RemitOTP, error) {
+	f.otp = &r
+	return &r, nil
+}
+
+func (f *fakeOTPStore) GetRemitOTP(context.Context, string) (*storage.RemitOTP, error) {
+	if f.otp == nil {
+		return nil, storage.ErrNotFound
+	}
+	o := *f.otp
+	return &o, nil
+}
+
+func (f *fakeOTPStore) AttemptRemitOTP(context.Context, string) (*storage.RemitOTP, error) {
+	f.otp.Attempts++
+	return f.otp, nil
+}
+
+func (f *fakeOTPStore) VerifyRemitOTP(context.Context, string) (*storage.RemitOTP, error) {
+	f.otp.Verified = sql.NullTime{Time: time.Now(), Valid: true}
+	return f.otp, nil
+}
+
+func (f *fakeOTPStore) SetRemitHistoryOTPUsed(context.Context, string) error {
+	f.used = true
+	return nil
+}
+
+type fakeMFA struct {
+	mpb.MFAAuthServiceClient
+	code string
+	err  error
+}
+
+func (f *fakeMFA) InitiateMFA(context.Context, *mpb.InitiateMFARequest, ...grpc.CallOption) (*mpb.InitiateMFAResponse, error) {
+	return &mpb.InitiateMFAResponse{EventID: "event"}, nil
+}
+
+func (f *fakeMFA) ValidateMFA(_ context.Context, req *mpb.ValidateMFARequest, _ ...grpc.CallOption) (*mpb.ValidateMFAResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if req.GetEventID() != "event" || req.GetToken() != f.code {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+	return &mpb.ValidateMFAResponse{EventID: req.GetEventID()}, nil
+}
+
+func errCode(err error) codes.Code {
+	var cerr *coreerror.Error
+	if errors.As(err, &cerr) {
+		return cerr.Code
+	}
+	return status.Code(err)
+}
+
+func TestCheckOTP(t *testing.T) {
+	ctx := metautils.ExtractIncoming(context.Background()).Set(hydra.OrgIDKey, "dsa").ToIncoming(context.Background())
+	newSvc := func(opts ...Option) (*Svc, *fakeOTPStore) {
+		st := &fakeOTPStore{rc: storage.RemitCache{TxnID: "txn", DsaID: "dsa", UserID: "user", RemcoID: "WU"}}
+		opts = append([]Option{WithOTP(st, &fakeMFA{code: "123456"}), WithOTPAttempts(2)}, opts...)
+		s, err := New(st, nil, nil, nil, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s, st
+	}
+	pr := func(otp string) core.ProcessRemit {
+		return core.ProcessRemit{TransactionID: "txn", OTP: otp}
+	}
+
+	t.Run("NotRequired", func(t *testing.T) {
+		s, _ := newSvc(WithOTPDSAs("other"))
+		used, err := s.checkOTP(ctx, pr(""), "WU")
+		if err != nil || used {
+			t.Fatalf("checkOTP() = %v, %v, want false, nil", used, err)
+		}
+		if _, err := s.RequestOTP(ctx, "txn"); errCode(err) != codes.FailedPrecondition {
+			t.Fatalf("RequestOTP() = got error %v, want %v", err, codes.FailedPrecondition)
+		}
+	})
+
+	t.Run("NotRequested", func(t *testing.T) {
+		s, _ := newSvc(WithOTPPartners("WU"))
+		if _, err := s.checkOTP(ctx, pr("123456"), "WU"); errCode(err) != codes.FailedPrecondition {
+			t.Fatalf("checkOTP() = got error %v, want %v", err, codes.FailedPrecondition)
+		}
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		s, st := newSvc(WithOTPDSAs("dsa"))
+		if _, err := s.RequestOTP(ctx, "txn"); err != nil {
+			t.Fatalf("RequestOTP() = got error %v, want nil", err)
+		}
+		if _, err := s.checkOTP(ctx, pr("000000"), "WU"); errCode(err) != codes.InvalidArgument {
+			t.Fatalf("checkOTP() = got error %v, want %v", err, codes.InvalidArgument)
+		}
+		used, err := s.checkOTP(ctx, pr("123456"), "WU")
+		if err != nil || !used {
+			t.Fatalf("checkOTP() = %v, %v, want true, nil", used, err)
+		}
+		if !st.otp.Verified.Valid {
+			t.Fatal("checkOTP() = want verified otp")
+		}
+	})
+
+	t.Run("TooManyAttempts", func(t *testing.T) {
+		s, _ := newSvc(WithOTPPartners("WU"))
+		if _, err := s.RequestOTP(ctx, "txn"); err != nil {
+			t.Fatalf("RequestOTP() = got error %v, want nil", err)
+		}
+		for i := 0; i < 2; i++ {
+			if _, err := s.checkOTP(ctx, pr("000000"), "WU"); errCode(err) != codes.InvalidArgument {
+				t.Fatalf("checkOTP() = got error %v, want %v", err, codes.InvalidArgument)
+			}
+		}
+		if _, err := s.checkOTP(ctx, pr("123456"), "WU"); errCode(err) != codes.ResourceExhausted {
+			t.Fatalf("checkOTP() = got error %v, want %v", err, codes.ResourceExhausted)
+		}
+	})
+
+	t.Run("OtherDSA", func(t *testing.T) {
+		s, _ := newSvc(WithOTPDSAs("dsa"))
+		octx := metautils.ExtractIncoming(ctx).Set(hydra.OrgIDKey, "other").ToIncoming(ctx)
+		if _, err := s.RequestOTP(octx, "txn"); errCode(err) != codes.NotFound {
+			t.Fatalf("RequestOTP() = got error %v, want %v", err, codes.NotFound)
+		}
+	})
+
+	t.Run("RemitCacheError", func(t *testing.T) {
+		s, st := newSvc(WithOTPDSAs("dsa"))
+		st.rcErr = storage.ErrNotFound
+		if used, err := s.checkOTP(ctx, pr(""), "WU"); err != nil || used {
+			t.Fatalf("checkOTP() = %v, %v, want false, nil", used, err)
+		}
+		st.rcErr = errors.New("connection reset")
+		if _, err := s.checkOTP(ctx, pr(""), "WU"); errCode(err) != codes.Internal {
+			t.Fatalf("checkOTP() = got error %v, want %v", err, codes.Internal)
+		}
+	})
+
+	t.Run("MFAError", func(t *testing.T) {
+		for _, test := range []struct {
+			err  error
+			want codes.Code
+		}{
+			{err: status.Error(codes.Unavailable, "connection refused"), want: codes.Unavailable},
+			{err: status.Error(codes.DeadlineExceeded, "timeout"), want: codes.Unavailable},
+			{err: status.Error(codes.Internal, "db down"), want: codes.Internal},
+		} {
+			s, _ := newSvc(WithOTPDSAs("dsa"))
+			s.otp.mfa = &fakeMFA{code: "123456", err: test.err}
+			if _, err := s.RequestOTP(ctx, "txn"); err != nil {
+				t.Fatalf("RequestOTP() = got error %v, want nil", err)
+			}
+			if _, err := s.checkOTP(ctx, pr("123456"), "WU"); errCode(err) != test.want {
+				t.Errorf("checkOTP() = got error %v, want %v", err, test.want)
+			}
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		s, st := newSvc(WithOTPPartners("WU"))
+		if _, err := s.RequestOTP(ctx, "txn"); err != nil {
+			t.Fatalf("RequestOTP() = got error %v, want nil", err)
+		}
+		st.otp.Expires = time.Now().Add(-time.Second)
+		if _, err := s.checkOTP(ctx, pr("123456"), "WU"); errCode(err) != codes.FailedPrecondition {
+			t.Fatalf("checkOTP() = got error %v, want %v", err, codes.FailedPrecondition)
+		}
+	})
+}
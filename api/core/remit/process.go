@@ -2,10 +2,55 @@ package remit
 
 import (
 	"context"
+	"encoding/json"
 
 	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
 )
 
 func (s *Svc) ProcessRemit(ctx context.Context, r core.ProcessRemit, partner string) (*core.ProcessRemit, error) {
-	return s.remitters[partner].ProcessRemit(ctx, r)
+	rm, err := s.remitter(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	otp, err := s.checkOTP(ctx, r, partner)
+	if err != nil {
+		return nil, err
+	}
+	res, err := rm.ProcessRemit(s.withOtherInfo(ctx, r.TransactionID), r)
+	if err != nil || !otp {
+		return res, err
+	}
+	if err := s.otp.st.SetRemitHistoryOTPUsed(ctx, r.TransactionID); err != nil {
+		logging.WithError(err, logging.FromContext(ctx)).Error("recording otp use")
+	}
+	return res, nil
+}
+
+// withOtherInfo adds the other info staged with the remittance to the context,
+// so it is sent with the request to the partner.
+func (s *Svc) withOtherInfo(ctx context.Context, txnID string) context.Context {
+	if s.st == nil {
+		return ctx
+	}
+	log := logging.FromContext(ctx).WithField("transaction_id", txnID)
+	rc, err := s.st.GetRemitCache(ctx, txnID)
+	switch {
+	case err == storage.ErrNotFound:
+		// left to the remitter, which reports the missing transaction.
+		return ctx
+	case err != nil:
+		logging.WithError(err, log).Error("get remit cache")
+		return ctx
+	}
+	var c struct {
+		StageReq core.Remittance `json:"stage_request"`
+	}
+	if err := json.Unmarshal(rc.Remit, &c); err != nil {
+		logging.WithError(err, log).Error("unmarshal remit cache")
+		return ctx
+	}
+	return perahub.WithOtherInfo(ctx, c.StageReq.OtherInfo)
 }
@@ -4,16 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/api/core/static"
 	"brank.as/petnet/api/integration/perahub"
 	"brank.as/petnet/api/storage"
 	"brank.as/petnet/serviceutil/logging"
+	mpb "brank.as/rbac/gunk/v1/mfa"
 )
 
 type remitStore interface {
 	GetRemitCache(context.Context, string) (*storage.RemitCache, error)
 	ListRemitHistory(context.Context, storage.LRHFilter) ([]storage.RemitHistory, error)
+	GetEnrolledCustomer(ctx context.Context, orgID, partner, id string) (*storage.CustomerEnrollment, error)
 }
 
 type Remitter interface {
@@ -29,14 +34,22 @@ type Svc struct {
 	st        remitStore
 	ph        *perahub.Svc
 	rc        *static.Svc
+	otp       otpPolicy
 }
 
-func New(store remitStore, wu *perahub.Svc, rc *static.Svc, rs []Remitter) (*Svc, error) {
+func New(store remitStore, wu *perahub.Svc, rc *static.Svc, rs []Remitter, opts ...Option) (*Svc, error) {
 	s := &Svc{
 		remitters: make(map[string]Remitter, len(rs)),
 		st:        store,
 		ph:        wu,
 		rc:        rc,
+		otp: otpPolicy{
+			typ:      mpb.MFA_SMS,
+			dsas:     map[string]bool{},
+			partners: map[string]bool{},
+			ttl:      defaultOTPTTL,
+			attempts: defaultOTPAttempts,
+		},
 	}
 	for i, r := range rs {
 		switch {
@@ -47,9 +60,24 @@ func New(store remitStore, wu *perahub.Svc, rc *static.Svc, rs []Remitter) (*Svc
 		}
 		s.remitters[r.Kind()] = r
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return s, nil
 }
 
+// remitter returns the remitter of a partner enabled in the partner registry.
+func (s *Svc) remitter(ctx context.Context, partner string) (Remitter, error) {
+	if err := s.rc.PartnerCode(ctx, partner); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid remit partner")
+	}
+	rm, ok := s.remitters[partner]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid remit partner")
+	}
+	return rm, nil
+}
+
 func (s *Svc) GetPartnerByTxnID(ctx context.Context, txnID string) (string, error) {
 	log := logging.FromContext(ctx)
 	rm, err := s.st.GetRemitCache(ctx, txnID)
@@ -7,5 +7,9 @@ import (
 )
 
 func (s *Svc) StageCreateRemit(ctx context.Context, r core.Remittance, partner string) (*core.RemitResponse, error) {
-	return s.remitters[partner].StageCreateRemit(ctx, r)
+	rm, err := s.remitter(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	return rm.StageCreateRemit(ctx, r)
 }
@@ -7,5 +7,9 @@ import (
 )
 
 func (s *Svc) StageDisburseRemit(ctx context.Context, r core.Remittance, partner string) (*core.Remittance, error) {
-	return s.remitters[partner].StageDisburseRemit(ctx, r)
+	rm, err := s.remitter(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	return rm.StageDisburseRemit(ctx, r)
 }
@@ -0,0 +1,29 @@
+package remit
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+)
+
+// StatusInquirer is implemented by the remitters that can inquire the payout
+// status of a remittance with the partner.
+type StatusInquirer interface {
+	RemitStatus(ctx context.Context, controlNo string) (*core.RemitStatus, error)
+}
+
+// RemitStatus inquires the payout status of a remittance with the partner.
+func (s *Svc) RemitStatus(ctx context.Context, controlNo, partner string) (*core.RemitStatus, error) {
+	rm, err := s.remitter(ctx, partner)
+	if err != nil {
+		return nil, err
+	}
+	si, ok := rm.(StatusInquirer)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "status inquiry not supported for partner")
+	}
+	return si.RemitStatus(ctx, controlNo)
+}
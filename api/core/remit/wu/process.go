@@ -17,7 +17,6 @@ func (s *Svc) ProcessRemit(ctx context.Context, r core.ProcessRemit) (*core.Proc
 	log := logging.FromContext(ctx).WithField("txID", r.TransactionID)
 
 	log.Trace("processing")
-	// TODO: Need to implement OTP validation
 	rc, err := s.st.GetRemitCache(ctx, r.TransactionID)
 	if err != nil {
 		if err == storage.ErrNotFound {
@@ -340,7 +340,7 @@ func (s *Svc) sendQuickPay(ctx context.Context, r core.ProcessRemit) (rres *core
 	})
 	if err != nil {
 		logging.WithError(err, log).Error("quickpay store")
-		r.RemitCache.Step = storage.TxnStep(storage.FailStatus)
+		r.RemitCache.Step = storage.FailStep
 		if _, dbErr := s.st.UpdateRemitCache(ctx, r.RemitCache); dbErr != nil {
 			logging.WithError(dbErr, log).Error("send quick pay update cache error")
 		}
@@ -0,0 +1,78 @@
+package wu
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bojanz/currency"
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/serviceutil/logging"
+	"brank.as/petnet/svcutil/random"
+)
+
+// filingLayout is the format of the WU filing date and time.
+const filingLayout = "01 02 06 15:04:05"
+
+// RemitStatus inquires the pay status of a WU transfer.
+func (s *Svc) RemitStatus(ctx context.Context, mtcn string) (*core.RemitStatus, error) {
+	log := logging.FromContext(ctx)
+	res, err := s.ph.PayStatusInquiry(ctx, perahub.PaySIRequest{
+		MTCN:               mtcn,
+		ForeignReferenceNo: random.InvitationCode(20),
+		TerminalID:         getTerminalID(ctx),
+		OperatorID:         "drp",
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("pay status inquiry")
+		return nil, handleWUError(err)
+	}
+	if res.WU.Header.ErrorCode != "1" {
+		return nil, handleWUError(&perahub.Error{
+			Code:     res.WU.Header.ErrorCode,
+			GRPCCode: codes.Internal,
+			Msg:      res.WU.Header.Message,
+		})
+	}
+	if res.WU.Body.NumberMatches == 0 {
+		return nil, coreerror.NewCoreError(codes.NotFound, "not found")
+	}
+
+	txn := res.WU.Body.PaymentTransactions.PaymentTransaction
+	rs := &core.RemitStatus{
+		RemitPartner:  static.WUCode,
+		ControlNo:     mtcn,
+		Status:        payoutStatus(txn.PayStatusDescription),
+		PartnerStatus: txn.PayStatusDescription,
+		SenderName:    strings.TrimSpace(txn.Sender.Name.FirstName + " " + txn.Sender.Name.LastName),
+		ReceiverName:  strings.TrimSpace(txn.Receiver.Name.FirstName + " " + txn.Receiver.Name.LastName),
+	}
+	ccy := txn.PaymentDetails.OriginatingCountryCurrency.IsoCode.CurrencyCode
+	if amt, err := currency.NewMinor(txn.Financials.OrigPnplAmt.String(), ccy); err == nil {
+		rs.RemitAmount = amt
+	}
+	if t, err := time.Parse(filingLayout, txn.FilingDate+" "+txn.FilingTime); err == nil {
+		rs.FilingTime = t
+	}
+	return rs, nil
+}
+
+// payoutStatus normalizes the WU pay status description, reported with or
+// without separators, e.g. "W/C", "W C" and "WC" for will call.
+func payoutStatus(s string) string {
+	s = strings.ToUpper(strings.NewReplacer(" ", "", "/", "", "-", "").Replace(s))
+	switch {
+	case s == "WC":
+		return core.PayoutAvailable
+	case strings.HasPrefix(s, "PAID"):
+		return core.PayoutPaid
+	case strings.HasPrefix(s, "CANC"), strings.HasPrefix(s, "REF"):
+		return core.PayoutCancelled
+	}
+	return core.PayoutUnknown
+}
@@ -0,0 +1,102 @@
+package remittoaccount
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"brank.as/petnet/api/storage"
+	rta "brank.as/petnet/gunk/drp/v1/remittoaccount"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// RTATransactList lists the recorded remit to account transactions.
+func (s *Svc) RTATransactList(ctx context.Context, f *rta.RTATransactListRequest) (*rta.RTATransactListResponse, error) {
+	log := logging.FromContext(ctx)
+	dateFrom, err := time.Parse("2006-01-02", f.GetFrom())
+	if err != nil {
+		dateFrom = time.Time{}
+	}
+	dateUntil, err := time.Parse("2006-01-02", f.GetUntil())
+	if err != nil {
+		dateUntil = time.Now()
+	}
+	after, err := storage.ParseCursor(f.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	fl := storage.RTATrxListFilter{
+		From:            dateFrom,
+		Until:           dateUntil,
+		Limit:           int(f.GetLimit()),
+		Offset:          int(f.GetOffset()),
+		SortOrder:       storage.SortOrder(f.GetSortOrder().String()),
+		SortByColumn:    storage.RTAHistoryColumn(f.GetSortByColumn().String()),
+		ReferenceNumber: f.GetReferenceNumber(),
+		ExcludePartners: f.GetExcludePartners(),
+		OrgID:           f.GetOrgID(),
+		BranchID:        f.GetBranchID(),
+		TellerID:        f.GetTellerID(),
+		After:           after,
+	}
+
+	rhs, err := s.st.ListRTATrx(ctx, fl)
+	if err != nil {
+		logging.WithError(err, log).Error("list RTA transactions")
+		return nil, err
+	}
+	if len(rhs) == 0 {
+		return nil, storage.ErrNotFound
+	}
+
+	trx := make([]*rta.RTATransact, 0, len(rhs))
+	for _, v := range rhs {
+		trx = append(trx, rtaTransact(v))
+	}
+
+	last := rhs[len(rhs)-1]
+	return &rta.RTATransactListResponse{
+		Next:         f.GetOffset() + int32(len(trx)),
+		RTATransacts: trx,
+		Total:        int32(rhs[0].Total),
+		NextPageToken: storage.NextPageToken(f.GetSortByColumn().String(), fl.Limit, len(rhs), storage.Cursor{
+			Created: last.Created,
+			ID:      last.ID,
+		}),
+	}, nil
+}
+
+func rtaTransact(v storage.RemitToAccountHistory) *rta.RTATransact {
+	// the transaction date isn't always sent, the record is made when the
+	// payment completes.
+	dt := v.TrxDate
+	if dt.IsZero() {
+		dt = v.Created
+	}
+	return &rta.RTATransact{
+		ID:                       v.ID,
+		ReferenceNumber:          v.ReferenceNumber,
+		Partner:                  v.Partner,
+		Currency:                 v.Currency,
+		PrincipalAmount:          v.PrincipalAmount,
+		ServiceCharge:            v.ServiceCharge,
+		TotalAmount:              v.TotalAmount,
+		AccountNumber:            v.AccountNumber,
+		AccountName:              v.AccountName,
+		BankID:                   int32(v.BankID),
+		RemitterFirstname:        v.RemitterFirstName,
+		RemitterMiddlename:       v.RemitterMiddleName,
+		RemitterLastname:         v.RemitterLastName,
+		BeneficiaryFirstname:     v.BeneficiaryFirstName,
+		BeneficiaryMiddlename:    v.BeneficiaryMiddleName,
+		BeneficiaryLastname:      v.BeneficiaryLastName,
+		TxnStatus:                v.TxnStatus,
+		ErrorCode:                v.ErrorCode,
+		ErrorMessage:             v.ErrorMessage,
+		TransactionCompletedTime: timestamppb.New(dt),
+		BranchID:                 v.BranchID,
+		TellerID:                 v.TellerID,
+	}
+}
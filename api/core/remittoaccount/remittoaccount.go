@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	rtai "brank.as/petnet/api/integration/remittoaccount"
+	"brank.as/petnet/api/storage/postgres"
 	rta "brank.as/petnet/gunk/drp/v1/remittoaccount"
 )
 
@@ -18,12 +19,14 @@ type Remitter interface {
 type Svc struct {
 	remitters map[string]Remitter
 	remitAcc  *rtai.Client
+	st        *postgres.Storage
 }
 
-func New(rs []Remitter, RemitAcc *rtai.Client) (*Svc, error) {
+func New(rs []Remitter, RemitAcc *rtai.Client, st *postgres.Storage) (*Svc, error) {
 	s := &Svc{
 		remitters: make(map[string]Remitter, len(rs)),
 		remitAcc:  RemitAcc,
+		st:        st,
 	}
 	for i, r := range rs {
 		switch {
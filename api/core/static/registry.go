@@ -0,0 +1,208 @@
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// partner is an enabled partner of the partner registry.
+type partner struct {
+	core.Remco
+	countries []string
+}
+
+func (p partner) in(cty string) bool {
+	for _, c := range p.countries {
+		if c == cty {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Svc) partner(ctx context.Context, code string) (*partner, error) {
+	ps, err := s.partners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range ps {
+		if p.Code == code {
+			return &p, nil
+		}
+	}
+	return nil, storage.ErrNotFound
+}
+
+// partners returns the enabled partners of the registry, read again once the
+// cache expires. The stale partners are returned if the registry is
+// unavailable.
+func (s *Svc) partners(ctx context.Context) ([]partner, error) {
+	s.mu.RLock()
+	ps, loaded := s.cache, s.loaded
+	s.mu.RUnlock()
+	if ps != nil && time.Since(loaded) < s.ttl {
+		return ps, nil
+	}
+
+	log := logging.FromContext(ctx)
+	l, err := s.load(ctx)
+	if err != nil {
+		if ps != nil {
+			logging.WithError(err, log).Error("reloading partner registry, using cached partners")
+			return ps, nil
+		}
+		return nil, err
+	}
+	s.mu.Lock()
+	s.cache, s.loaded = l, time.Now()
+	s.mu.Unlock()
+	return l, nil
+}
+
+// invalidate drops the cached partners so the next lookup reads the registry.
+func (s *Svc) invalidate() {
+	s.mu.Lock()
+	s.cache = nil
+	s.mu.Unlock()
+}
+
+func (s *Svc) load(ctx context.Context) ([]partner, error) {
+	if s.st == nil {
+		return nil, errors.New("missing partner registry storage")
+	}
+	rs, err := s.st.ListRemitPartner(ctx, storage.RemitPartnerFilter{EnabledOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	ps := make([]partner, 0, len(rs))
+	for _, r := range rs {
+		p, err := toPartner(r)
+		if err != nil {
+			return nil, fmt.Errorf("partner %s: %w", r.Code, err)
+		}
+		ps = append(ps, *p)
+	}
+	return ps, nil
+}
+
+// RegistryPartners lists all partners of the registry, including the disabled.
+func (s *Svc) RegistryPartners(ctx context.Context) ([]storage.RemitPartner, error) {
+	return s.st.ListRemitPartner(ctx, storage.RemitPartnerFilter{})
+}
+
+// RegistryPartner returns a partner of the registry.
+func (s *Svc) RegistryPartner(ctx context.Context, code string) (*storage.RemitPartner, error) {
+	return s.st.GetRemitPartner(ctx, code)
+}
+
+// CreateRegistryPartner registers a partner.
+func (s *Svc) CreateRegistryPartner(ctx context.Context, r storage.RemitPartner) (*storage.RemitPartner, error) {
+	if _, err := toPartner(r); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid remit types")
+	}
+	defer s.invalidate()
+	return s.st.CreateRemitPartner(ctx, r)
+}
+
+// UpdateRegistryPartner updates a registered partner.
+func (s *Svc) UpdateRegistryPartner(ctx context.Context, r storage.RemitPartner) (*storage.RemitPartner, error) {
+	if _, err := toPartner(r); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid remit types")
+	}
+	defer s.invalidate()
+	return s.st.UpdateRemitPartner(ctx, r)
+}
+
+// DeleteRegistryPartner removes a partner from the registry.
+func (s *Svc) DeleteRegistryPartner(ctx context.Context, code string) error {
+	defer s.invalidate()
+	return s.st.DeleteRemitPartner(ctx, code)
+}
+
+func toPartner(r storage.RemitPartner) (*partner, error) {
+	var st, dt map[string]storage.RemitType
+	if len(r.SendTypes) > 0 {
+		if err := json.Unmarshal(r.SendTypes, &st); err != nil {
+			return nil, err
+		}
+	}
+	if len(r.DisburseTypes) > 0 {
+		if err := json.Unmarshal(r.DisburseTypes, &dt); err != nil {
+			return nil, err
+		}
+	}
+	p := &partner{
+		Remco: core.Remco{
+			Code: r.Code,
+			Name: r.Name,
+		},
+		countries: r.Countries,
+	}
+	if len(st) > 0 {
+		p.SendTypes = make(map[string]core.SendRemitType, len(st))
+		for k, t := range st {
+			p.SendTypes[k] = core.SendRemitType{
+				Code:        t.Code,
+				Description: t.Description,
+				Receiver:    t.Receiver,
+				BankAccount: t.BankAccount,
+				Business:    t.Business,
+			}
+		}
+	}
+	if len(dt) > 0 {
+		p.DisburseTypes = make(map[string]core.DisburseRemitType, len(dt))
+		for k, t := range dt {
+			p.DisburseTypes[k] = core.DisburseRemitType{
+				Code:        t.Code,
+				Description: t.Description,
+			}
+		}
+	}
+	return p, nil
+}
+
+// FromRemco converts a partner to its registry record.
+func FromRemco(p core.Remco, countries []string) (*storage.RemitPartner, error) {
+	st := make(map[string]storage.RemitType, len(p.SendTypes))
+	for k, t := range p.SendTypes {
+		st[k] = storage.RemitType{
+			Code:        t.Code,
+			Description: t.Description,
+			Receiver:    t.Receiver,
+			BankAccount: t.BankAccount,
+			Business:    t.Business,
+		}
+	}
+	dt := make(map[string]storage.RemitType, len(p.DisburseTypes))
+	for k, t := range p.DisburseTypes {
+		dt[k] = storage.RemitType{Code: t.Code, Description: t.Description}
+	}
+	sb, err := json.Marshal(st)
+	if err != nil {
+		return nil, err
+	}
+	db, err := json.Marshal(dt)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(countries)
+	return &storage.RemitPartner{
+		Code:          p.Code,
+		Name:          p.Name,
+		Countries:     countries,
+		SendTypes:     sb,
+		DisburseTypes: db,
+	}, nil
+}
@@ -2,9 +2,12 @@ package static
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/api/storage/postgres"
+	"brank.as/petnet/serviceutil/logging"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -50,6 +53,8 @@ const (
 	Sendout = "SO"
 )
 
+// Partners are the built-in partners by country the partner registry is
+// seeded with by migration, used if the registry is unavailable.
 var Partners = map[string][]core.Remco{
 	"PH": {
 		{
@@ -289,25 +294,47 @@ type CountrySource interface {
 }
 
 type Svc struct {
-	all []core.Remco
 	cty CountrySource
 	st  *postgres.Storage
+	ttl time.Duration
+
+	mu     sync.RWMutex
+	cache  []partner
+	loaded time.Time
 }
 
-func New(cty CountrySource, st *postgres.Storage) *Svc {
-	a := []core.Remco{}
-	for k := range Partners {
-		a = append(a, Partners[k]...)
+type Option func(*Svc)
+
+// WithCacheTTL sets how long partners are cached before the registry is read
+// again, defaults to a minute.
+func WithCacheTTL(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.ttl = d
+		}
 	}
-	return &Svc{all: a, cty: cty, st: st}
+}
+
+func New(cty CountrySource, st *postgres.Storage, opts ...Option) *Svc {
+	s := &Svc{cty: cty, st: st, ttl: time.Minute}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
 }
 
 func (s *Svc) ListPartners(ctx context.Context, cty string) ([]core.Remco, error) {
-	if cty == "" {
-		return s.all, nil
+	ps, err := s.partners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := []core.Remco{}
+	for _, p := range ps {
+		if cty == "" || p.in(cty) {
+			r = append(r, p.Remco)
+		}
 	}
-	r := Partners[cty]
-	if len(r) == 0 {
+	if cty != "" && len(r) == 0 {
 		return nil, status.Errorf(codes.NotFound, "no partners available in %q", cty)
 	}
 	return r, nil
@@ -315,60 +342,67 @@ func (s *Svc) ListPartners(ctx context.Context, cty string) ([]core.Remco, error
 
 // PartnerCode validates that the remittance partner code exists.
 func (s *Svc) PartnerCode(ctx context.Context, partner string) error {
-	for _, p := range s.all {
-		if p.Code != partner {
-			continue
-		}
-		return nil
+	if _, err := s.partner(ctx, partner); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid remittance partner")
 	}
-	return status.Error(codes.InvalidArgument, "invalid remittance partner")
+	return nil
 }
 
 func (s *Svc) SendRemitType(ctx context.Context, partner, remTyp string, code bool) (*core.SendRemitType, error) {
-	for _, p := range s.all {
-		if p.Code != partner {
-			continue
-		}
-		for k, t := range p.SendTypes {
-			if code {
-				if t.Code != remTyp {
-					continue
-				}
-				return &t, nil
-			} else {
-				if k != remTyp {
-					continue
-				}
-				return &t, nil
+	p, err := s.partner(ctx, partner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid remittance type")
+	}
+	for k, t := range p.SendTypes {
+		if code {
+			if t.Code != remTyp {
+				continue
+			}
+			return &t, nil
+		} else {
+			if k != remTyp {
+				continue
 			}
+			return &t, nil
 		}
 	}
 	return nil, status.Error(codes.InvalidArgument, "invalid remittance type")
 }
 
 func (s *Svc) DisburseRemitType(ctx context.Context, partner, remTyp string, code bool) (*core.DisburseRemitType, error) {
-	for _, p := range s.all {
-		if p.Code != partner {
-			continue
-		}
-		for k, t := range p.DisburseTypes {
-			if code {
-				if t.Code != remTyp {
-					continue
-				}
-				return &t, nil
-			} else {
-				if k != remTyp {
-					continue
-				}
-				return &t, nil
+	p, err := s.partner(ctx, partner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid remittance type")
+	}
+	for k, t := range p.DisburseTypes {
+		if code {
+			if t.Code != remTyp {
+				continue
 			}
+			return &t, nil
+		} else {
+			if k != remTyp {
+				continue
+			}
+			return &t, nil
 		}
 	}
 	return nil, status.Error(codes.InvalidArgument, "invalid remittance type")
 }
 
-func PartnerExists(partner string, country string) bool {
+// PartnerExists reports whether the partner is enabled in the country. The
+// built-in partners are used if the partner registry is unavailable.
+func (s *Svc) PartnerExists(ctx context.Context, partner string, country string) bool {
+	ps, err := s.partners(ctx)
+	if err == nil {
+		for _, p := range ps {
+			if p.Code == partner && p.in(country) {
+				return true
+			}
+		}
+		return false
+	}
+	logging.WithError(err, logging.FromContext(ctx)).Error("loading partner registry, using built-in partners")
 	pns := Partners[country]
 	for _, pn := range pns {
 		if pn.Code == partner {
@@ -0,0 +1,232 @@
+package user
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	dpb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/auth/hydra"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const (
+	// UBCode is the partner code of the UnionBank customer history.
+	UBCode = "UNIONBANK"
+
+	// sources of a customer transaction
+	partnerSource  = "PARTNER"
+	platformSource = "PETNET"
+	bothSource     = "BOTH"
+
+	historyLayout = "2006-01-02 15:04:05"
+	historyWindow = 30 * 24 * time.Hour
+	historyLimit  = 200
+)
+
+// CustomerHistory merges the partner transaction history of a customer with
+// the remittances done through the platform. A partner failure is reported in
+// the response so tellers still see our own records.
+func (s *Svc) CustomerHistory(ctx context.Context, req *ppb.CustomerHistoryRequest) (*ppb.CustomerHistoryResponse, error) {
+	log := logging.FromContext(ctx)
+	orgID := getDSAOrgID(ctx)
+	if orgID == "" {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "missing org")
+	}
+	until := time.Now()
+	if req.GetUntil() != nil {
+		until = req.GetUntil().AsTime()
+	}
+	from := until.Add(-historyWindow)
+	if req.GetFrom() != nil {
+		from = req.GetFrom().AsTime()
+	}
+	if !from.Before(until) {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "from must be before until")
+	}
+
+	var pts []*ppb.CustomerTransaction
+	var err error
+	switch req.GetPartner() {
+	case static.WUCode:
+		pts, err = s.wuHistory(ctx, req.GetCustomerID(), from, until)
+	case UBCode:
+		pts, err = s.ubHistory(ctx, req, from, until)
+	default:
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "customer history is not available for partner")
+	}
+	res := &ppb.CustomerHistoryResponse{}
+	if err != nil {
+		logging.WithError(err, log).Error("partner customer history")
+		res.PartnerError = err.Error()
+	}
+
+	rhs, err := s.st.ListCustomerRemitHistory(ctx, storage.CustomerRemitFilter{
+		DsaOrgID:   orgID,
+		CustomerID: req.GetCustomerID(),
+		From:       from,
+		Until:      until,
+		Limit:      historyLimit,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("listing customer remit history")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	res.Transactions = mergeCustomerHistory(pts, rhs)
+	return res, nil
+}
+
+func (s *Svc) wuHistory(ctx context.Context, card string, from, until time.Time) ([]*ppb.CustomerTransaction, error) {
+	txs, err := s.ph.WUTransactionHistory(ctx, perahub.WUTHRequest{
+		DateStart: from.Format(historyLayout),
+		DateEnd:   until.Format(historyLayout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return wuTransactions(txs, card), nil
+}
+
+func (s *Svc) ubHistory(ctx context.Context, req *ppb.CustomerHistoryRequest, from, until time.Time) ([]*ppb.CustomerTransaction, error) {
+	res, err := s.ph.UBTransactionHistory(ctx, perahub.UBTHRequest{
+		UserCode:     req.GetCustomerID(),
+		DateStart:    from.Format(historyLayout),
+		DateEnd:      until.Format(historyLayout),
+		OperatorCode: req.GetOperatorCode(),
+		LocationCode: req.GetLocationCode(),
+		SessionID:    req.GetSessionID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.WU.Header.ErrorCode != "1" {
+		return nil, coreerror.NewCoreError(codes.Unavailable, res.WU.Header.Message)
+	}
+	return ubTransactions(res.WU.Body.TranList.Transaction), nil
+}
+
+// wuTransactions keeps the transactions of the My WU card, the report lists
+// every customer of the branch.
+func wuTransactions(txs []perahub.WUTransaction, card string) []*ppb.CustomerTransaction {
+	var res []*ppb.CustomerTransaction
+	for _, t := range txs {
+		if strings.TrimSpace(t.CustomerCode) != card {
+			continue
+		}
+		ct := &ppb.CustomerTransaction{
+			Source:          partnerSource,
+			Partner:         static.WUCode,
+			ReferenceNumber: t.MTCN,
+			TransactionType: t.TxnType,
+			Amount:          t.Principal,
+			Currency:        t.Currency,
+			TransactionTime: historyTime(t.TxnDate),
+		}
+		if t.DateClaimed != "" {
+			ct.PartnerStatus = "CLAIMED"
+			ct.Claimed = historyTime(t.DateClaimed)
+		}
+		res = append(res, ct)
+	}
+	return res
+}
+
+func ubTransactions(txs []perahub.Transaction) []*ppb.CustomerTransaction {
+	res := make([]*ppb.CustomerTransaction, 0, len(txs))
+	for _, t := range txs {
+		res = append(res, &ppb.CustomerTransaction{
+			Source:          partnerSource,
+			Partner:         UBCode,
+			ReferenceNumber: t.ID,
+			TransactionType: t.PplCode,
+			Amount:          t.Amount,
+			Currency:        t.TrxnCurrency,
+			Branch:          t.Branch,
+			Description:     t.TranDesc,
+			TransactionTime: historyTime(t.TranDate),
+		})
+	}
+	return res
+}
+
+// mergeCustomerHistory adds our remittances to the partner history. Partner
+// transactions we also recorded are folded into our record so the teller sees
+// both statuses on one line.
+func mergeCustomerHistory(pts []*ppb.CustomerTransaction, rhs []storage.RemitHistory) []*ppb.CustomerTransaction {
+	byRef := make(map[string]*ppb.CustomerTransaction, len(pts))
+	for _, p := range pts {
+		if p.ReferenceNumber != "" {
+			byRef[p.ReferenceNumber] = p
+		}
+	}
+	res := make([]*ppb.CustomerTransaction, 0, len(pts)+len(rhs))
+	seen := map[string]bool{}
+	for _, r := range rhs {
+		ct := remitTransaction(r)
+		if p, ok := byRef[r.RemcoControlNo]; ok && r.RemcoControlNo != "" {
+			ct.Source = bothSource
+			ct.PartnerStatus = p.PartnerStatus
+			ct.Claimed = p.Claimed
+			ct.Branch = p.Branch
+			ct.Description = p.Description
+			seen[r.RemcoControlNo] = true
+		}
+		res = append(res, ct)
+	}
+	for _, p := range pts {
+		if !seen[p.ReferenceNumber] {
+			res = append(res, p)
+		}
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].GetTransactionTime().AsTime().After(res[j].GetTransactionTime().AsTime())
+	})
+	return res
+}
+
+func remitTransaction(r storage.RemitHistory) *ppb.CustomerTransaction {
+	amt := r.Remittance.SourceAmt
+	if r.RemType == string(storage.DisburseType) || amt.CurrencyCode() == "" {
+		amt = r.Remittance.DestAmt
+	}
+	t := r.Created
+	if r.TxnCompletedTime.Valid {
+		t = r.TxnCompletedTime.Time
+	}
+	return &ppb.CustomerTransaction{
+		Source:          platformSource,
+		Partner:         r.RemcoID,
+		ReferenceNumber: r.RemcoControlNo,
+		TransactionType: r.RemType,
+		Status:          r.TxnStatus,
+		Amount:          amt.ToAmount().Number(),
+		Currency:        amt.CurrencyCode(),
+		TransactionTime: timestamppb.New(t),
+	}
+}
+
+func historyTime(s string) *timestamppb.Timestamp {
+	t, err := time.Parse(historyLayout, s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func getDSAOrgID(ctx context.Context) string {
+	switch phmw.GetOrgType(ctx) {
+	case dpb.OrgType_PetNet.String(), dpb.OrgType_DSA.String():
+		return phmw.GetDSAOrgID(ctx)
+	}
+	return hydra.OrgID(ctx)
+}
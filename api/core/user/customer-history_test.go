@@ -0,0 +1,63 @@
+package user
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/bojanz/currency"
+
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/api/storage"
+)
+
+func TestMergeCustomerHistory(t *testing.T) {
+	pts := wuTransactions([]perahub.WUTransaction{
+		{TxnDate: "2021-03-01 16:01:50", MTCN: "9429067484", Principal: "2127", Currency: "PHP", TxnType: "PO", DateClaimed: "2021-03-01 03:01:00", CustomerCode: "1083182       "},
+		{TxnDate: "2021-03-02 10:00:00", MTCN: "1111111111", Principal: "500", Currency: "PHP", TxnType: "SO", CustomerCode: "1083182"},
+		{TxnDate: "2021-03-03 10:00:00", MTCN: "2222222222", Principal: "900", Currency: "PHP", TxnType: "SO", CustomerCode: "9999999"},
+	}, "1083182")
+	if len(pts) != 2 {
+		t.Fatalf("wuTransactions() = got %d transactions, want the 2 of the card", len(pts))
+	}
+
+	amt, err := currency.NewMinor("212700", "PHP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rhs := []storage.RemitHistory{
+		{
+			RemcoID:          "WU",
+			RemcoControlNo:   "9429067484",
+			RemType:          string(storage.DisburseType),
+			TxnStatus:        string(storage.SuccessStatus),
+			Remittance:       storage.Remittance{DestAmt: amt},
+			TxnCompletedTime: sql.NullTime{Valid: true, Time: time.Date(2021, 3, 1, 16, 1, 50, 0, time.UTC)},
+		},
+		{
+			RemcoID:        "WU",
+			RemcoControlNo: "3333333333",
+			RemType:        string(storage.SendType),
+			TxnStatus:      string(storage.FailStatus),
+			Created:        time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	got := mergeCustomerHistory(pts, rhs)
+	want := []struct{ ref, source string }{
+		{"3333333333", platformSource},
+		{"1111111111", partnerSource},
+		{"9429067484", bothSource},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("mergeCustomerHistory() = got %d transactions, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].ReferenceNumber != w.ref || got[i].Source != w.source {
+			t.Errorf("mergeCustomerHistory()[%d] = got %s from %s, want %s from %s", i, got[i].ReferenceNumber, got[i].Source, w.ref, w.source)
+		}
+	}
+	if m := got[2]; m.PartnerStatus != "CLAIMED" || m.Status != string(storage.SuccessStatus) || m.Amount != "2127.00" {
+		t.Errorf("mergeCustomerHistory() = got merged transaction %v", m)
+	}
+}
@@ -0,0 +1,480 @@
+package user
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+var mobileRe = regexp.MustCompile(`^09\d{9}$`)
+
+// StartEnrollment sends the registration OTP to the customer mobile and keeps
+// the enrollment pending until the OTP is confirmed.
+func (s *Svc) StartEnrollment(ctx context.Context, req *ppb.StartEnrollmentRequest) (*ppb.StartEnrollmentResponse, error) {
+	log := logging.FromContext(ctx)
+	if s.seal == nil {
+		log.Error("missing registration key")
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "customer enrollment is not available")
+	}
+	c := req.GetCustomer()
+	mob, err := localMobile(c.GetMobile())
+	if err != nil {
+		return nil, err
+	}
+
+	kyc, err := json.Marshal(customerKYC(c))
+	if err != nil {
+		return nil, err
+	}
+	reg, err := s.seal.seal(registration(c, mob))
+	if err != nil {
+		logging.WithError(err, log).Error("sealing enrollment registration")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDRPInternalError)
+	}
+
+	res, err := s.ph.RegistrationOTP(ctx, perahub.OTPRequest{MobileNo: mob})
+	if err != nil {
+		logging.WithError(err, log).Error("sending registration otp")
+		return nil, err
+	}
+	if res.Data.Header.ErrorCode != "1" {
+		return nil, coreerror.NewCoreError(codes.Unavailable, res.Data.Header.Message)
+	}
+
+	e, err := s.st.CreateCustomerEnrollment(ctx, storage.CustomerEnrollment{
+		OrgID:        getDSAOrgID(ctx),
+		UserID:       phmw.GetUserID(ctx),
+		Partner:      req.GetPartner(),
+		Mobile:       mob,
+		Details:      kyc,
+		Registration: reg,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("storing customer enrollment")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	return &ppb.StartEnrollmentResponse{
+		EnrollmentID: e.ID,
+		Mobile:       mob,
+	}, nil
+}
+
+// ConfirmEnrollment validates the OTP, registers the customer with the partner
+// and records the customer code for later remittances. The enrollment is
+// claimed before the registration so the customer is registered once.
+func (s *Svc) ConfirmEnrollment(ctx context.Context, req *ppb.ConfirmEnrollmentRequest) (*ppb.ConfirmEnrollmentResponse, error) {
+	log := logging.FromContext(ctx)
+	if s.seal == nil {
+		log.Error("missing registration key")
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "customer enrollment is not available")
+	}
+	e, err := s.st.GetCustomerEnrollment(ctx, getDSAOrgID(ctx), req.GetEnrollmentID())
+	switch {
+	case err == storage.ErrNotFound:
+		return nil, coreerror.NewCoreError(codes.NotFound, "enrollment not found")
+	case err != nil:
+		logging.WithError(err, log).Error("getting customer enrollment")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	case e.Partner != req.GetPartner():
+		return nil, coreerror.NewCoreError(codes.NotFound, "enrollment not found")
+	case e.Status == storage.EnrollmentEnrolled:
+		return nil, coreerror.NewCoreError(codes.AlreadyExists, "enrollment already confirmed")
+	case e.Status == storage.EnrollmentConfirming:
+		return nil, coreerror.NewCoreError(codes.Aborted, "enrollment is being confirmed")
+	case time.Since(e.Created) > s.enrollTTL:
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, "enrollment expired, please start again")
+	}
+
+	otp, err := strconv.Atoi(req.GetOTP())
+	if err != nil {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, "invalid otp")
+	}
+	vres, err := s.ph.RegistrationOTPValidate(ctx, perahub.OTPValidateRequest{
+		MobileNo: e.Mobile,
+		OTPCode:  otp,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("validating registration otp")
+		return nil, err
+	}
+	if vres.Data.Header.ErrorCode != "1" {
+		return nil, coreerror.NewCoreError(codes.InvalidArgument, vres.Data.Header.Message)
+	}
+
+	var reg perahub.CustomerRegRequest
+	if err := s.seal.open(e.Registration, &reg); err != nil {
+		logging.WithError(err, log).Error("reading enrollment registration")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	switch _, err := s.st.ClaimCustomerEnrollment(ctx, e.OrgID, e.ID); {
+	case err == storage.ErrNotFound:
+		return nil, coreerror.NewCoreError(codes.Aborted, "enrollment is being confirmed")
+	case err != nil:
+		logging.WithError(err, log).Error("claiming customer enrollment")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	rres, err := s.ph.CustomerRegistration(ctx, reg)
+	if err != nil {
+		// the customer may have been registered, the enrollment stays
+		// claimed until it is purged.
+		logging.WithError(err, log).Error("registering customer")
+		return nil, err
+	}
+	if rres.WU.Header.ErrorCode != "1" {
+		if err := s.st.ReleaseCustomerEnrollment(ctx, e.OrgID, e.ID); err != nil {
+			logging.WithError(err, log).Error("releasing customer enrollment")
+		}
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, rres.WU.Header.Message)
+	}
+	cc := strings.TrimSpace(rres.WU.Body.CustomerCode)
+	if cc == "" {
+		log.WithField("response", rres.WU.Body).Error("missing customer code")
+		return nil, coreerror.NewCoreError(codes.Internal, "partner did not return a customer code")
+	}
+
+	en, err := s.st.EnrollCustomer(ctx, storage.CustomerEnrollment{
+		ID:           e.ID,
+		OrgID:        e.OrgID,
+		CustomerCode: cc,
+		WUCardNo:     reg.WuCardNo,
+	})
+	switch {
+	case err == storage.Conflict:
+		return nil, coreerror.NewCoreError(codes.AlreadyExists, "customer already enrolled")
+	case err != nil:
+		logging.WithError(err, log).Error("storing enrolled customer")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+	return &ppb.ConfirmEnrollmentResponse{
+		CustomerCode: en.CustomerCode,
+		WUCardNo:     en.WUCardNo,
+	}, nil
+}
+
+// UpdateCustomer sends the new customer details to the partner and refreshes
+// the stored KYC. Only customers enrolled through the org can be updated.
+func (s *Svc) UpdateCustomer(ctx context.Context, req *ppb.UpdateCustomerRequest) (*ppb.UpdateCustomerResponse, error) {
+	log := logging.FromContext(ctx)
+	c := req.GetCustomer()
+	mob, err := localMobile(c.GetMobile())
+	if err != nil {
+		return nil, err
+	}
+
+	org := getDSAOrgID(ctx)
+	switch _, err := s.st.GetEnrolledCustomer(ctx, org, req.GetPartner(), req.GetCustomerCode()); {
+	case err == storage.ErrNotFound:
+		return nil, coreerror.NewCoreError(codes.NotFound, "customer not enrolled")
+	case err != nil:
+		logging.WithError(err, log).Error("getting enrolled customer")
+		return nil, coreerror.NewCoreError(codes.Internal, coreerror.MsgDatabaseError)
+	}
+
+	res, err := s.ph.CustomerInfoUpdate(ctx, infoUpdate(req.GetCustomerCode(), c, mob))
+	if err != nil {
+		logging.WithError(err, log).Error("updating customer info")
+		return nil, err
+	}
+	if res.WU.Header.ErrorCode != "1" {
+		return nil, coreerror.NewCoreError(codes.FailedPrecondition, res.WU.Header.Message)
+	}
+
+	kyc, err := json.Marshal(customerKYC(c))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.st.UpdateEnrolledCustomer(ctx, storage.CustomerEnrollment{
+		OrgID:        org,
+		Partner:      req.GetPartner(),
+		CustomerCode: req.GetCustomerCode(),
+		Mobile:       mob,
+		Details:      kyc,
+	}); err != nil {
+		// the partner already has the update, the stored copy is only used
+		// to prefill remittances
+		logging.WithError(err, log).Error("storing enrolled customer update")
+	}
+	return &ppb.UpdateCustomerResponse{}, nil
+}
+
+// PurgeEnrollments deletes the pending enrollments not confirmed within the
+// enrollment TTL with their registration requests. Enrollments being
+// confirmed are kept. Meant to run as a leader cron.
+func (s *Svc) PurgeEnrollments(ctx context.Context) error {
+	log := logging.FromContext(ctx)
+	n, err := s.st.PurgeCustomerEnrollments(ctx, time.Now().Add(-s.enrollTTL))
+	if err != nil {
+		logging.WithError(err, log).Error("purging customer enrollments")
+		return err
+	}
+	if n > 0 {
+		log.WithField("count", n).Info("purged abandoned customer enrollments")
+	}
+	return nil
+}
+
+// LookupCustomer returns the customer as pre-enrolled with the partner.
+func (s *Svc) LookupCustomer(ctx context.Context, req *ppb.LookupCustomerRequest) (*ppb.LookupCustomerResponse, error) {
+	log := logging.FromContext(ctx)
+	pc, err := s.ph.PreEnrolledCustomer(ctx, req.GetCustomerCode())
+	if err != nil {
+		logging.WithError(err, log).Error("looking up pre-enrolled customer")
+		return nil, err
+	}
+	if pc == nil {
+		return nil, coreerror.NewCoreError(codes.NotFound, "customer not found")
+	}
+
+	res := &ppb.LookupCustomerResponse{
+		CustomerCode: pc.CustomerNumber,
+		Customer:     preEnrolledCustomer(*pc),
+		CardPoints:   int32(pc.CardPoints),
+	}
+	_, err = s.st.GetEnrolledCustomer(ctx, getDSAOrgID(ctx), req.GetPartner(), req.GetCustomerCode())
+	switch {
+	case err == nil:
+		res.Enrolled = true
+	case err != storage.ErrNotFound:
+		logging.WithError(err, log).Error("getting enrolled customer")
+	}
+	return res, nil
+}
+
+// localMobile returns the customer mobile in the 09XXXXXXXXX form PeraHub
+// sends the OTP to.
+func localMobile(p *ppb.PhoneNumber) (string, error) {
+	n := strings.NewReplacer(" ", "", "-", "", "+", "").Replace(p.GetNumber())
+	cc := strings.TrimPrefix(p.GetCountryCode(), "+")
+	switch {
+	case strings.HasPrefix(n, "63"):
+		n = "0" + strings.TrimPrefix(n, "63")
+	case (cc == "63" || cc == "") && strings.HasPrefix(n, "9"):
+		n = "0" + n
+	}
+	if !mobileRe.MatchString(n) {
+		return "", coreerror.NewCoreError(codes.InvalidArgument, "mobile must be a philippine mobile number")
+	}
+	return n, nil
+}
+
+func customerKYC(c *ppb.Customer) core.UserKYC {
+	id := c.GetIdentification()
+	return core.UserKYC{
+		FName:        c.GetFirstName(),
+		MdName:       c.GetMiddleName(),
+		LName:        c.GetLastName(),
+		Gender:       c.GetGender(),
+		Email:        c.GetEmail(),
+		BirthDate:    kycDate(c.GetBirthDate()),
+		BirthCountry: c.GetBirthCountry(),
+		Nationality:  c.GetNationality(),
+		Address:      core.ToAddr(c.GetPresentAddress()),
+		Phone:        core.ToPhone(c.GetPhone()),
+		Mobile:       core.ToPhone(c.GetMobile()),
+		Employment: core.Employment{
+			Employer:      c.GetEmployment().GetEmployer(),
+			Occupation:    c.GetEmployment().GetOccupation(),
+			PositionLevel: c.GetEmployment().GetPositionLevel(),
+		},
+		PrimaryID: core.Identification{
+			IDType:  id.GetType(),
+			Number:  id.GetNumber(),
+			Country: id.GetCountry(),
+			City:    id.GetCity(),
+			Issued:  kycDate(id.GetIssued()),
+			Expiry:  core.ToDate(id.GetExpiration()),
+		},
+		CurrentAddress:   core.ToAddr(c.GetPresentAddress()),
+		PermanentAddress: core.ToAddr(c.GetPermanentAddress()),
+	}
+}
+
+func registration(c *ppb.Customer, mob string) perahub.CustomerRegRequest {
+	id, pre, per := c.GetIdentification(), c.GetPresentAddress(), c.GetPermanentAddress()
+	r := perahub.CustomerRegRequest{
+		Mobile:              mob,
+		Email:               c.GetEmail(),
+		Phone:               c.GetPhone().GetNumber(),
+		Surname:             c.GetLastName(),
+		Givenname:           c.GetFirstName(),
+		Middlename:          c.GetMiddleName(),
+		Birthdate:           isoDate(c.GetBirthDate()),
+		Nationality:         c.GetNationality(),
+		Occupation:          c.GetEmployment().GetOccupation(),
+		NameOfEmployer:      c.GetEmployment().GetEmployer(),
+		ValidIdentification: id.GetNumber(),
+		IDImage:             idImage(c.GetIDImage()),
+		WuCardNo:            c.GetWUCardNo(),
+		IDCountryIssue:      id.GetCountry(),
+		IDIssueDate:         isoDate(id.GetIssued()),
+		Gender:              c.GetGender(),
+		City:                pre.GetCity(),
+		State:               pre.GetState(),
+		PostalCode:          postalCode(pre.GetPostalCode()),
+		IsWalkIn:            "0",
+		IDType:              id.GetType(),
+		IDExpirationDate:    isoDate(id.GetExpiration()),
+		CountryOfBirth:      c.GetBirthCountry(),
+		TIN:                 c.GetTIN(),
+		PresentAddress:      joinAddr(pre),
+		PresentCity:         pre.GetCity(),
+		PresentState:        pre.GetState(),
+		PresentProvince:     pre.GetProvince(),
+		PresentCountry:      pre.GetCountry(),
+		PresentPostalcode:   postalCode(pre.GetPostalCode()),
+		PermanentAddress:    joinAddr(per),
+		PermanentCity:       per.GetCity(),
+		PermanentState:      per.GetState(),
+		PermanentProvince:   per.GetProvince(),
+		PermanentCountry:    per.GetCountry(),
+		PermanentPostalcode: postalCode(per.GetPostalCode()),
+		ACountry:            pre.GetCountry(),
+	}
+	sq := c.GetSecurityQuestions()
+	if len(sq) > 0 {
+		r.SecurityQuestion1, r.Answer1 = sq[0].GetQuestion(), sq[0].GetAnswer()
+	}
+	if len(sq) > 1 {
+		r.SecurityQuestion2, r.Answer2 = sq[1].GetQuestion(), sq[1].GetAnswer()
+	}
+	if len(sq) > 2 {
+		r.SecurityQuestion3, r.Answer3 = sq[2].GetQuestion(), sq[2].GetAnswer()
+	}
+	return r
+}
+
+func infoUpdate(code string, c *ppb.Customer, mob string) perahub.CustomerIURequest {
+	id, pre, per := c.GetIdentification(), c.GetPresentAddress(), c.GetPermanentAddress()
+	r := perahub.CustomerIURequest{
+		Nationality:         c.GetNationality(),
+		Usercode:            code,
+		Surname:             c.GetLastName(),
+		Givenname:           c.GetFirstName(),
+		Middlename:          c.GetMiddleName(),
+		Mobile:              mob,
+		Email:               c.GetEmail(),
+		NewEmail:            c.GetEmail(),
+		Presentaddress:      joinAddr(pre),
+		Permanentaddress:    joinAddr(per),
+		Presentcity:         pre.GetCity(),
+		Presentstate:        pre.GetState(),
+		Presentprovince:     pre.GetProvince(),
+		Presentcountry:      pre.GetCountry(),
+		Presentpostalcode:   pre.GetPostalCode(),
+		Permanentcity:       per.GetCity(),
+		Permanentstate:      per.GetState(),
+		Permanentprovince:   per.GetProvince(),
+		Permanentcountry:    per.GetCountry(),
+		Permanentpostalcode: per.GetPostalCode(),
+		IDType:              id.GetType(),
+		IDImage:             idImage(c.GetIDImage()),
+		IDExpirationDate:    isoDate(id.GetExpiration()),
+		CustomerIDNumber:    id.GetNumber(),
+		IDCountryIssue:      id.GetCountry(),
+	}
+	sq := c.GetSecurityQuestions()
+	if len(sq) > 0 {
+		r.Secretquestion1, r.Answer1 = sq[0].GetQuestion(), sq[0].GetAnswer()
+	}
+	if len(sq) > 1 {
+		r.Secretquestion2, r.Answer2 = sq[1].GetQuestion(), sq[1].GetAnswer()
+	}
+	if len(sq) > 2 {
+		r.Secretquestion3, r.Answer3 = sq[2].GetQuestion(), sq[2].GetAnswer()
+	}
+	return r
+}
+
+func preEnrolledCustomer(c perahub.PECustomer) *ppb.Customer {
+	addr := func(a perahub.Address) *ppb.Address {
+		return &ppb.Address{
+			Address1:   a.AddrLine1,
+			Address2:   a.AddrLine2,
+			City:       a.City,
+			State:      a.State,
+			PostalCode: a.PostalCode,
+			Country:    a.Country,
+		}
+	}
+	res := &ppb.Customer{
+		FirstName:   c.FirstName,
+		MiddleName:  c.MiddleName,
+		LastName:    c.LastName,
+		Nationality: c.Nationality,
+		Gender:      c.Gender,
+		Email:       c.EmailAdd,
+		Mobile: &ppb.PhoneNumber{
+			CountryCode: "63",
+			Number:      c.MobileNo,
+		},
+		Phone: &ppb.PhoneNumber{
+			CountryCode: "63",
+			Number:      c.TelNo,
+		},
+		PresentAddress:   addr(c.CurrentAddress),
+		PermanentAddress: addr(c.PermaAddress),
+		Employment: &ppb.Employment{
+			Employer:   c.EmployerName,
+			Occupation: c.Occupation,
+		},
+		TIN:      c.TINID,
+		WUCardNo: c.WBCardNo,
+	}
+	if t, err := time.Parse("2006-01-02", c.BirthDate); err == nil {
+		res.BirthDate = &ppb.Date{
+			Year:  strconv.Itoa(t.Year()),
+			Month: strconv.Itoa(int(t.Month())),
+			Day:   strconv.Itoa(t.Day()),
+		}
+	}
+	return res
+}
+
+func kycDate(d *ppb.Date) core.Date {
+	if d == nil {
+		return core.Date{}
+	}
+	return *core.ToDate(d)
+}
+
+func isoDate(d *ppb.Date) string {
+	if d == nil || d.GetYear() == "" {
+		return ""
+	}
+	t, err := time.Parse("2006/1/2", fmt.Sprintf("%s/%s/%s", d.GetYear(), d.GetMonth(), d.GetDay()))
+	if err != nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+func joinAddr(a *ppb.Address) string {
+	return strings.TrimSpace(strings.Join([]string{a.GetAddress1(), a.GetAddress2()}, " "))
+}
+
+func postalCode(s string) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(s))
+	return n
+}
+
+func idImage(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,118 @@
+package user
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"brank.as/petnet/api/integration/perahub"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+)
+
+func TestLocalMobile(t *testing.T) {
+	tests := []struct {
+		cc, number string
+		want       string
+		wantErr    bool
+	}{
+		{number: "09171234567", want: "09171234567"},
+		{cc: "63", number: "9171234567", want: "09171234567"},
+		{cc: "+63", number: "917 123 4567", want: "09171234567"},
+		{number: "+639171234567", want: "09171234567"},
+		{cc: "1", number: "4155550100", wantErr: true},
+		{number: "0917123", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := localMobile(&ppb.PhoneNumber{CountryCode: test.cc, Number: test.number})
+		if (err != nil) != test.wantErr {
+			t.Fatalf("localMobile(%q, %q) = got error %v, want error %t", test.cc, test.number, err, test.wantErr)
+		}
+		if got != test.want {
+			t.Errorf("localMobile(%q, %q) = got %q, want %q", test.cc, test.number, got, test.want)
+		}
+	}
+}
+
+func TestRegistration(t *testing.T) {
+	c := &ppb.Customer{
+		FirstName: "Juan",
+		LastName:  "Dela Cruz",
+		BirthDate: &ppb.Date{Year: "1990", Month: "1", Day: "2"},
+		PresentAddress: &ppb.Address{
+			Address1:   "1 Rizal St",
+			Address2:   "Brgy 5",
+			City:       "Manila",
+			PostalCode: "1000",
+			Country:    "PH",
+		},
+		Identification: &ppb.Identification{
+			Type:       "PASSPORT",
+			Number:     "P1234",
+			Country:    "PH",
+			Expiration: &ppb.Date{Year: "2030", Month: "12", Day: "31"},
+		},
+		IDImage: []byte("img"),
+		SecurityQuestions: []*ppb.SecurityQuestion{
+			{Question: "pet", Answer: "bantay"},
+			{Question: "city", Answer: "manila"},
+		},
+		WUCardNo: "500000001",
+	}
+	got := registration(c, "09171234567")
+	switch {
+	case got.Birthdate != "1990-01-02":
+		t.Errorf("registration() = got birthdate %q", got.Birthdate)
+	case got.IDExpirationDate != "2030-12-31":
+		t.Errorf("registration() = got id expiration %q", got.IDExpirationDate)
+	case got.PresentAddress != "1 Rizal St Brgy 5" || got.PresentPostalcode != 1000:
+		t.Errorf("registration() = got present address %q %d", got.PresentAddress, got.PresentPostalcode)
+	case got.IDImage != "aW1n":
+		t.Errorf("registration() = got id image %q", got.IDImage)
+	case got.Answer2 != "manila" || got.SecurityQuestion3 != "":
+		t.Errorf("registration() = got security questions %q %q", got.Answer2, got.SecurityQuestion3)
+	case got.Mobile != "09171234567" || got.WuCardNo != "500000001":
+		t.Errorf("registration() = got mobile %q card %q", got.Mobile, got.WuCardNo)
+	}
+
+	kyc := customerKYC(&ppb.Customer{FirstName: "Juan"})
+	if kyc.FName != "Juan" || kyc.PrimaryID.Expiry != nil {
+		t.Errorf("customerKYC() = got %+v", kyc)
+	}
+}
+
+func TestSealer(t *testing.T) {
+	if _, err := newSealer([]byte("short")); err == nil {
+		t.Fatal("newSealer() = want error for short key")
+	}
+	key := bytes.Repeat([]byte{1}, 32)
+	s, err := newSealer(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := perahub.CustomerRegRequest{Answer1: "bantay", IDImage: "aW1n"}
+	b, err := s.seal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(b, []byte("bantay")) || !json.Valid(b) {
+		t.Fatalf("seal() = got %s, want encrypted json", b)
+	}
+	var got perahub.CustomerRegRequest
+	if err := s.open(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Answer1 != in.Answer1 || got.IDImage != in.IDImage {
+		t.Errorf("open() = got %+v, want %+v", got, in)
+	}
+
+	other, err := newSealer(bytes.Repeat([]byte{2}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.open(b, &got); err == nil {
+		t.Error("open() = want error with another key")
+	}
+	if err := s.open([]byte(`{"Answer1":"bantay"}`), &got); err == nil {
+		t.Error("open() = want error for plaintext registration")
+	}
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// sealer encrypts the partner registration kept while an enrollment is
+// pending, it carries the customer security answers and ID image.
+type sealer struct {
+	aead cipher.AEAD
+}
+
+// newSealer returns a sealer using AES-GCM with the given 32 byte key.
+func newSealer(key []byte) (*sealer, error) {
+	if len(key) != 32 {
+		return nil, errors.New("registration key must be 32 bytes")
+	}
+	b, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	g, err := cipher.NewGCM(b)
+	if err != nil {
+		return nil, err
+	}
+	return &sealer{aead: g}, nil
+}
+
+// seal encrypts v as a JSON string so it can be stored in a jsonb column.
+func (s *sealer) seal(v interface{}) ([]byte, error) {
+	pt, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return json.Marshal(base64.StdEncoding.EncodeToString(s.aead.Seal(nonce, nonce, pt, nil)))
+}
+
+// open decrypts a value sealed by seal into v.
+func (s *sealer) open(b []byte, v interface{}) error {
+	var enc string
+	if err := json.Unmarshal(b, &enc); err != nil {
+		return err
+	}
+	ct, err := base64.StdEncoding.DecodeString(enc)
+	if err != nil {
+		return err
+	}
+	ns := s.aead.NonceSize()
+	if len(ct) < ns {
+		return errors.New("sealed value too short")
+	}
+	pt, err := s.aead.Open(nil, ct[:ns], ct[ns:], nil)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(pt, v)
+}
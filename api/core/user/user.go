@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"log"
+	"time"
 
 	"brank.as/petnet/api/core"
 	ceb "brank.as/petnet/api/core/user/cebuana"
@@ -28,14 +29,53 @@ type Svc struct {
 	usermanagers map[string]UserManager
 	st           *postgres.Storage
 	ph           *perahub.Svc
+	regKey       []byte
+	seal         *sealer
+	enrollTTL    time.Duration
 }
 
-func New(st *postgres.Storage, ph *perahub.Svc) *Svc {
+// defaultEnrollmentTTL is how long an enrollment can be confirmed after it
+// was started.
+const defaultEnrollmentTTL = 24 * time.Hour
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithRegistrationKey sets the 32 byte key the pending partner registrations
+// are encrypted with. Customers cannot be enrolled without it.
+func WithRegistrationKey(key []byte) Option {
+	return func(s *Svc) {
+		s.regKey = key
+	}
+}
+
+// WithEnrollmentTTL sets how long an enrollment can be confirmed before it is
+// purged.
+func WithEnrollmentTTL(d time.Duration) Option {
+	return func(s *Svc) {
+		if d > 0 {
+			s.enrollTTL = d
+		}
+	}
+}
+
+func New(st *postgres.Storage, ph *perahub.Svc, opts ...Option) *Svc {
 	gs := []UserManager{wum.New(ph), ceb.New(ph)}
 	s := &Svc{
 		usermanagers: make(map[string]UserManager, len(gs)),
 		st:           st,
 		ph:           ph,
+		enrollTTL:    defaultEnrollmentTTL,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if len(s.regKey) > 0 {
+		seal, err := newSealer(s.regKey)
+		if err != nil {
+			log.Fatalf("registration key: %v", err)
+		}
+		s.seal = seal
 	}
 	for i, r := range gs {
 		switch {
@@ -50,7 +50,7 @@ type AYANNAHInquireResult struct {
 }
 
 func (s *Svc) AYANNAHInquire(ctx context.Context, sr AYANNAHInquireRequest) (*AYANNAHInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("ayannah/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("ayannah/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
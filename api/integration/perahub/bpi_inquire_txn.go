@@ -43,7 +43,7 @@ type BPInquireResult struct {
 }
 
 func (s *Svc) BPInquire(ctx context.Context, sr BPInquireRequest) (*BPInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("bpi/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("bpi/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,270 @@
+package perahub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core/static"
+	phmw "brank.as/petnet/api/perahub-middleware"
+)
+
+// BreakerState is the state of a partner's circuit breaker.
+type BreakerState string
+
+const (
+	// BreakerClosed lets every request through.
+	BreakerClosed BreakerState = "CLOSED"
+	// BreakerOpen fails requests without sending them until the cool-down passes.
+	BreakerOpen BreakerState = "OPEN"
+	// BreakerHalfOpen lets a single probe through to decide whether to close again.
+	BreakerHalfOpen BreakerState = "HALF_OPEN"
+)
+
+const (
+	defaultBreakerThreshold = 5
+	defaultBreakerCooldown  = 30 * time.Second
+)
+
+// ErrPartnerUnavailable is returned without calling the partner while its
+// circuit is open.
+var ErrPartnerUnavailable error = &Error{
+	GRPCCode: codes.Unavailable,
+	Code:     codes.Unavailable.String(),
+	Msg:      "Partner is temporarily unavailable",
+	Type:     DRPError,
+}
+
+// PartnerHealth is the circuit breaker state of a partner.
+type PartnerHealth struct {
+	Partner  string
+	State    BreakerState
+	Failures int
+	// OpenedAt and RetryAt are set while the circuit isn't closed.
+	OpenedAt time.Time
+	RetryAt  time.Time
+}
+
+// WithCircuitBreaker fails requests to a partner fast once threshold
+// consecutive requests failed, until the cool-down passes and a probe
+// request succeeds. Non-positive values use the defaults.
+func WithCircuitBreaker(threshold int, cooldown time.Duration) SvcOption {
+	return func(s *Svc) {
+		if threshold <= 0 {
+			threshold = defaultBreakerThreshold
+		}
+		if cooldown <= 0 {
+			cooldown = defaultBreakerCooldown
+		}
+		s.brk = &breaker{
+			threshold: threshold,
+			cooldown:  cooldown,
+			now:       time.Now,
+			circuits:  map[string]*circuit{},
+		}
+	}
+}
+
+// PartnerHealth reports the circuit state of the partners called since
+// start, sorted by partner. It's empty when the circuit breaker is disabled.
+func (s *Svc) PartnerHealth() []PartnerHealth {
+	if s.brk == nil {
+		return nil
+	}
+	return s.brk.health()
+}
+
+type circuit struct {
+	state    BreakerState
+	failures int
+	opened   time.Time
+}
+
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	circuits  map[string]*circuit
+}
+
+// allow reports whether a request to the partner may be sent. Once the
+// cool-down passes an open circuit lets a single probe through.
+func (b *breaker) allow(key string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[key]
+	if !ok {
+		return true
+	}
+	switch c.state {
+	case BreakerOpen:
+		if b.now().Sub(c.opened) < b.cooldown {
+			return false
+		}
+		c.state = BreakerHalfOpen
+		return true
+	case BreakerHalfOpen:
+		return false
+	}
+	return true
+}
+
+// done records the outcome of a request allowed through. Requests cancelled
+// by the caller say nothing about the partner, they only hand the probe back.
+func (b *breaker) done(ctx context.Context, key string, resp *http.Response, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{state: BreakerClosed}
+		b.circuits[key] = c
+	}
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		if c.state == BreakerHalfOpen {
+			c.state = BreakerOpen
+		}
+	case err != nil, resp.StatusCode >= http.StatusInternalServerError:
+		c.failures++
+		if c.state == BreakerHalfOpen || c.failures >= b.threshold {
+			c.state = BreakerOpen
+			c.opened = b.now()
+		}
+	default:
+		c.state = BreakerClosed
+		c.failures = 0
+		c.opened = time.Time{}
+	}
+}
+
+func (b *breaker) health() []PartnerHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	hs := make([]PartnerHealth, 0, len(b.circuits))
+	for k, c := range b.circuits {
+		h := PartnerHealth{
+			Partner:  k,
+			State:    c.state,
+			Failures: c.failures,
+		}
+		if c.state != BreakerClosed {
+			h.OpenedAt = c.opened
+			h.RetryAt = c.opened.Add(b.cooldown)
+			if c.state == BreakerOpen && !b.now().Before(h.RetryAt) {
+				h.State = BreakerHalfOpen
+			}
+		}
+		hs = append(hs, h)
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i].Partner < hs[j].Partner })
+	return hs
+}
+
+// breakerClient sends the requests through the partner's circuit breaker.
+type breakerClient struct {
+	HTTPClient
+	b   *breaker
+	key func(ctx context.Context, u *url.URL) string
+}
+
+func (c *breakerClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	k := c.key(ctx, req.URL)
+	if !c.b.allow(k) {
+		return nil, ErrPartnerUnavailable
+	}
+	resp, err := c.HTTPClient.Do(req)
+	c.b.done(ctx, k, resp, err)
+	return resp, err
+}
+
+// Post sends the request through Do. Without a context the request is keyed
+// by its URL alone, the perahub requests are sent through Do instead.
+func (c *breakerClient) Post(rawURL, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	return c.Do(req)
+}
+
+// withBreaker wraps the client with the circuit breaker, if enabled.
+func (s *Svc) withBreaker(cl HTTPClient) HTTPClient {
+	if s.brk == nil {
+		return cl
+	}
+	if bc, ok := cl.(*breakerClient); ok {
+		cl = bc.HTTPClient
+	}
+	return &breakerClient{HTTPClient: cl, b: s.brk, key: s.breakerKey}
+}
+
+// urlPartners are the partner codes of the partner segments of the nonex and
+// remittance URLs.
+var urlPartners = map[string]string{
+	"ayannah":               static.AYACode,
+	"bpi":                   static.BPICode,
+	"cebuana":               static.CEBCode,
+	"cebuana-international": static.CEBINTCode,
+	"instacash":             static.ICCode,
+	"intelexpress":          static.IECode,
+	"iremit":                static.IRCode,
+	"japanremit":            static.JPRCode,
+	"metrobank":             static.MBCode,
+	"perahub-remit":         static.PerahubRemit,
+	"remitly":               static.RMCode,
+	"ria":                   static.RIACode,
+	"transfast":             static.TFCode,
+	"transferwise":          static.WISECode,
+	"uniteller":             static.UNTCode,
+	"ussc":                  static.USSCCode,
+}
+
+// breakerKey is the partner code of the request. Requests without a partner
+// in their metadata are keyed by the partner segment of the nonex or
+// remittance URL, or by the gateway module. The Western Union modules of the
+// gateway share the Western Union circuit.
+func (s *Svc) breakerKey(ctx context.Context, u *url.URL) string {
+	if p := phmw.GetPartner(ctx); p != "" {
+		return p
+	}
+	if u.Host == s.baseUrl.Host && strings.HasPrefix(u.Path, s.baseUrl.Path) {
+		return moduleKey(strings.TrimPrefix(u.Path, s.baseUrl.Path))
+	}
+	for _, b := range []*url.URL{s.nonexUrl, s.phRemittanceUrl} {
+		if u.Host != b.Host || !strings.HasPrefix(u.Path, b.Path) {
+			continue
+		}
+		seg := strings.SplitN(strings.TrimPrefix(strings.TrimPrefix(u.Path, b.Path), "/"), "/", 2)[0]
+		if p, ok := urlPartners[seg]; ok {
+			return p
+		}
+		if seg != "" {
+			return seg
+		}
+	}
+	return u.Host
+}
+
+// moduleKey is the breaker key of a gateway module path, see moduleURL.
+func moduleKey(p string) string {
+	segs := strings.Split(strings.Trim(p, "/"), "/")
+	m := segs[0]
+	if m == "1.1" && len(segs) > 1 {
+		m = segs[1]
+	}
+	if m == "" || m == "sdq" || strings.HasPrefix(m, "wu") {
+		return static.WUCode
+	}
+	return m
+}
@@ -0,0 +1,163 @@
+package perahub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/metadata"
+
+	"brank.as/petnet/api/core/static"
+)
+
+type flakyClient struct {
+	status int
+	err    error
+	calls  int
+}
+
+func (f *flakyClient) Do(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &http.Response{StatusCode: f.status, Body: http.NoBody}, nil
+}
+
+func (f *flakyClient) Post(url, contentType string, body io.Reader) (*http.Response, error) {
+	return f.Do(nil)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := &Svc{}
+	WithCircuitBreaker(3, time.Minute)(s)
+	s.brk.now = func() time.Time { return now }
+
+	fc := &flakyClient{status: http.StatusBadGateway}
+	cl := &breakerClient{
+		HTTPClient: fc,
+		b:          s.brk,
+		key:        func(context.Context, *url.URL) string { return "CEB" },
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://perahub.test/cebuana/find-client", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := func(want BreakerState) {
+		t.Helper()
+		hs := s.PartnerHealth()
+		if len(hs) != 1 || hs[0].State != want {
+			t.Fatalf("want state %s, got %+v", want, hs)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := cl.Do(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	state(BreakerOpen)
+	if _, err := cl.Do(req); !errors.Is(err, ErrPartnerUnavailable) || fc.calls != 3 {
+		t.Fatalf("want request failed fast, got %v after %d calls", err, fc.calls)
+	}
+
+	now = now.Add(time.Minute)
+	state(BreakerHalfOpen)
+	fc.err = errors.New("timeout")
+	if _, err := cl.Do(req); err == nil || errors.Is(err, ErrPartnerUnavailable) {
+		t.Fatalf("want probe sent, got %v", err)
+	}
+	state(BreakerOpen)
+
+	now = now.Add(time.Minute)
+	fc.err, fc.status = nil, http.StatusOK
+	if _, err := cl.Do(req); err != nil {
+		t.Fatal(err)
+	}
+	state(BreakerClosed)
+	if hs := s.PartnerHealth(); hs[0].Failures != 0 {
+		t.Errorf("want failures reset, got %d", hs[0].Failures)
+	}
+}
+
+func TestBreakerKey(t *testing.T) {
+	t.Parallel()
+	s, err := New(nil,
+		"dev",
+		"https://newkycgateway.dev.perahub.com.ph/gateway/",
+		"https://privatedrp.dev.perahub.com.ph/v1/remit/nonex/",
+		"https://privatedrp.dev.perahub.com.ph/v1/billspay/wrapper/api/",
+		"https://privatedrp.dev.perahub.com.ph/v1/billspay/",
+		"https://privatedrp.dev.perahub.com.ph/v1/transactions/api/",
+		"partner-id",
+		"client-key",
+		"api-key",
+		"",
+		"",
+		nil,
+		WithCircuitBreaker(0, 0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{url: s.moduleURL("wupo", "checkstat"), want: "WU"},
+		{url: s.moduleURL("wusostg", ""), want: "WU"},
+		{url: s.moduleURL("sdq", ""), want: "WU"},
+		{url: s.moduleURL("eload", ""), want: "eload"},
+		{url: s.moduleURL("Register", ""), want: "Register"},
+		{url: s.nonexURL("cebuana/get-service-fee"), want: "CEB"},
+		{url: "https://privatedrp.dev.perahub.com.ph/v1/billspay/bayad/transact", want: "privatedrp.dev.perahub.com.ph"},
+	}
+	for _, test := range tests {
+		u, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := s.breakerKey(context.Background(), u); got != test.want {
+			t.Errorf("%s: want key %q, got %q", test.url, test.want, got)
+		}
+	}
+}
+
+func TestBreakerPartnerPost(t *testing.T) {
+	t.Parallel()
+	fc := &flakyClient{status: http.StatusOK}
+	s, err := New(fc,
+		"dev",
+		"https://newkycgateway.dev.perahub.com.ph/gateway/",
+		"https://privatedrp.dev.perahub.com.ph/v1/remit/nonex/",
+		"https://privatedrp.dev.perahub.com.ph/v1/billspay/wrapper/api/",
+		"https://privatedrp.dev.perahub.com.ph/v1/billspay/",
+		"https://privatedrp.dev.perahub.com.ph/v1/transactions/api/",
+		"partner-id",
+		"client-key",
+		"api-key",
+		"",
+		"",
+		nil,
+		WithCircuitBreaker(3, time.Minute),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := metautils.NiceMD(metadata.Pairs("partner", static.WUCode)).ToIncoming(context.Background())
+	if _, err := s.postJSON(ctx, s.moduleURL("Register", ""), []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if hs := s.PartnerHealth(); len(hs) != 1 || hs[0].Partner != static.WUCode {
+		t.Errorf("want request keyed by partner %s, got %+v", static.WUCode, hs)
+	}
+}
@@ -41,7 +41,7 @@ type CEBInquireResult struct {
 }
 
 func (s *Svc) CEBInquire(ctx context.Context, sr CEBInquireRequest) (*CEBInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("cebuana/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("cebuana/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,7 @@ type CEBINTInquireResult struct {
 }
 
 func (s *Svc) CEBINTInquire(ctx context.Context, sr CEBINTInquireRequest) (*CEBINTInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("cebuana-international/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("cebuana-international/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
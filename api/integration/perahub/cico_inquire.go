@@ -40,7 +40,7 @@ type CicoInquireResult struct {
 }
 
 func (s *Svc) CicoInquire(ctx context.Context, sr CicoInquireRequest) (*CicoInquireResponse, error) {
-	res, err := s.cicoPost(ctx, s.cicoURL("inquiry"), sr)
+	res, err := s.cicoPost(idempotent(ctx), s.cicoURL("inquiry"), sr)
 	if err != nil {
 		return nil, err
 	}
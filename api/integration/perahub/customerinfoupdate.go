@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -66,7 +65,7 @@ func (s *Svc) CustomerInfoUpdate(ctx context.Context, ciuReq CustomerIURequest)
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("UpdateInfo", ""), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("UpdateInfo", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
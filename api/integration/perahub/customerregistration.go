@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -89,7 +88,7 @@ func (s *Svc) CustomerRegistration(ctx context.Context, crReq CustomerRegRequest
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("Register", ""), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("Register", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
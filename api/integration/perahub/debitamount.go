@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -40,7 +39,7 @@ func (s *Svc) DebitAmount(ctx context.Context, dr DebitAmountRequest) (*DebitAmo
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("DebitAmount", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("DebitAmount", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -383,6 +383,9 @@ func ConvertErr(err error, errType ErrType) error {
 	if err == nil {
 		return nil
 	}
+	if _, ok := err.(*Error); ok {
+		return err
+	}
 	er := status.Convert(err)
 	cd := er.Code()
 	if errType == NonexConError {
@@ -88,7 +88,7 @@ func (s *Svc) FeeInquiry(ctx context.Context, fr FIRequest) (*FIResponseBody, er
 		return nil, err
 	}
 
-	resp, err := s.post(ctx, s.moduleURL(mod, modReq), *req)
+	resp, err := s.post(idempotent(ctx), s.moduleURL(mod, modReq), *req)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +30,7 @@ func (s *Svc) FeeSurvey(ctx context.Context, fr FSRequest) (string, error) {
 		return "", err
 	}
 
-	resp, err := s.post(ctx, s.moduleURL(mod, ""), *req)
+	resp, err := s.post(idempotent(ctx), s.moduleURL(mod, ""), *req)
 	if err != nil {
 		return "", err
 	}
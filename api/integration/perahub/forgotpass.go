@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -35,7 +34,7 @@ func (s *Svc) ForgotPassword(ctx context.Context, pr ForgotPassRequest) (*Forgot
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("forgot_pwd_init", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("forgot_pwd_init", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
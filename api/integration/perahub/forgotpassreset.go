@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha512"
 	"encoding/json"
@@ -96,7 +95,7 @@ func (s *Svc) ForgotPasswordReset(ctx context.Context, pr ForgotPassResetRequest
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("forgot_pwd_commit", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("forgot_pwd_commit", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -55,7 +54,7 @@ func (s *Svc) GetELoadProducts(ctx context.Context, elpReq ELPRequest) (*ELPResp
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("eload", ""), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(idempotent(ctx), s.moduleURL("eload", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
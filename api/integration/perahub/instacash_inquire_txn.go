@@ -45,7 +45,7 @@ type InstaCashResult struct {
 }
 
 func (s *Svc) InstaCashInquire(ctx context.Context, sr InstaCashInquireRequest) (*InstaCashInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("instacash/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("instacash/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ type IEInquireResult struct {
 }
 
 func (s *Svc) IEInquire(ctx context.Context, sr IEInquireRequest) (*IEInquireResponse, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("intelexpress/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("intelexpress/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
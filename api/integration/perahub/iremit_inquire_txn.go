@@ -60,7 +60,7 @@ func (w *StringArray) UnmarshalJSON(data []byte) (err error) {
 }
 
 func (s *Svc) IRemitInquire(ctx context.Context, sr IRInquireRequest) (*IRInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("iremit/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("iremit/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +45,7 @@ type JPRResult struct {
 }
 
 func (s *Svc) JPRInquire(ctx context.Context, sr JPRInquireRequest) (*JPRInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("japanremit/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("japanremit/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
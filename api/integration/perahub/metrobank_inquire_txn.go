@@ -36,7 +36,7 @@ type MBInqResult struct {
 }
 
 func (s *Svc) MBInquire(ctx context.Context, sr MBInquireRequest) (*MBInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("metrobank/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("metrobank/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,44 @@
+package perahub
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type otherInfoKey struct{}
+
+// WithOtherInfo returns a context whose requests carry the other info fields
+// of the partner next to the fields of the request body.
+func WithOtherInfo(ctx context.Context, oi map[string]string) context.Context {
+	if len(oi) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, otherInfoKey{}, oi)
+}
+
+// marshalBody marshals the request body with the other info of the context
+// added to it. Fields of the body are never replaced by other info fields.
+func marshalBody(ctx context.Context, body interface{}) ([]byte, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	oi, _ := ctx.Value(otherInfoKey{}).(map[string]string)
+	if len(oi) == 0 {
+		return b, nil
+	}
+	m := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		// not an object, there is nothing to add the fields to.
+		return b, nil
+	}
+	for k, v := range oi {
+		if _, ok := m[k]; ok {
+			continue
+		}
+		if m[k], err = json.Marshal(v); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(m)
+}
@@ -0,0 +1,53 @@
+package perahub
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarshalBody(t *testing.T) {
+	type body struct {
+		ControlNo string `json:"control_number"`
+		Purpose   string `json:"purpose"`
+	}
+	b := body{ControlNo: "CTRL1", Purpose: "gift"}
+
+	tests := []struct {
+		desc string
+		ctx  context.Context
+		want map[string]string
+	}{
+		{
+			desc: "No Other Info",
+			ctx:  context.Background(),
+			want: map[string]string{"control_number": "CTRL1", "purpose": "gift"},
+		},
+		{
+			desc: "Other Info",
+			ctx: WithOtherInfo(context.Background(), map[string]string{
+				"source_account": "1234",
+				"purpose":        "savings",
+			}),
+			want: map[string]string{"control_number": "CTRL1", "purpose": "gift", "source_account": "1234"},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			r, err := marshalBody(test.ctx, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]string{}
+			if err := json.Unmarshal(r, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(test.want, got) {
+				t.Error(cmp.Diff(test.want, got))
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -92,7 +91,7 @@ func (s *Svc) PayStatusInquiry(ctx context.Context, psiReq PaySIRequest) (*PaySI
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("wupo", "checkstat"), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(idempotent(ctx), s.moduleURL("wupo", "checkstat"), reqBody)
 	if err != nil {
 		return nil, err
 	}
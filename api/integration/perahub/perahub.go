@@ -39,6 +39,9 @@ type Svc struct {
 
 	curr currencyCache
 	exp  time.Duration
+	brk  *breaker
+
+	retry map[string]RetryPolicy
 
 	log *logrus.Entry
 }
@@ -168,6 +171,7 @@ func New(cl HTTPClient, phEnv, baseUrl, nonexUrl, billerUrl, billsUrl, phTransac
 	for _, opt := range opts {
 		opt(s)
 	}
+	s.cl = s.withRetry(s.withBreaker(s.cl))
 
 	s.log.WithField("baser url", s.baseUrl).
 		WithField("nonex url", s.nonexUrl).
@@ -217,7 +221,7 @@ func newTestSvc(t *testing.T, store *postgres.Storage) (*Svc, *HTTPMock) {
 
 func (s *Svc) SetMock(cl HTTPClient) *Svc {
 	sc := *s
-	sc.cl = cl
+	sc.cl = s.withRetry(s.withBreaker(cl))
 	return &sc
 }
 
@@ -35,7 +35,7 @@ type PerahubRemitInquireResponse struct {
 }
 
 func (s *Svc) PerahubRemitInquire(ctx context.Context, req PerahubRemitInquireRequest) (*PerahubRemitInquireResponse, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("/perahub-remit/payout/inquire"), req)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("/perahub-remit/payout/inquire"), req)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -35,7 +34,7 @@ func (s *Svc) RegistrationOTP(ctx context.Context, ores OTPRequest) (*OTPRespons
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("SendSmsNewUser", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("SendSmsNewUser", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -36,7 +35,7 @@ func (s *Svc) RegistrationOTPValidate(ctx context.Context, ores OTPValidateReque
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("ValidateSMSNewUser", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("ValidateSMSNewUser", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +45,7 @@ type RMInqResult struct {
 }
 
 func (s *Svc) RMInquire(ctx context.Context, sr RMInquireRequest) (*RMInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("remitly/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("remitly/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
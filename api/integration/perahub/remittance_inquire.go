@@ -31,7 +31,7 @@ type RemitanceInquireRes struct {
 }
 
 func (s *Svc) RemitanceInquire(ctx context.Context, req RemitanceInquireReq) (*RemitanceInquireRes, error) {
-	res, err := s.remitancePost(ctx, s.remitanceURL("inquire"), req)
+	res, err := s.remitancePost(idempotent(ctx), s.remitanceURL("inquire"), req)
 	if err != nil {
 		return nil, err
 	}
@@ -258,7 +258,7 @@ func (s *Svc) postOrPutPerahub(ctx context.Context, method, url string, body int
 		return nil, errMethodNotAllowed
 	}
 
-	reqBody, err := json.Marshal(body)
+	reqBody, err := marshalBody(ctx, body)
 	if err != nil {
 		log.Error(err)
 		if err := ConvertErr(err, NonexError); err != nil {
@@ -463,6 +463,17 @@ renewAuthCache:
 	return b, nil
 }
 
+// postJSON posts the json body with the context of the request, so the
+// wrapping clients see it.
+func (s *Svc) postJSON(ctx context.Context, url string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return s.cl.Do(req)
+}
+
 func (s *Svc) moduleURL(name, req string) string {
 	u := *s.baseUrl
 	switch name {
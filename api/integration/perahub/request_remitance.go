@@ -92,7 +92,7 @@ renewAuthCache:
 // remitancePost request to perahub remitance
 func (s *Svc) remitancePost(ctx context.Context, url string, body interface{}) (json.RawMessage, error) {
 	log := logging.FromContext(ctx)
-	reqBody, err := json.Marshal(body)
+	reqBody, err := marshalBody(ctx, body)
 	if err != nil {
 		log.Error(err)
 		return nil, err
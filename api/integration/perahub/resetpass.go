@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha512"
 	"encoding/json"
@@ -43,7 +42,7 @@ func (s *Svc) ResetPassword(ctx context.Context, pr ResetPassRequest) (*ResetPas
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("ResetPassword", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("ResetPassword", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,195 @@
+package perahub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// URL groups of the perahub requests, each with its own retry policy.
+const (
+	RetryGateway    = "gateway"
+	RetryNonex      = "nonex"
+	RetryBiller     = "biller"
+	RetryBills      = "bills"
+	RetryCico       = "cico"
+	RetryRemittance = "remittance"
+	RetryTransact   = "transact"
+)
+
+// RetryGroups are the URL groups a retry policy can be set for.
+var RetryGroups = []string{RetryGateway, RetryNonex, RetryBiller, RetryBills, RetryCico, RetryRemittance, RetryTransact}
+
+// RetryPolicy retries safe requests failing with a connection error or a 5xx
+// status. The wait before each retry doubles from Backoff up to MaxBackoff,
+// with up to half of it taken off at random.
+type RetryPolicy struct {
+	// Attempts is the total number of attempts, retries are disabled below 2.
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) wait(attempt int) time.Duration {
+	d := p.Backoff << (attempt - 1)
+	if d <= 0 || (p.MaxBackoff > 0 && d > p.MaxBackoff) {
+		d = p.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// WithRetryPolicy sets the retry policy of the URL group.
+func WithRetryPolicy(group string, p RetryPolicy) SvcOption {
+	return func(s *Svc) {
+		if s.retry == nil {
+			s.retry = map[string]RetryPolicy{}
+		}
+		s.retry[group] = p
+	}
+}
+
+type idempotentKey struct{}
+
+// idempotent marks the requests sent with the context as safe to send again,
+// for the POST requests that only read. GET requests are always safe.
+func idempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+func isIdempotent(req *http.Request) bool {
+	if req.Method == http.MethodGet {
+		return true
+	}
+	ok, _ := req.Context().Value(idempotentKey{}).(bool)
+	return ok
+}
+
+// retryClient sends the safe requests again as their URL group's policy
+// allows. Every attempt goes through the wrapped client, so each is recorded
+// by the metrics transport.
+type retryClient struct {
+	HTTPClient
+	policy func(u *url.URL) RetryPolicy
+}
+
+func (c *retryClient) Do(req *http.Request) (*http.Response, error) {
+	if !isIdempotent(req) {
+		return c.HTTPClient.Do(req)
+	}
+	p := c.policy(req.URL)
+	ctx := req.Context()
+	r := req
+	for attempt := 1; ; attempt++ {
+		resp, err := c.HTTPClient.Do(r)
+		if attempt >= p.Attempts || !retryable(ctx, resp, err) {
+			return resp, err
+		}
+		w := p.wait(attempt)
+		if dl, ok := ctx.Deadline(); ok && time.Until(dl) <= w {
+			return resp, err
+		}
+		nr, rerr := rewind(req)
+		if rerr != nil {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		t := time.NewTimer(w)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return nil, ctx.Err()
+		}
+		r = nr
+	}
+}
+
+// Post sends the request through Do. Without a context it is never marked
+// safe, so it is sent once; the read-only posts go through Do instead.
+func (c *retryClient) Post(rawURL, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, rawURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	return c.Do(req)
+}
+
+// retryable reports whether the attempt failed on the partner's side rather
+// than because the caller gave up or the partner's circuit is open.
+func retryable(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, ErrPartnerUnavailable)
+	}
+	return resp.StatusCode >= http.StatusInternalServerError
+}
+
+// rewind returns a copy of the request with its body read again.
+func rewind(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return r, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("request body can't be read again")
+	}
+	b, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = b
+	return r, nil
+}
+
+// withRetry wraps the client with the retry policies, if any is set.
+func (s *Svc) withRetry(cl HTTPClient) HTTPClient {
+	if len(s.retry) == 0 {
+		return cl
+	}
+	if rc, ok := cl.(*retryClient); ok {
+		cl = rc.HTTPClient
+	}
+	return &retryClient{HTTPClient: cl, policy: s.retryPolicy}
+}
+
+// retryPolicy is the policy of the URL group with the longest base URL
+// matching the request.
+func (s *Svc) retryPolicy(u *url.URL) RetryPolicy {
+	bases := []struct {
+		group string
+		u     *url.URL
+	}{
+		{RetryGateway, s.baseUrl},
+		{RetryNonex, s.nonexUrl},
+		{RetryBiller, s.billerUrl},
+		{RetryBills, s.billsUrl},
+		{RetryCico, s.cicoUrl},
+		{RetryRemittance, s.phRemittanceUrl},
+		{RetryTransact, s.phTransactUrl},
+	}
+	var grp string
+	var n int
+	for _, b := range bases {
+		if b.u == nil || u.Host != b.u.Host || !strings.HasPrefix(u.Path, b.u.Path) {
+			continue
+		}
+		if len(b.u.Path) > n || grp == "" {
+			grp, n = b.group, len(b.u.Path)
+		}
+	}
+	return s.retry[grp]
+}
@@ -0,0 +1,197 @@
+package perahub
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type attemptClient struct {
+	statuses []int
+	errs     []error
+	bodies   []string
+}
+
+func (a *attemptClient) Do(req *http.Request) (*http.Response, error) {
+	n := len(a.bodies)
+	b := ""
+	if req.Body != nil {
+		bb, _ := io.ReadAll(req.Body)
+		b = string(bb)
+	}
+	a.bodies = append(a.bodies, b)
+	if n < len(a.errs) && a.errs[n] != nil {
+		return nil, a.errs[n]
+	}
+	st := http.StatusOK
+	if n < len(a.statuses) {
+		st = a.statuses[n]
+	}
+	return &http.Response{StatusCode: st, Body: http.NoBody}, nil
+}
+
+func (a *attemptClient) Post(url string, contentType string, body io.Reader) (*http.Response, error) {
+	return nil, errors.New("not implemented")
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+	pol := func(*url.URL) RetryPolicy {
+		return RetryPolicy{Attempts: 3, Backoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+	}
+	conErr := errors.New("connection reset")
+
+	tests := []struct {
+		desc       string
+		method     string
+		idempotent bool
+		statuses   []int
+		errs       []error
+		want       int
+		wantStatus int
+	}{
+		{
+			desc:       "Retried Until Success",
+			method:     http.MethodPost,
+			idempotent: true,
+			statuses:   []int{http.StatusBadGateway, 0, http.StatusOK},
+			errs:       []error{nil, conErr},
+			want:       3,
+			wantStatus: http.StatusOK,
+		},
+		{
+			desc:       "Attempts Exhausted",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			want:       3,
+			wantStatus: http.StatusServiceUnavailable,
+		},
+		{
+			desc:       "Client Error Not Retried",
+			method:     http.MethodGet,
+			statuses:   []int{http.StatusBadRequest},
+			want:       1,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			desc:       "Unsafe Request Not Retried",
+			method:     http.MethodPost,
+			statuses:   []int{http.StatusBadGateway, http.StatusOK},
+			want:       1,
+			wantStatus: http.StatusBadGateway,
+		},
+		{
+			desc:       "Open Circuit Not Retried",
+			method:     http.MethodGet,
+			errs:       []error{ErrPartnerUnavailable},
+			want:       1,
+			wantStatus: 0,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			ac := &attemptClient{statuses: test.statuses, errs: test.errs}
+			cl := &retryClient{HTTPClient: ac, policy: pol}
+			ctx := context.Background()
+			if test.idempotent {
+				ctx = idempotent(ctx)
+			}
+			req, err := http.NewRequestWithContext(ctx, test.method, "https://perahub.test/nonex/cebuana/inquire", bytes.NewBufferString(`{"control_number":"1"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := cl.Do(req)
+			if len(ac.bodies) != test.want {
+				t.Fatalf("want %d attempts, got %d", test.want, len(ac.bodies))
+			}
+			for i, b := range ac.bodies {
+				if b != `{"control_number":"1"}` {
+					t.Errorf("attempt %d sent body %q", i+1, b)
+				}
+			}
+			if test.wantStatus == 0 {
+				if err == nil {
+					t.Fatal("want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != test.wantStatus {
+				t.Errorf("want status %d, got %d", test.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestRetryDeadline(t *testing.T) {
+	t.Parallel()
+	ac := &attemptClient{statuses: []int{http.StatusBadGateway, http.StatusOK}}
+	cl := &retryClient{HTTPClient: ac, policy: func(*url.URL) RetryPolicy {
+		return RetryPolicy{Attempts: 3, Backoff: time.Minute}
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://perahub.test/nonex/ria/inquire", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := cl.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ac.bodies) != 1 || resp.StatusCode != http.StatusBadGateway {
+		t.Errorf("want no retry past the deadline, got %d attempts", len(ac.bodies))
+	}
+}
+
+func TestRetryPolicyGroup(t *testing.T) {
+	t.Parallel()
+	s, err := New(nil,
+		"dev",
+		"https://newkycgateway.dev.perahub.com.ph/gateway/",
+		"https://privatedrp.dev.perahub.com.ph/v1/remit/nonex/",
+		"https://privatedrp.dev.perahub.com.ph/v1/billspay/wrapper/api/",
+		"https://privatedrp.dev.perahub.com.ph/v1/billspay/",
+		"https://privatedrp.dev.perahub.com.ph/v1/transactions/api/",
+		"partner-id",
+		"client-key",
+		"api-key",
+		"",
+		"",
+		nil,
+		WithRetryPolicy(RetryNonex, RetryPolicy{Attempts: 3}),
+		WithRetryPolicy(RetryBiller, RetryPolicy{Attempts: 2}),
+		WithRetryPolicy(RetryBills, RetryPolicy{Attempts: 4}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		url  string
+		want int
+	}{
+		{url: s.nonexURL("cebuana/inquire"), want: 3},
+		{url: s.billerURL("biller-category"), want: 2},
+		{url: "https://privatedrp.dev.perahub.com.ph/v1/billspay/ecpay/biller-category", want: 4},
+		{url: s.moduleURL("wu", "feeinquiry"), want: 0},
+	}
+	for _, test := range tests {
+		u, err := url.Parse(test.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := s.retryPolicy(u).Attempts; got != test.want {
+			t.Errorf("%s: want %d attempts, got %d", test.url, test.want, got)
+		}
+	}
+}
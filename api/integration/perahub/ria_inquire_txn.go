@@ -41,7 +41,7 @@ type RiaResult struct {
 }
 
 func (s *Svc) RiaInquire(ctx context.Context, sr RiaInquireRequest) (*RiaInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("ria/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("ria/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
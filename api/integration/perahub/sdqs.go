@@ -77,7 +77,7 @@ func (s *Svc) SDQs(ctx context.Context, r SDQsRequest) (*SDQsResponseBody, error
 		if err != nil {
 			return nil, err
 		}
-		resp, err := s.post(ctx, s.moduleURL(mod, modReq), *req)
+		resp, err := s.post(idempotent(ctx), s.moduleURL(mod, modReq), *req)
 		if err != nil {
 			return nil, err
 		}
@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -44,7 +43,7 @@ func (s *Svc) SellLoad(ctx context.Context, selReq SELoadRequest) (*SELoadRespon
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("eload", ""), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("eload", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -148,7 +147,7 @@ func (s *Svc) SendMoneyStageHold(ctx context.Context, smshReq SMSHoldRequest) (*
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("wusostg", ""), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("wusostg", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
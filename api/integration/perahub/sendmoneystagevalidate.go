@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -177,7 +176,7 @@ func (s *Svc) SendMoneyStageValidate(ctx context.Context, smsvr SMSVRequest) (*S
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("wusostg", ""), "application/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("wusostg", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
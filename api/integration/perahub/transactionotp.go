@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -34,7 +33,7 @@ func (s *Svc) TransactionOTP(ctx context.Context, tr SendOTPRequest) (*SendOTPRe
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("SendSMSUser", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(ctx, s.moduleURL("SendSMSUser", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +54,7 @@ type TFResult struct {
 }
 
 func (s *Svc) TFInquire(ctx context.Context, sr TFInquireRequest) (*TFInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("transfast/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("transfast/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
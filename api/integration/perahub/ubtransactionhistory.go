@@ -1,7 +1,6 @@
 package perahub
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"io/ioutil"
@@ -55,7 +54,7 @@ func (s *Svc) UBTransactionHistory(ctx context.Context, tr UBTHRequest) (*UBTHRe
 		return nil, err
 	}
 
-	res, err := s.cl.Post(s.moduleURL("Transaction", ""), "aplication/json", bytes.NewBuffer(reqBody))
+	res, err := s.postJSON(idempotent(ctx), s.moduleURL("Transaction", ""), reqBody)
 	if err != nil {
 		return nil, err
 	}
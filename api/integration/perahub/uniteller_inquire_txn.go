@@ -52,7 +52,7 @@ type UNTResult struct {
 }
 
 func (s *Svc) UNTInquire(ctx context.Context, sr UNTInquireRequest) (*UNTInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("uniteller/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("uniteller/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +37,7 @@ type USSCFeeInquiryResult struct {
 }
 
 func (s *Svc) USSCFeeInquiry(ctx context.Context, sr USSCFeeInquiryRequest) (*USSCFeeInquiryRespBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("ussc/fee-inquiry"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("ussc/fee-inquiry"), sr)
 	if err != nil {
 		return nil, err
 	}
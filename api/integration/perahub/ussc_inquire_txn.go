@@ -46,7 +46,7 @@ type USSCInqResult struct {
 }
 
 func (s *Svc) USSCInquire(ctx context.Context, sr USSCInquireRequest) (*USSCInquireResponseBody, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("ussc/inquire"), sr)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("ussc/inquire"), sr)
 	if err != nil {
 		return nil, err
 	}
@@ -33,7 +33,7 @@ type WISEFeeBreakdown struct {
 }
 
 func (s *Svc) WISEQuoteInquiry(ctx context.Context, req WISEQuoteInquiryReq) (*WISEQuoteInquiryResp, error) {
-	res, err := s.postNonex(ctx, s.nonexURL("transferwise/quotes/inquiry"), req)
+	res, err := s.postNonex(idempotent(ctx), s.nonexURL("transferwise/quotes/inquiry"), req)
 	if err != nil {
 		return nil, err
 	}
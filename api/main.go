@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pariz/gountries"
 	"github.com/spf13/viper"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
@@ -43,15 +45,21 @@ import (
 
 	// core logic
 	"brank.as/petnet/api/core/auth"
+	"brank.as/petnet/api/core/billerwallet"
 	bpac "brank.as/petnet/api/core/bills-payment"
 	bpacBc "brank.as/petnet/api/core/bills-payment/bayadcenter"
 	bpacEp "brank.as/petnet/api/core/bills-payment/ecpay"
 	bpacMp "brank.as/petnet/api/core/bills-payment/multipay"
 	cicoc "brank.as/petnet/api/core/cashincashout"
+	"brank.as/petnet/api/core/commission"
+	eloadc "brank.as/petnet/api/core/eload"
 	fc "brank.as/petnet/api/core/fee"
 	miCore "brank.as/petnet/api/core/microinsurance"
 	pc "brank.as/petnet/api/core/partner"
+	"brank.as/petnet/api/core/payout"
 	qc "brank.as/petnet/api/core/quote"
+	wiseq "brank.as/petnet/api/core/quote/wise"
+	"brank.as/petnet/api/core/reconcile"
 	"brank.as/petnet/api/core/remit"
 	aya "brank.as/petnet/api/core/remit/ayannah"
 	bp "brank.as/petnet/api/core/remit/bpi"
@@ -83,6 +91,7 @@ import (
 	authSvc "brank.as/petnet/api/services/auth"
 	bpas "brank.as/petnet/api/services/bills-payment"
 	cicos "brank.as/petnet/api/services/cashincashout"
+	eloads "brank.as/petnet/api/services/eload"
 	fSvc "brank.as/petnet/api/services/fee"
 	"brank.as/petnet/api/services/microinsurance"
 	rpSvc "brank.as/petnet/api/services/partner"
@@ -90,16 +99,24 @@ import (
 	remits "brank.as/petnet/api/services/remittance"
 	rtas "brank.as/petnet/api/services/remittoaccount"
 	revcom "brank.as/petnet/api/services/revenue-commission"
+	tlSvc "brank.as/petnet/api/services/teller"
 	"brank.as/petnet/api/services/terminal"
 	usrSvc "brank.as/petnet/api/services/user"
 
 	// proto
+	epb "brank.as/petnet/gunk/dsa/v1/email"
+	brpb "brank.as/petnet/gunk/dsa/v2/branch"
+	dfpb "brank.as/petnet/gunk/dsa/v2/fees"
 	pfppb "brank.as/petnet/gunk/dsa/v2/partner"
+	pcpb "brank.as/petnet/gunk/dsa/v2/partnercommission"
 	ptnrLst "brank.as/petnet/gunk/dsa/v2/partnerlist"
 	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	rspb "brank.as/petnet/gunk/dsa/v2/revenuesharing"
 	rsr "brank.as/petnet/gunk/dsa/v2/revenuesharingreport"
 	pfSvc "brank.as/petnet/gunk/dsa/v2/service"
+	tlpb "brank.as/petnet/gunk/dsa/v2/teller"
 	trxtp "brank.as/petnet/gunk/dsa/v2/transactiontype"
+	mpb "brank.as/rbac/gunk/v1/mfa"
 	osapb "brank.as/rbac/gunk/v1/oauth2"
 	sapb "brank.as/rbac/gunk/v1/serviceaccount"
 )
@@ -288,6 +305,15 @@ func (u *util) newIntlServer(log *logrus.Entry, c *viper.Viper, svcs *Services)
 	if err != nil {
 		log.Fatal(err)
 	}
+	// branch and teller attribution, run once the user's org is known
+	aMD, err := meta.NewMetadata(log,
+		phmw.ResetAttribution(),
+		phmw.ConfirmTeller(tlpb.NewTellerServiceClient(u.cs.pfInt)),
+		phmw.ConfirmBranch(brpb.NewBranchServiceClient(u.cs.pfInt)),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	grpcMeasurement := c.GetString("influxdb.grpcMeasure")
 	if grpcMeasurement == "" {
@@ -299,6 +325,7 @@ func (u *util) newIntlServer(log *logrus.Entry, c *viper.Viper, svcs *Services)
 		u.met.UnaryServerInterceptor("internal_"+grpcMeasurement, nil),
 		iMD.UnaryServerInterceptor(),
 		UserDetailsInternal(),
+		aMD.UnaryServerInterceptor(),
 	)
 	svr := grpc.NewServer(grpc.UnaryInterceptor(iMW))
 
@@ -320,6 +347,8 @@ func (u *util) newExtlServer(log *logrus.Entry, c *viper.Viper, intSvr *mainpkg.
 		// service account auth
 		phmw.NewServiceAccount(sapb.NewValidationServiceClient(u.cs.idInt), trxtp.NewTransactionTypeServiceClient(u.cs.pfInt), ppb.NewOrgProfileServiceClient(u.cs.pfInt), log),
 		phmw.ConfirmDSA(c.GetString("runtime.environment")), // Enforcement
+		phmw.ConfirmTeller(tlpb.NewTellerServiceClient(u.cs.pfInt)),
+		phmw.ConfirmBranch(brpb.NewBranchServiceClient(u.cs.pfInt)),
 		meta.MetaFunc(func(ctx context.Context) (context.Context, error) {
 			// annotate metrics
 			metrics.SetTag(ctx, "dsa_id", phmw.GetDSA(ctx))
@@ -351,6 +380,7 @@ func (u *util) newExtlServer(log *logrus.Entry, c *viper.Viper, intSvr *mainpkg.
 		mainpkg.WithDualService(mainpkg.External, svcs.External...),
 		mainpkg.AdditionalServers(intSvr),
 		mainpkg.WithGatewayProtoError(eh.HTTPErrorHandler),
+		mainpkg.AddGatewayMuxOption(gwruntime.WithIncomingHeaderMatcher(phmw.HeaderMatcher)),
 		mainpkg.OptionList(svcs.Option),
 	)
 	if err != nil {
@@ -360,6 +390,10 @@ func (u *util) newExtlServer(log *logrus.Entry, c *viper.Viper, intSvr *mainpkg.
 	return extSvr
 }
 
+// leaderLockKey is the postgres advisory lock used to elect the replica
+// running the leader crons.
+const leaderLockKey = 7_000_001
+
 // newSvcs configure services.
 func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (*Services, error) {
 	st := u.st
@@ -381,6 +415,20 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 		nonexAPIKey = c.GetString("perahub.defaultAPIKey")
 	}
 
+	phOpts := []perahub.SvcOption{
+		perahub.WithLogger(log),
+		perahub.WithCiCoURL(c.GetString("perahub.cicourl")),
+		perahub.WithPHRemittanceURL(c.GetString("perahub.remittanceUrl")),
+		perahub.WithPerahubDefaultAPIKey(c.GetString("perahub.defaultAPIKey")),
+		perahub.WithCircuitBreaker(c.GetInt("perahub.breakerThreshold"), c.GetDuration("perahub.breakerCooldown")),
+	}
+	for _, g := range perahub.RetryGroups {
+		phOpts = append(phOpts, perahub.WithRetryPolicy(g, perahub.RetryPolicy{
+			Attempts:   c.GetInt("perahub.retry." + g + ".attempts"),
+			Backoff:    c.GetDuration("perahub.retry." + g + ".backoff"),
+			MaxBackoff: c.GetDuration("perahub.retry." + g + ".maxBackoff"),
+		}))
+	}
 	phintg, err := perahub.New(cl,
 		c.GetString("runtime.environment"),
 		c.GetString("perahub.baseurl"),
@@ -399,10 +447,7 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 				ClientSecret: c.GetString("perahub.wiseClientSecret"),
 			},
 		},
-		perahub.WithLogger(log),
-		perahub.WithCiCoURL(c.GetString("perahub.cicourl")),
-		perahub.WithPHRemittanceURL(c.GetString("perahub.remittanceUrl")),
-		perahub.WithPerahubDefaultAPIKey(c.GetString("perahub.defaultAPIKey")),
+		phOpts...,
 	)
 	if err != nil {
 		return nil, err
@@ -413,7 +458,7 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 		phm = true
 	}
 
-	stccore := static.New(phintg, st)
+	stccore := static.New(phintg, st, static.WithCacheTTL(c.GetDuration("partner.registryTTL")))
 
 	// remitters
 	rmts := []remit.Remitter{
@@ -437,15 +482,60 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 	}
 
 	// core logic
-	rmtcore, err := remit.New(st, phintg, stccore, rmts)
+	rmtcore, err := remit.New(st, phintg, stccore, rmts,
+		remit.WithOTP(st, mpb.NewMFAAuthServiceClient(u.cs.idInt)),
+		remit.WithOTPType(c.GetString("remit.otpType")),
+		remit.WithOTPDSAs(c.GetStringSlice("remit.otpDSAs")...),
+		remit.WithOTPPartners(c.GetStringSlice("remit.otpPartners")...),
+		remit.WithOTPTTL(c.GetDuration("remit.otpTTL")),
+		remit.WithOTPAttempts(c.GetInt("remit.otpAttempts")),
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	rcnsvc := reconcile.New(st, rmtcore,
+		reconcile.WithGrace(c.GetDuration("reconcile.grace")),
+		reconcile.WithLookback(c.GetDuration("reconcile.lookback")),
+		reconcile.WithRetry(c.GetDuration("reconcile.retry")),
+		reconcile.WithLimit(c.GetInt("reconcile.limit")),
+	)
+	rcnSched := c.GetString("reconcile.schedule")
+	if rcnSched == "" {
+		rcnSched = "*/15 * * * *" // every 15 minutes
+	}
+
+	poSvc := payout.New(st, rmtcore,
+		payout.WithPartners(c.GetStringSlice("payoutPoll.partners")),
+		payout.WithLookback(c.GetDuration("payoutPoll.lookback")),
+		payout.WithRecheck(c.GetDuration("payoutPoll.recheck")),
+		payout.WithLimit(c.GetInt("payoutPoll.limit")),
+	)
+	poSched := c.GetString("payoutPoll.schedule")
+	if poSched == "" {
+		poSched = "*/15 * * * *"
+	}
+
+	igOpts := []pc.Option{pc.WithInputGuideTTL(c.GetDuration("partner.inputGuideTTL"))}
+	for p, d := range c.GetStringMapString("partner.inputGuidePartnerTTL") {
+		ttl, err := time.ParseDuration(d)
+		if err != nil {
+			return nil, fmt.Errorf("input guide ttl of %s: %w", p, err)
+		}
+		igOpts = append(igOpts, pc.WithPartnerInputGuideTTL(p, ttl))
+	}
+	ptnrcore := pc.New(st, phintg, igOpts...)
+	igSched := c.GetString("partner.inputGuideSchedule")
+	if igSched == "" {
+		igSched = "0 * * * *" // hourly
+	}
 	// validators
 	q := gountries.New()
 	trmval := terminal.NewValidators(q)
-	trmsvc, err := terminal.New(rmtcore, stccore, trmval)
+	trmsvc, err := terminal.New(rmtcore, stccore, trmval,
+		terminal.WithIdempotency(st, c.GetDuration("terminal.idempotencyWindow")),
+		terminal.WithOtherInfo(ptnrcore),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -468,7 +558,7 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 		rtaMb.New(st, rtaClnt),
 	}
 	rtaval := rtas.NewValidators(q)
-	rtaCore, err := rtac.New(rtaPtnrs, rtaClnt)
+	rtaCore, err := rtac.New(rtaPtnrs, rtaClnt, st)
 	if err != nil {
 		return nil, err
 	}
@@ -497,25 +587,88 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 	if err != nil {
 		return nil, err
 	}
-	bpSvc, err := bpas.New(bpCore, bpval)
+	bwOpts := []billerwallet.Option{}
+	if t := c.GetString("billerWallet.threshold"); t != "" {
+		n, err := billerwallet.ParseAmount(t)
+		if err != nil {
+			return nil, fmt.Errorf("biller wallet threshold: %w", err)
+		}
+		bwOpts = append(bwOpts, billerwallet.WithThreshold(n))
+	}
+	for p, t := range c.GetStringMapString("billerWallet.partnerThreshold") {
+		n, err := billerwallet.ParseAmount(t)
+		if err != nil {
+			return nil, fmt.Errorf("biller wallet threshold of %s: %w", p, err)
+		}
+		bwOpts = append(bwOpts, billerwallet.WithPartnerThreshold(strings.ToUpper(p), n))
+	}
+	if to := c.GetStringSlice("billerWallet.alertEmails"); len(to) > 0 {
+		bwOpts = append(bwOpts, billerwallet.WithNotifiers(billerwallet.NewEmailNotifier(epb.NewEmailServiceClient(u.cs.pfInt), to)))
+	}
+	if wh := c.GetString("billerWallet.webhookURL"); wh != "" {
+		bwOpts = append(bwOpts, billerwallet.WithNotifiers(billerwallet.NewWebhookNotifier(wh, c.GetDuration("billerWallet.webhookTimeout"))))
+	}
+	bwSvc := billerwallet.New(st, bpClnt, bwOpts...)
+	bwSched := c.GetString("billerWallet.schedule")
+	if bwSched == "" {
+		bwSched = "*/5 * * * *" // every 5 minutes
+	}
+
+	bpSvc, err := bpas.New(bpCore, bpval, bpas.WithWalletStore(bwSvc))
 	if err != nil {
 		return nil, err
 	}
 	// end billspayment
 
 	ptnrval := rpSvc.NewValidators()
-	ptnrsvc := rpSvc.New(stccore, pc.New(st, phintg), pfppb.NewPartnerServiceClient(u.cs.pfInt), pfSvc.NewServiceServiceClient(u.cs.pfInt), ptnrLst.NewPartnerListServiceClient(u.cs.pfInt), ptnrval)
+	ptnrsvc := rpSvc.New(stccore, ptnrcore, pfppb.NewPartnerServiceClient(u.cs.pfInt), pfSvc.NewServiceServiceClient(u.cs.pfInt), ptnrLst.NewPartnerListServiceClient(u.cs.pfInt), ptnrval, rpSvc.WithHealth(phintg))
 
+	feeOpts := []fc.Option{
+		fc.WithFeeTable(dfpb.NewOrgFeesServiceClient(u.cs.pfInt)),
+		fc.WithQuoters(wiseq.New(phintg)),
+		fc.WithQuoteTimeout(c.GetDuration("fee.quoteTimeout")),
+	}
+	for p, d := range c.GetStringMapString("fee.partnerQuoteTimeout") {
+		to, err := time.ParseDuration(d)
+		if err != nil {
+			return nil, fmt.Errorf("quote timeout of %s: %w", p, err)
+		}
+		feeOpts = append(feeOpts, fc.WithPartnerQuoteTimeout(strings.ToUpper(p), to))
+	}
 	feeval := fSvc.NewValidators()
-	feesvc := fSvc.New(stccore, fc.New(st, phintg), feeval)
+	feesvc := fSvc.New(stccore, fc.New(st, phintg, feeOpts...), feeval)
+
+	// teller logins are created through the profile service's RBAC signup proxy
+	tlsvc := tlSvc.New(tlpb.NewTellerServiceClient(u.cs.pfInt))
 
 	usrval := usrSvc.NewValidators()
-	usrsvc := usrSvc.New(uc.New(st, phintg), usrval)
+	var regKey []byte
+	if k := c.GetString("enrollment.registrationKey"); k != "" {
+		regKey, err = base64.StdEncoding.DecodeString(k)
+		if err != nil {
+			return nil, fmt.Errorf("enrollment registration key: %w", err)
+		}
+	}
+	usrcore := uc.New(st, phintg,
+		uc.WithRegistrationKey(regKey),
+		uc.WithEnrollmentTTL(c.GetDuration("enrollment.ttl")),
+	)
+	enrSched := c.GetString("enrollment.purgeSchedule")
+	if enrSched == "" {
+		enrSched = "0 * * * *" // hourly
+	}
+	usrsvc := usrSvc.New(usrcore, stccore, usrval)
 
 	qteval := qteSvc.NewValidators()
-	qtesvc := qteSvc.New(qc.New(st, phintg), qteval)
+	qtesvc := qteSvc.New(qc.New(st, phintg), stccore, qteval)
 
 	cicovc := cicos.New(cicoc.New(phintg, st))
+	eloadcore := eloadc.New(phintg, st, eloadc.WithReviewGrace(c.GetDuration("eload.reviewGrace")))
+	eloadSched := c.GetString("eload.reviewSchedule")
+	if eloadSched == "" {
+		eloadSched = "*/15 * * * *"
+	}
+	eloadsvc := eloads.New(eloadcore)
 	remitsvc := remits.New(remitc.New(st, phintg))
 
 	// internal services
@@ -530,6 +683,16 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 		return nil, err
 	}
 
+	comsvc := commission.New(rspb.NewRevenueSharingServiceClient(u.cs.pfInt), pcpb.NewPartnerCommissionServiceClient(u.cs.pfInt))
+	comLedger := commission.NewLedger(st, comsvc,
+		commission.WithLookback(c.GetDuration("commission.lookback")),
+		commission.WithLimit(c.GetInt("commission.limit")),
+	)
+	comSched := c.GetString("commission.schedule")
+	if comSched == "" {
+		comSched = "*/10 * * * *" // every 10 minutes
+	}
+
 	revComClient := revcom_int.NewRevCommClient(phintg, revComBaseUrl)
 	revComSvc := revcom.NewRevenueCommissionService(
 		revcom.WithDSAStore(revComClient),
@@ -538,6 +701,7 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 		revcom.WithStorage(st),
 		revcom.WithRevenueSharingReport(rsr.NewRevenueSharingReportServiceClient(u.cs.pfInt)),
 		revcom.WithProfileDSA(ppb.NewOrgProfileServiceClient(u.cs.pfInt)),
+		revcom.WithCommission(comsvc),
 	)
 
 	micInsBaseUrl, err := url.Parse(c.GetString("perahub.micInsUrl"))
@@ -549,11 +713,21 @@ func (u *util) newSvcs(ctx context.Context, log *logrus.Entry, c *viper.Viper) (
 	miSvc := microinsurance.NewMicroInsuranceSvc(miCore.NewMicroInsuranceCoreSvc(st, miClient))
 
 	return &Services{
-		External: []mainpkg.GWGRPC{ptnrsvc, trmsvc, feesvc, usrsvc, qtesvc, remitsvc, cicovc, rtaSvc, miSvc, bpSvc, revComSvc},
-		Internal: []mainpkg.GWGRPC{asvc, trmsvc, remitsvc, revComSvc, cicovc, rtaSvc, miSvc, bpSvc},
+		External: []mainpkg.GWGRPC{ptnrsvc, trmsvc, feesvc, usrsvc, qtesvc, remitsvc, cicovc, rtaSvc, miSvc, bpSvc, revComSvc, eloadsvc, tlsvc},
+		Internal: []mainpkg.GWGRPC{asvc, trmsvc, remitsvc, revComSvc, cicovc, rtaSvc, miSvc, bpSvc, ptnrsvc, eloadsvc},
 		Option: []mainpkg.Option{
 			mainpkg.WithCron("Create Trannsaction Report", mainpkg.NewCrontab(sched), revComSvc.SyncTransactionReport),
 			mainpkg.WithCron("update remco id", mainpkg.NewCrontab(newSched), ptnrsvc.UpdateRemcoId),
+			mainpkg.WithLeaderCron("reconcile remittance", mainpkg.NewCrontab(rcnSched), rcnsvc.Reconcile),
+			mainpkg.WithLeaderCron("poll remit payout status", mainpkg.NewCrontab(poSched), poSvc.Poll),
+			mainpkg.WithLeaderCron("post commission ledger", mainpkg.NewCrontab(comSched), comLedger.Post),
+			mainpkg.WithLeaderCron("refresh input guides", mainpkg.NewCrontab(igSched), ptnrcore.RefreshStaleInputGuides),
+			mainpkg.WithLeaderCron("poll biller wallet balances", mainpkg.NewCrontab(bwSched), bwSvc.Poll),
+			mainpkg.WithLeaderCron("review pending eload sales", mainpkg.NewCrontab(eloadSched), eloadcore.ReviewPending),
+			mainpkg.WithLeaderCron("purge abandoned customer enrollments", mainpkg.NewCrontab(enrSched), usrcore.PurgeEnrollments),
+			mainpkg.WithLeadElector(func(string) (mainpkg.Leader, error) {
+				return st.NewElector(leaderLockKey), nil
+			}),
 		},
 	}, nil
 }
@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS remit_idempotency (
+    dsa_id text NOT NULL,
+    idempotency_key text NOT NULL,
+    method text NOT NULL,
+    request_hash text NOT NULL,
+    response bytea,
+    created timestamptz NOT NULL DEFAULT NOW(),
+    updated timestamptz NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (dsa_id, idempotency_key)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS remit_idempotency;
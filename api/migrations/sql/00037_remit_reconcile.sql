@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS remit_reconcile (
+    id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+    remit_id uuid NOT NULL,
+    remco_id text NOT NULL DEFAULT '',
+    remit_type text NOT NULL DEFAULT '',
+    remco_control_number text NOT NULL DEFAULT '',
+    from_status text NOT NULL DEFAULT '',
+    from_step text NOT NULL DEFAULT '',
+    to_status text NOT NULL DEFAULT '',
+    to_step text NOT NULL DEFAULT '',
+    partner_status text NOT NULL DEFAULT '',
+    message text NOT NULL DEFAULT '',
+    created timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS remit_reconcile_remit_id_idx ON remit_reconcile (remit_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS remit_reconcile;
@@ -0,0 +1,28 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS commission_ledger (
+    id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+    org_id text NOT NULL,
+    service text NOT NULL,
+    transaction_id text NOT NULL,
+    partner text NOT NULL DEFAULT '',
+    bound_type text NOT NULL DEFAULT '',
+    principal_amount numeric NOT NULL DEFAULT 0,
+    charges numeric NOT NULL DEFAULT 0,
+    revenue numeric NOT NULL DEFAULT 0,
+    dsa_commission numeric NOT NULL DEFAULT 0,
+    petnet_commission numeric NOT NULL DEFAULT 0,
+    partner_tier_type text NOT NULL DEFAULT '',
+    partner_rate text NOT NULL DEFAULT '',
+    sharing_tier_type text NOT NULL DEFAULT '',
+    share_percent text NOT NULL DEFAULT '',
+    txn_completed_time timestamptz NOT NULL,
+    created timestamptz NOT NULL DEFAULT now(),
+    UNIQUE (service, transaction_id)
+);
+
+CREATE INDEX IF NOT EXISTS commission_ledger_org_id_idx ON commission_ledger (org_id, txn_completed_time);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS commission_ledger;
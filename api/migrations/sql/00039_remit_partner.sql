@@ -0,0 +1,17 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS remit_partner (
+    code text NOT NULL PRIMARY KEY,
+    name text NOT NULL,
+    countries text[] NOT NULL DEFAULT '{}',
+    send_types jsonb NOT NULL DEFAULT '{}',
+    disburse_types jsonb NOT NULL DEFAULT '{}',
+    enabled boolean NOT NULL DEFAULT true,
+    updated_by text NOT NULL DEFAULT '',
+    created timestamptz NOT NULL DEFAULT now(),
+    updated timestamptz NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS remit_partner;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE inputguide ADD COLUMN IF NOT EXISTS refreshed timestamptz NOT NULL DEFAULT now();
+
+CREATE TABLE IF NOT EXISTS inputguide_diff (
+    id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+    partner text NOT NULL,
+    group_name text NOT NULL,
+    added text[] NOT NULL DEFAULT '{}',
+    removed text[] NOT NULL DEFAULT '{}',
+    changed text[] NOT NULL DEFAULT '{}',
+    created timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS inputguide_diff_partner_idx ON inputguide_diff (partner, created);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS inputguide_diff;
+ALTER TABLE inputguide DROP COLUMN IF EXISTS refreshed;
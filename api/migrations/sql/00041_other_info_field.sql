@@ -0,0 +1,24 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS other_info_field (
+    partner text NOT NULL,
+    name text NOT NULL,
+    field_type text NOT NULL DEFAULT '',
+    required boolean NOT NULL DEFAULT false,
+    pattern text NOT NULL DEFAULT '',
+    options text[] NOT NULL DEFAULT '{}',
+    position integer NOT NULL DEFAULT 0,
+    updated_by text NOT NULL DEFAULT '',
+    created timestamptz NOT NULL DEFAULT now(),
+    updated timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (partner, name)
+);
+
+CREATE TRIGGER other_info_field_updated
+    BEFORE UPDATE ON other_info_field
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_timestamp ();
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS other_info_field;
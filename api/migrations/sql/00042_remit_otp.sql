@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS remit_otp (
+    transaction_id text PRIMARY KEY,
+    dsa_id text NOT NULL DEFAULT '',
+    user_id text NOT NULL DEFAULT '',
+    remco_id text NOT NULL DEFAULT '',
+    event_id text NOT NULL DEFAULT '',
+    attempts integer NOT NULL DEFAULT 0,
+    expires timestamptz NOT NULL,
+    verified timestamptz,
+    created timestamptz NOT NULL DEFAULT now(),
+    updated timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TRIGGER remit_otp_updated
+    BEFORE UPDATE ON remit_otp
+    FOR EACH ROW
+    EXECUTE PROCEDURE trigger_set_timestamp ();
+
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS otp_used boolean NOT NULL DEFAULT false;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE remit_history DROP COLUMN IF EXISTS otp_used;
+DROP TABLE IF EXISTS remit_otp;
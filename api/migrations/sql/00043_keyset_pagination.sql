@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS created timestamptz;
+UPDATE remit_history SET created = COALESCE(txn_staged_time, updated) WHERE created IS NULL;
+ALTER TABLE remit_history
+    ALTER COLUMN created SET DEFAULT now(),
+    ALTER COLUMN created SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS remit_history_keyset_idx ON remit_history (dsa_id, created, remit_id);
+CREATE INDEX IF NOT EXISTS cico_history_keyset_idx ON cico_history (org_id, created, id);
+CREATE INDEX IF NOT EXISTS bill_payment_keyset_idx ON bill_payment (org_id, created, bill_payment_id);
+CREATE INDEX IF NOT EXISTS micro_insurance_history_keyset_idx ON micro_insurance_history (org_id, created, id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS micro_insurance_history_keyset_idx;
+DROP INDEX IF EXISTS bill_payment_keyset_idx;
+DROP INDEX IF EXISTS cico_history_keyset_idx;
+DROP INDEX IF EXISTS remit_history_keyset_idx;
+ALTER TABLE remit_history DROP COLUMN IF EXISTS created;
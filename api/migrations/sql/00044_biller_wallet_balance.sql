@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS biller_wallet_balance (
+    id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+    partner text NOT NULL,
+    balance bigint NOT NULL DEFAULT 0,
+    currency text NOT NULL DEFAULT 'PHP',
+    threshold bigint NOT NULL DEFAULT 0,
+    low boolean NOT NULL DEFAULT false,
+    error text NOT NULL DEFAULT '',
+    created timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS biller_wallet_balance_partner_created_idx ON biller_wallet_balance (partner, created DESC);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS biller_wallet_balance;
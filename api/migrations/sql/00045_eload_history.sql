@@ -0,0 +1,27 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS eload_history (
+    id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+    org_id text NOT NULL,
+    user_id text NOT NULL DEFAULT '',
+    partner text NOT NULL DEFAULT '',
+    product_code text NOT NULL DEFAULT '',
+    product_type text NOT NULL DEFAULT '',
+    provider text NOT NULL DEFAULT '',
+    target_mobile_no text NOT NULL DEFAULT '',
+    amount bigint NOT NULL DEFAULT 0, -- minor units
+    currency text NOT NULL DEFAULT 'PHP',
+    reference_number text NOT NULL DEFAULT '',
+    trace_id text NOT NULL DEFAULT '',
+    txn_status text NOT NULL DEFAULT '',
+    error_code text NOT NULL DEFAULT '',
+    error_message text NOT NULL DEFAULT '',
+    trx_date timestamptz NOT NULL DEFAULT NOW(),
+    created timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS eload_history_org_id_trx_date_idx ON eload_history (org_id, trx_date DESC);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS eload_history;
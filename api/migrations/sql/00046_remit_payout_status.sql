@@ -0,0 +1,11 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS payout_status text NOT NULL DEFAULT '';
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS payout_checked timestamptz;
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS paid_out_time timestamptz;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE remit_history DROP COLUMN IF EXISTS paid_out_time;
+ALTER TABLE remit_history DROP COLUMN IF EXISTS payout_checked;
+ALTER TABLE remit_history DROP COLUMN IF EXISTS payout_status;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS customer_enrollment (
+    id uuid NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+    org_id text NOT NULL,
+    user_id text NOT NULL DEFAULT '',
+    partner text NOT NULL DEFAULT '',
+    mobile text NOT NULL DEFAULT '',
+    customer_code text NOT NULL DEFAULT '',
+    wu_card_no text NOT NULL DEFAULT '',
+    status text NOT NULL DEFAULT '',
+    details jsonb NOT NULL DEFAULT '{}',
+    registration jsonb NOT NULL DEFAULT '{}', -- partner registration, cleared once enrolled
+    created timestamptz NOT NULL DEFAULT NOW(),
+    updated timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS customer_enrollment_customer_code_idx ON customer_enrollment (org_id, partner, customer_code) WHERE customer_code <> '';
+CREATE INDEX IF NOT EXISTS customer_enrollment_wu_card_no_idx ON customer_enrollment (org_id, partner, wu_card_no) WHERE wu_card_no <> '';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS customer_enrollment;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE INDEX IF NOT EXISTS remit_to_acc_history_keyset_idx ON remit_to_acc_history (org_id, created, id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS remit_to_acc_history_keyset_idx;
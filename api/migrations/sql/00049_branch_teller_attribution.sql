@@ -0,0 +1,41 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE remit_history ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+ALTER TABLE remittance_history ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE remittance_history ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+ALTER TABLE cico_history ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE cico_history ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+ALTER TABLE bill_payment ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE bill_payment ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+ALTER TABLE remit_to_acc_history ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE remit_to_acc_history ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+ALTER TABLE micro_insurance_history ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE micro_insurance_history ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS remit_history_branch_idx ON remit_history (dsa_id, branch_id) WHERE branch_id <> '';
+CREATE INDEX IF NOT EXISTS cico_history_branch_idx ON cico_history (org_id, branch_id) WHERE branch_id <> '';
+CREATE INDEX IF NOT EXISTS bill_payment_branch_idx ON bill_payment (org_id, branch_id) WHERE branch_id <> '';
+CREATE INDEX IF NOT EXISTS remit_to_acc_history_branch_idx ON remit_to_acc_history (org_id, branch_id) WHERE branch_id <> '';
+CREATE INDEX IF NOT EXISTS micro_insurance_history_branch_idx ON micro_insurance_history (org_id, branch_id) WHERE branch_id <> '';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS micro_insurance_history_branch_idx;
+DROP INDEX IF EXISTS remit_to_acc_history_branch_idx;
+DROP INDEX IF EXISTS bill_payment_branch_idx;
+DROP INDEX IF EXISTS cico_history_branch_idx;
+DROP INDEX IF EXISTS remit_history_branch_idx;
+
+ALTER TABLE micro_insurance_history DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE micro_insurance_history DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE remit_to_acc_history DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE remit_to_acc_history DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE bill_payment DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE bill_payment DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE cico_history DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE cico_history DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE remittance_history DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE remittance_history DROP COLUMN IF EXISTS branch_id;
+ALTER TABLE remit_history DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE remit_history DROP COLUMN IF EXISTS branch_id;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Built-in partners, seeded once so partners deleted by an admin stay deleted.
+INSERT INTO remit_partner (code, name, countries, send_types, disburse_types, enabled, updated_by) VALUES
+('AYA', 'Ayannah', '{PH}', '{"Send":{"code":"SO","description":"Send money transaction.","receiver":true}}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('BPI', 'BPI', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('CEB', 'Cebuana', '{PH}', '{"Send":{"code":"SO","description":"Send money transaction.","receiver":true}}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('CEBINT', 'Cebuana intl', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('IC', 'InstaCash', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('IE', 'IntelExpress', '{PH}', '{"Send":{"code":"SO","description":"Send money transaction.","receiver":true}}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('IR', 'iRemit', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('JPR', 'JapanRemit', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('MB', 'Metrobank', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('PerahubRemit', 'PerahubRemit', '{PH}', '{"Send":{"code":"SO","description":"Send money transaction.","receiver":true}}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('RIA', 'Ria', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('RM', 'Remitly', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('TF', 'Transfast', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('UNT', 'Uniteller', '{PH}', '{}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('USSC', 'USSC', '{PH}', '{"Send":{"code":"SO","description":"Send money transaction.","receiver":true}}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system'),
+('WISE', 'TransferWise', '{PH}', '{"Send":{"code":"SO","description":"Send money transaction.","receiver":true}}', '{}', true, 'system'),
+('WU', 'Western Union', '{PH}', '{"Direct":{"code":"D2B","description":"Send money directly to a bank account.","receiver":true,"bank_account":true},"Mobile":{"code":"MMT","description":"Send money to a mobile number.","receiver":true},"QuickPay":{"code":"QP","description":"Send money to a buiness that accepts Western Union QuickPay.","business":true},"Send":{"code":"SO","description":"Send money to an individual for pick up at any Western Union location.","receiver":true}}', '{"Payout":{"code":"PO","description":"Payout a transaction."}}', true, 'system')
+ON CONFLICT (code) DO NOTHING;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DELETE FROM remit_partner WHERE updated_by = 'system';
@@ -0,0 +1,158 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- Default other info fields, seeded once so fields deleted by an admin stay deleted.
+INSERT INTO other_info_field (partner, name, field_type, required, pattern, options, position, updated_by) VALUES
+('AYA', 'address', '', false, '', '{}', 0, 'system'),
+('AYA', 'city', '', false, '', '{}', 1, 'system'),
+('AYA', 'contact_number', '', false, '', '{}', 2, 'system'),
+('AYA', 'country', '', false, '', '{}', 3, 'system'),
+('AYA', 'creation_date', '', false, '', '{}', 4, 'system'),
+('AYA', 'destination_country', '', false, '', '{}', 5, 'system'),
+('AYA', 'originating_country', '', false, '', '{}', 6, 'system'),
+('AYA', 'receiver_name', '', false, '', '{}', 7, 'system'),
+('AYA', 'reference_number', '', false, '', '{}', 8, 'system'),
+('AYA', 'response_message', '', false, '', '{}', 9, 'system'),
+('AYA', 'sender_name', '', false, '', '{}', 10, 'system'),
+('AYA', 'zip_code', '', false, '', '{}', 11, 'system'),
+('BPI', 'Desc', '', false, '', '{}', 0, 'system'),
+('BPI', 'address', '', false, '', '{}', 1, 'system'),
+('BPI', 'client_reference_no', '', false, '', '{}', 2, 'system'),
+('BPI', 'contact_number', '', false, '', '{}', 3, 'system'),
+('BPI', 'destination_country', '', false, '', '{}', 4, 'system'),
+('BPI', 'originating_country', '', false, '', '{}', 5, 'system'),
+('BPI', 'receiver_name', '', false, '', '{}', 6, 'system'),
+('BPI', 'reference_number', '', false, '', '{}', 7, 'system'),
+('BPI', 'sender_name', '', false, '', '{}', 8, 'system'),
+('CEB', 'beneficiary_id', '', false, '', '{}', 0, 'system'),
+('CEB', 'birth_date', '', false, '', '{}', 1, 'system'),
+('CEB', 'client_reference_no', '', false, '', '{}', 2, 'system'),
+('CEB', 'log_id', '', false, '', '{}', 3, 'system'),
+('CEB', 'message_id', '', false, '', '{}', 4, 'system'),
+('CEB', 'receiver_name', '', false, '', '{}', 5, 'system'),
+('CEB', 'remittance_status_description', '', false, '', '{}', 6, 'system'),
+('CEB', 'remittance_status_id', '', false, '', '{}', 7, 'system'),
+('CEB', 'sender_name', '', false, '', '{}', 8, 'system'),
+('CEB', 'service_charge', '', false, '', '{}', 9, 'system'),
+('CEBINT', 'beneficiary_id', '', false, '', '{}', 0, 'system'),
+('CEBINT', 'birth_date', '', false, '', '{}', 1, 'system'),
+('CEBINT', 'client_reference_no', '', false, '', '{}', 2, 'system'),
+('CEBINT', 'is_domestic', '', false, '', '{}', 3, 'system'),
+('CEBINT', 'log_id', '', false, '', '{}', 4, 'system'),
+('CEBINT', 'message_id', '', false, '', '{}', 5, 'system'),
+('CEBINT', 'receiver_name', '', false, '', '{}', 6, 'system'),
+('CEBINT', 'remittance_status_description', '', false, '', '{}', 7, 'system'),
+('CEBINT', 'remittance_status_id', '', false, '', '{}', 8, 'system'),
+('CEBINT', 'sender_name', '', false, '', '{}', 9, 'system'),
+('CEBINT', 'service_charge', '', false, '', '{}', 10, 'system'),
+('IC', 'destination_country', '', false, '', '{}', 0, 'system'),
+('IC', 'originating_country', '', false, '', '{}', 1, 'system'),
+('IC', 'purpose', '', false, '', '{}', 2, 'system'),
+('IC', 'receiver_name', '', false, '', '{}', 3, 'system'),
+('IC', 'reference_number', '', false, '', '{}', 4, 'system'),
+('IC', 'sender_name', '', false, '', '{}', 5, 'system'),
+('IC', 'status', '', false, '', '{}', 6, 'system'),
+('IE', 'address', '', false, '', '{}', 0, 'system'),
+('IE', 'contact_number', '', false, '', '{}', 1, 'system'),
+('IE', 'country', '', false, '', '{}', 2, 'system'),
+('IE', 'destination_country', '', false, '', '{}', 3, 'system'),
+('IE', 'originating_country', '', false, '', '{}', 4, 'system'),
+('IE', 'receiver_name', '', false, '', '{}', 5, 'system'),
+('IE', 'reference_number', '', false, '', '{}', 6, 'system'),
+('IE', 'sender_name', '', false, '', '{}', 7, 'system'),
+('IE', 'trx_date', '', false, '', '{}', 8, 'system'),
+('IR', 'address', '', false, '', '{}', 0, 'system'),
+('IR', 'contact_number', '', false, '', '{}', 1, 'system'),
+('IR', 'desc', '', false, '', '{}', 2, 'system'),
+('IR', 'receiver_first_name', '', false, '', '{}', 3, 'system'),
+('IR', 'receiver_last_name', '', false, '', '{}', 4, 'system'),
+('IR', 'receiver_name', '', false, '', '{}', 5, 'system'),
+('IR', 'reference_number', '', false, '', '{}', 6, 'system'),
+('IR', 'sender_name', '', false, '', '{}', 7, 'system'),
+('IR', 'transaction_date', '', false, '', '{}', 8, 'system'),
+('JPR', 'currency', '', false, '', '{}', 0, 'system'),
+('JPR', 'destination_country', '', false, '', '{}', 1, 'system'),
+('JPR', 'originating_country', '', false, '', '{}', 2, 'system'),
+('JPR', 'pay_token_id', '', false, '', '{}', 3, 'system'),
+('JPR', 'receiver_name', '', false, '', '{}', 4, 'system'),
+('JPR', 'reference_number', '', false, '', '{}', 5, 'system'),
+('JPR', 'sender_name', '', false, '', '{}', 6, 'system'),
+('MB', 'address', '', false, '', '{}', 0, 'system'),
+('MB', 'contact_number', '', false, '', '{}', 1, 'system'),
+('MB', 'receiver_name', '', false, '', '{}', 2, 'system'),
+('MB', 'reference_number', '', false, '', '{}', 3, 'system'),
+('PerahubRemit', 'address', '', false, '', '{}', 0, 'system'),
+('PerahubRemit', 'city', '', false, '', '{}', 1, 'system'),
+('PerahubRemit', 'contact_number', '', false, '', '{}', 2, 'system'),
+('PerahubRemit', 'country', '', false, '', '{}', 3, 'system'),
+('PerahubRemit', 'creation_date', '', false, '', '{}', 4, 'system'),
+('PerahubRemit', 'destination_country', '', false, '', '{}', 5, 'system'),
+('PerahubRemit', 'formatted_receiver_name', '', false, '', '{}', 6, 'system'),
+('PerahubRemit', 'formatted_sender_name', '', false, '', '{}', 7, 'system'),
+('PerahubRemit', 'originating_country', '', false, '', '{}', 8, 'system'),
+('PerahubRemit', 'receiver_name', '', false, '', '{}', 9, 'system'),
+('PerahubRemit', 'sender_name', '', false, '', '{}', 10, 'system'),
+('PerahubRemit', 'zip_code', '', false, '', '{}', 11, 'system'),
+('RIA', 'client_reference_no', '', false, '', '{}', 0, 'system'),
+('RIA', 'destination_country', '', false, '', '{}', 1, 'system'),
+('RIA', 'is_domestic', '', false, '', '{}', 2, 'system'),
+('RIA', 'order_number', '', false, '', '{}', 3, 'system'),
+('RIA', 'originating_country', '', false, '', '{}', 4, 'system'),
+('RIA', 'receiver_name', '', false, '', '{}', 5, 'system'),
+('RIA', 'sender_name', '', false, '', '{}', 6, 'system'),
+('RM', 'address', '', false, '', '{}', 0, 'system'),
+('RM', 'city', '', false, '', '{}', 1, 'system'),
+('RM', 'contact_number', '', false, '', '{}', 2, 'system'),
+('RM', 'country', '', false, '', '{}', 3, 'system'),
+('RM', 'receiver_name', '', false, '', '{}', 4, 'system'),
+('RM', 'sender_name', '', false, '', '{}', 5, 'system'),
+('TF', 'Desc', '', false, '', '{}', 0, 'system'),
+('TF', 'address', '', false, '', '{}', 1, 'system'),
+('TF', 'contact_number', '', false, '', '{}', 2, 'system'),
+('TF', 'destination_country', '', false, '', '{}', 3, 'system'),
+('TF', 'id_type', '', false, '', '{}', 4, 'system'),
+('TF', 'is_domestic', '', false, '', '{}', 5, 'system'),
+('TF', 'originating_country', '', false, '', '{}', 6, 'system'),
+('TF', 'purpose_of_remittance_id', '', false, '', '{}', 7, 'system'),
+('TF', 'receiver_city_id', '', false, '', '{}', 8, 'system'),
+('TF', 'receiver_city_name', '', false, '', '{}', 9, 'system'),
+('TF', 'receiver_country_iso_code', '', false, '', '{}', 10, 'system'),
+('TF', 'receiver_id_type', '', false, '', '{}', 11, 'system'),
+('TF', 'receiver_is_individual', '', false, '', '{}', 12, 'system'),
+('TF', 'receiver_last_name', '', false, '', '{}', 13, 'system'),
+('TF', 'receiver_name', '', false, '', '{}', 14, 'system'),
+('TF', 'receiver_state_id', '', false, '', '{}', 15, 'system'),
+('TF', 'reference_number', '', false, '', '{}', 16, 'system'),
+('TF', 'sender_name', '', false, '', '{}', 17, 'system'),
+('TF', 'transaction_date', '', false, '', '{}', 18, 'system'),
+('UNT', 'address', '', false, '', '{}', 0, 'system'),
+('UNT', 'city', '', false, '', '{}', 1, 'system'),
+('UNT', 'contact_number', '', false, '', '{}', 2, 'system'),
+('UNT', 'country', '', false, '', '{}', 3, 'system'),
+('UNT', 'creation_date', '', false, '', '{}', 4, 'system'),
+('UNT', 'destination_country', '', false, '', '{}', 5, 'system'),
+('UNT', 'formatted_receiver_name', '', false, '', '{}', 6, 'system'),
+('UNT', 'formatted_sender_name', '', false, '', '{}', 7, 'system'),
+('UNT', 'originating_country', '', false, '', '{}', 8, 'system'),
+('UNT', 'receiver_name', '', false, '', '{}', 9, 'system'),
+('UNT', 'sender_name', '', false, '', '{}', 10, 'system'),
+('UNT', 'zip_code', '', false, '', '{}', 11, 'system'),
+('USSC', 'contact_number', '', false, '', '{}', 0, 'system'),
+('USSC', 'purpose_transaction', '', false, '', '{}', 1, 'system'),
+('USSC', 'receiver_first_name', '', false, '', '{}', 2, 'system'),
+('USSC', 'receiver_last_name', '', false, '', '{}', 3, 'system'),
+('USSC', 'receiver_middle_name', '', false, '', '{}', 4, 'system'),
+('USSC', 'receiver_name', '', false, '', '{}', 5, 'system'),
+('USSC', 'reference_number', '', false, '', '{}', 6, 'system'),
+('USSC', 'relation_to', '', false, '', '{}', 7, 'system'),
+('USSC', 'sender_first_name', '', false, '', '{}', 8, 'system'),
+('USSC', 'sender_last_name', '', false, '', '{}', 9, 'system'),
+('USSC', 'sender_middle_name', '', false, '', '{}', 10, 'system'),
+('USSC', 'sender_name', '', false, '', '{}', 11, 'system'),
+('USSC', 'service_charge', '', false, '', '{}', 12, 'system'),
+('USSC', 'total_amount', '', false, '', '{}', 13, 'system'),
+('USSC', 'trx_date', '', false, '', '{}', 14, 'system')
+ON CONFLICT (partner, name) DO NOTHING;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DELETE FROM other_info_field WHERE updated_by = 'system';
@@ -0,0 +1,10 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE biller_wallet_balance ADD COLUMN IF NOT EXISTS alerted boolean NOT NULL DEFAULT false;
+
+-- low samples recorded so far were alerted when they were taken
+UPDATE biller_wallet_balance SET alerted = true WHERE low;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE biller_wallet_balance DROP COLUMN IF EXISTS alerted;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE eload_history ADD COLUMN IF NOT EXISTS idempotency_key text NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS eload_history_org_id_idempotency_key_idx ON eload_history (org_id, idempotency_key) WHERE idempotency_key <> '';
+CREATE INDEX IF NOT EXISTS eload_history_pending_idx ON eload_history (created) WHERE txn_status = 'PENDING';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS eload_history_pending_idx;
+DROP INDEX IF EXISTS eload_history_org_id_idempotency_key_idx;
+ALTER TABLE eload_history DROP COLUMN IF EXISTS idempotency_key;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE INDEX IF NOT EXISTS remit_history_payout_pending_idx ON remit_history (remco_id, payout_checked NULLS FIRST, txn_completed_time)
+WHERE remit_type = 'SEND'
+AND txn_step = 'CONFIRM'
+AND txn_status = 'SUCCESS'
+AND remco_control_number <> ''
+AND payout_status NOT IN ('PAID', 'CANCELLED');
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS remit_history_payout_pending_idx;
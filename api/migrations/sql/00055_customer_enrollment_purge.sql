@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- registrations are stored encrypted from now on, the pending enrollments
+-- still holding a plaintext registration are dropped and have to be started
+-- again.
+DELETE FROM customer_enrollment WHERE status <> 'ENROLLED';
+UPDATE customer_enrollment SET registration = '{}' WHERE registration <> '{}';
+
+CREATE INDEX IF NOT EXISTS customer_enrollment_pending_idx ON customer_enrollment (created) WHERE status <> 'ENROLLED';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS customer_enrollment_pending_idx;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE remit_idempotency ADD COLUMN IF NOT EXISTS error bytea;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE remit_idempotency DROP COLUMN IF EXISTS error;
@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE eload_history ADD COLUMN IF NOT EXISTS branch_id text NOT NULL DEFAULT '';
+ALTER TABLE eload_history ADD COLUMN IF NOT EXISTS teller_id text NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS eload_history_branch_idx ON eload_history (org_id, branch_id) WHERE branch_id <> '';
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP INDEX IF EXISTS eload_history_branch_idx;
+
+ALTER TABLE eload_history DROP COLUMN IF EXISTS teller_id;
+ALTER TABLE eload_history DROP COLUMN IF EXISTS branch_id;
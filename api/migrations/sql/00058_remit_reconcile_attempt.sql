@@ -0,0 +1,13 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+-- transactions the reconciliation job could not get a partner answer for,
+-- kept apart from remit_history so an attempt does not touch its updated time.
+CREATE TABLE IF NOT EXISTS remit_reconcile_attempt (
+    remit_id uuid NOT NULL PRIMARY KEY,
+    attempts integer NOT NULL DEFAULT 0,
+    attempted timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS remit_reconcile_attempt;
@@ -0,0 +1,59 @@
+package phmw
+
+import (
+	"context"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/serviceutil/auth/hydra"
+	"brank.as/petnet/svcutil/mw/meta"
+
+	bpb "brank.as/petnet/gunk/dsa/v2/branch"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+)
+
+// ConfirmBranch validates the optional branch attribution of the request. A
+// branch must belong to the org of the request, requests without a branch pass
+// through unchanged. Tellers are confirmed by ConfirmTeller.
+func ConfirmBranch(cl bpb.BranchServiceClient) meta.MetaFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		md := metautils.ExtractIncoming(ctx)
+		bid := md.Get(ReqBranchID)
+		if bid == "" {
+			return ctx, nil
+		}
+		if err := is.UUID.Validate(bid); err != nil {
+			return ctx, status.Error(codes.InvalidArgument, "invalid branch id")
+		}
+		res, err := cl.GetBranch(ctx, &bpb.GetBranchRequest{ID: bid})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ctx, status.Error(codes.InvalidArgument, "branch not found")
+			}
+			return ctx, status.Error(codes.Internal, "validating branch")
+		}
+		if res.GetBranch().GetOrgID() != ownerOrgID(ctx) {
+			return ctx, status.Error(codes.PermissionDenied, "branch does not belong to the DSA")
+		}
+		if tb := GetBranchID(ctx); tb != "" && tb != bid {
+			return ctx, status.Error(codes.PermissionDenied, "teller is not assigned to the branch")
+		}
+		return md.Set(BranchID, bid).ToIncoming(ctx), nil
+	}
+}
+
+// ownerOrgID is the org the request is made for, the authenticated DSA on the
+// external API and the org of the user on the internal one.
+func ownerOrgID(ctx context.Context) string {
+	if d := GetDSA(ctx); d != "" {
+		return d
+	}
+	switch GetOrgType(ctx) {
+	case ppb.OrgType_PetNet.String(), ppb.OrgType_DSA.String():
+		return GetDSAOrgID(ctx)
+	}
+	return hydra.OrgID(ctx)
+}
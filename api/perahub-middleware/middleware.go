@@ -2,12 +2,15 @@ package phmw
 
 import (
 	"context"
+	"strings"
 
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/serviceutil/auth/hydra"
 	"brank.as/petnet/svcutil/mw/meta"
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
 	authpb "brank.as/rbac/gunk/v1/authenticate"
 )
 
@@ -37,6 +40,14 @@ const (
 	Partner         = "partner"
 	TransactionType = "transaction-type"
 	DsaCode         = "dsa_code"
+	IdempotencyKey  = "idempotency-key"
+	BranchID        = "branch-id"
+	TellerID        = "teller-id"
+
+	// The branch and teller the client asks to attribute the request to, they
+	// are set as BranchID and TellerID once confirmed.
+	ReqBranchID = "x-branch-id"
+	ReqTellerID = "x-teller-id"
 
 	env    = "environment"
 	apiEnv = "api-environment"
@@ -97,3 +108,30 @@ func GetTransactionTypes(ctx context.Context) string {
 	return metautils.ExtractIncoming(ctx).Get(TransactionType)
 }
 func GetDsaCode(ctx context.Context) string { return metautils.ExtractIncoming(ctx).Get(DsaCode) }
+func GetIdempotencyKey(ctx context.Context) string {
+	return metautils.ExtractIncoming(ctx).Get(IdempotencyKey)
+}
+func GetBranchID(ctx context.Context) string { return metautils.ExtractIncoming(ctx).Get(BranchID) }
+func GetTellerID(ctx context.Context) string { return metautils.ExtractIncoming(ctx).Get(TellerID) }
+
+// IsPetNet reports whether the request is made by PetNet, either through the
+// internal API or with a PetNet service account.
+func IsPetNet(ctx context.Context) bool {
+	return GetOrgType(ctx) == ppb.OrgType_PetNet.String() || GetOrgInfo(ctx) == Petnet
+}
+
+// HeaderMatcher forwards the Idempotency-Key, Branch-Id and Teller-Id HTTP
+// headers to the grpc metadata, other headers are handled by the gateway
+// default matcher.
+func HeaderMatcher(key string) (string, bool) {
+	for h, k := range map[string]string{
+		IdempotencyKey: IdempotencyKey,
+		BranchID:       ReqBranchID,
+		TellerID:       ReqTellerID,
+	} {
+		if strings.EqualFold(key, h) {
+			return k, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
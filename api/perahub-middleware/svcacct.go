@@ -51,8 +51,23 @@ func Reset() meta.MetaFunc {
 			Del(BDay).
 			Del(dsa).
 			Del(DSAOrgID).
+			Del(OrgType).
+			Del(OrgInfo).
 			Del(hydra.ClientIDKey).
 			Del(hydra.OrgIDKey).
+			Del(BranchID).
+			Del(TellerID).
+			ToIncoming(ctx), nil
+	}
+}
+
+// ResetAttribution clears the branch and teller of the request, they are only
+// set by ConfirmTeller and ConfirmBranch.
+func ResetAttribution() meta.MetaFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		return metautils.ExtractIncoming(ctx).
+			Del(BranchID).
+			Del(TellerID).
 			ToIncoming(ctx), nil
 	}
 }
@@ -0,0 +1,57 @@
+package phmw
+
+import (
+	"context"
+
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/svcutil/mw/meta"
+
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+// ConfirmTeller resolves the teller making the request. The teller must be an
+// enabled teller of the org of the request. Its operator ID is forwarded to the
+// partners and its terminal ID, checked to be the org's OTC or digital terminal
+// when the teller is recorded, replaces the org terminal ID when set. Tellers
+// without their own terminal keep using the org's one. Requests without a
+// branch default to the teller's branch.
+func ConfirmTeller(cl tpb.TellerServiceClient) meta.MetaFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		md := metautils.ExtractIncoming(ctx)
+		tid := md.Get(ReqTellerID)
+		if tid == "" {
+			return ctx, nil
+		}
+		if err := is.UUID.Validate(tid); err != nil {
+			return ctx, status.Error(codes.InvalidArgument, "invalid teller id")
+		}
+		res, err := cl.GetTeller(ctx, &tpb.GetTellerRequest{ID: tid})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return ctx, status.Error(codes.InvalidArgument, "teller not found")
+			}
+			return ctx, status.Error(codes.Internal, "validating teller")
+		}
+		t := res.GetTeller()
+		if t.GetOrgID() != ownerOrgID(ctx) {
+			return ctx, status.Error(codes.PermissionDenied, "teller does not belong to the DSA")
+		}
+		if t.GetStatus() != tpb.TellerStatus_Enabled {
+			return ctx, status.Error(codes.PermissionDenied, "teller is disabled")
+		}
+
+		// the branch requested is checked against the teller's by ConfirmBranch
+		md.Set(TellerID, tid).Set(BranchID, t.GetBranchID())
+		if t.GetOperatorID() != "" {
+			md.Set(OperatorID, t.GetOperatorID())
+		}
+		if t.GetTerminalID() != "" {
+			md.Set(TerminalID, t.GetTerminalID())
+		}
+		return md.ToIncoming(ctx), nil
+	}
+}
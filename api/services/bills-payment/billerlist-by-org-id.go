@@ -32,7 +32,10 @@ func (s *Svc) BillsPaymentTransactList(ctx context.Context, req *bp.BillsPayment
 func (s *Svc) BillsPaymentTransactListValidate(ctx context.Context, req *bp.BillsPaymentTransactListRequest) (*bp.BillsPaymentTransactListRequest, error) {
 	required := validation.Required
 	if err := validation.ValidateStruct(req,
-		validation.Field(&req.OrgID, required, is.UUID)); err != nil {
+		validation.Field(&req.OrgID, required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.TellerID, is.UUID),
+	); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	logging.FromContext(ctx).Info("Bills Payment Transact List validation")
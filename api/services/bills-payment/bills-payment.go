@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc"
 
 	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/storage"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pariz/gountries"
 
@@ -55,16 +56,36 @@ type BPStore interface {
 	BPTransactInquire(context.Context, *bp.BPTransactInquireRequest, string) (*bp.BPTransactInquireResponse, error)
 	BPBillerList(context.Context, *bp.BPBillerListRequest, string) (*bp.BPBillerListResponse, error)
 	BillsPaymentTransactList(context.Context, *bp.BillsPaymentTransactListRequest) (*bp.BillsPaymentTransactListResponse, error)
+	BPRetry(context.Context, *bp.BPRetryRequest) (*bp.BPTransactionStatus, error)
+	BPVoid(context.Context, *bp.BPVoidRequest) (*bp.BPTransactionStatus, error)
+	BPSearch(context.Context, *bp.BPSearchRequest) (*bp.BPTransactionStatus, error)
+}
+
+// WalletStore returns the latest biller wallet balances and the balance
+// history in the filter.
+type WalletStore interface {
+	Balances(context.Context, storage.BillerWalletBalanceFilter) ([]storage.BillerWalletBalance, []storage.BillerWalletBalance, error)
 }
 
 type Svc struct {
 	bp.UnimplementedBillspaymentServiceServer
 	bpStore    BPStore
+	wallet     WalletStore
 	validators map[string]Validator
 }
 
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithWalletStore enables the biller wallet balances API.
+func WithWalletStore(w WalletStore) Option {
+	return func(s *Svc) {
+		s.wallet = w
+	}
+}
+
 // New Remit service.
-func New(billspay BPStore, vs []Validator) (*Svc, error) {
+func New(billspay BPStore, vs []Validator, opts ...Option) (*Svc, error) {
 	s := &Svc{
 		validators: make(map[string]Validator, len(vs)),
 		bpStore:    billspay,
@@ -78,6 +99,9 @@ func New(billspay BPStore, vs []Validator) (*Svc, error) {
 		}
 		s.validators[v.Kind()] = v
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return s, nil
 }
 
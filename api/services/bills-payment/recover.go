@@ -0,0 +1,66 @@
+package bills_payment
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/util"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) BPRetry(ctx context.Context, req *bp.BPRetryRequest) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.BillPaymentID, validation.Required, is.UUID),
+		validation.Field(&req.ID, validation.Required),
+		validation.Field(&req.Partner, validation.Required)); err != nil {
+		logging.WithError(err, log).Error("validate request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	res, err := s.bpStore.BPRetry(ctx, req)
+	if err != nil {
+		logging.WithError(err, log).Error("failed to retry bill payment")
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
+
+func (s *Svc) BPVoid(ctx context.Context, req *bp.BPVoidRequest) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.BillPaymentID, validation.Required, is.UUID),
+		validation.Field(&req.Partner, validation.Required)); err != nil {
+		logging.WithError(err, log).Error("validate request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	res, err := s.bpStore.BPVoid(ctx, req)
+	if err != nil {
+		logging.WithError(err, log).Error("failed to void bill payment")
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
+
+func (s *Svc) BPSearch(ctx context.Context, req *bp.BPSearchRequest) (*bp.BPTransactionStatus, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.BillPaymentID, validation.Required, is.UUID),
+		validation.Field(&req.Partner, validation.Required)); err != nil {
+		logging.WithError(err, log).Error("validate request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	res, err := s.bpStore.BPSearch(ctx, req)
+	if err != nil {
+		logging.WithError(err, log).Error("failed to search bill payment")
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package bills_payment
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/api/util"
+	bp "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// BPWalletBalances lists the biller prefund wallet balances recorded by the
+// balance monitor.
+func (s *Svc) BPWalletBalances(ctx context.Context, req *bp.BPWalletBalancesRequest) (*bp.BPWalletBalancesResponse, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, util.HandleServiceErr(status.Error(codes.PermissionDenied, "only PetNet can view biller wallet balances"))
+	}
+	if s.wallet == nil {
+		return nil, util.HandleServiceErr(status.Error(codes.Unavailable, "biller wallet monitor is not enabled"))
+	}
+
+	f := storage.BillerWalletBalanceFilter{
+		Partner: req.GetPartner(),
+		Limit:   int(req.GetLimit()),
+	}
+	if req.GetFrom().IsValid() {
+		f.From = req.GetFrom().AsTime()
+	}
+	if req.GetUntil().IsValid() {
+		f.Until = req.GetUntil().AsTime()
+	}
+	ls, hs, err := s.wallet.Balances(ctx, f)
+	if err != nil {
+		logging.WithError(err, log).Error("listing biller wallet balances")
+		return nil, util.HandleServiceErr(status.Error(codes.Internal, "failed to list biller wallet balances"))
+	}
+
+	res := &bp.BPWalletBalancesResponse{
+		Balances: make([]*bp.BPWalletBalance, 0, len(ls)),
+		History:  make([]*bp.BPWalletBalance, 0, len(hs)),
+	}
+	for _, l := range ls {
+		res.Balances = append(res.Balances, walletBalance(l))
+	}
+	for _, h := range hs {
+		res.History = append(res.History, walletBalance(h))
+	}
+	return res, nil
+}
+
+func walletBalance(b storage.BillerWalletBalance) *bp.BPWalletBalance {
+	return &bp.BPWalletBalance{
+		Partner: b.Partner,
+		Balance: &bp.Amount{
+			Amount:   strconv.FormatInt(b.Balance, 10),
+			Currency: b.Currency,
+		},
+		Threshold: &bp.Amount{
+			Amount:   strconv.FormatInt(b.Threshold, 10),
+			Currency: b.Currency,
+		},
+		Low:     b.Low,
+		Error:   b.Error,
+		Checked: tspb.New(b.Created),
+	}
+}
@@ -32,7 +32,10 @@ func (s *Svc) CICOTransactList(ctx context.Context, req *cbp.CICOTransactListReq
 func (s *Svc) CICOTransactListValidate(ctx context.Context, req *cbp.CICOTransactListRequest) (*cbp.CICOTransactListRequest, error) {
 	required := validation.Required
 	if err := validation.ValidateStruct(req,
-		validation.Field(&req.OrgID, required, is.UUID)); err != nil {
+		validation.Field(&req.OrgID, required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.TellerID, is.UUID),
+	); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 	logging.FromContext(ctx).Info("CICO Transact List validation")
@@ -0,0 +1,36 @@
+package eload
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+)
+
+type ELoadCore interface {
+	ELoadProducts(ctx context.Context, req *epb.ELoadProductsRequest) (*epb.ELoadProductsResponse, error)
+	ELoadValidate(ctx context.Context, req *epb.ELoadValidateRequest) (*epb.ELoadValidateResponse, error)
+	ELoadSell(ctx context.Context, req *epb.ELoadSellRequest) (*epb.ELoadSellResponse, error)
+}
+
+type Svc struct {
+	epb.UnimplementedELoadServiceServer
+	core ELoadCore
+}
+
+func New(core ELoadCore) *Svc {
+	return &Svc{core: core}
+}
+
+// RegisterSvc registers the eload service.
+func (s *Svc) RegisterSvc(srv *grpc.Server) error {
+	epb.RegisterELoadServiceServer(srv, s)
+	return nil
+}
+
+// RegisterGateway registers the eload endpoints.
+func (s *Svc) RegisterGateway(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	return epb.RegisterELoadServiceHandlerFromEndpoint(ctx, mux, endpoint, opts)
+}
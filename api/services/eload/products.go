@@ -0,0 +1,16 @@
+package eload
+
+import (
+	"context"
+
+	"brank.as/petnet/api/util"
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+)
+
+func (s *Svc) ELoadProducts(ctx context.Context, req *epb.ELoadProductsRequest) (*epb.ELoadProductsResponse, error) {
+	res, err := s.core.ELoadProducts(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
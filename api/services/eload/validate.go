@@ -0,0 +1,30 @@
+package eload
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/util"
+	epb "brank.as/petnet/gunk/drp/v1/eload"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) ELoadValidate(ctx context.Context, req *epb.ELoadValidateRequest) (*epb.ELoadValidateResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ProductCode, validation.Required),
+		validation.Field(&req.Amount, validation.Required),
+		validation.Field(&req.TargetMobileNo, validation.Required),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	res, err := s.core.ELoadValidate(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
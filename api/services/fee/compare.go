@@ -0,0 +1,104 @@
+package fee
+
+import (
+	"context"
+
+	"github.com/bojanz/currency"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/util"
+	"brank.as/petnet/serviceutil/logging"
+
+	fpb "brank.as/petnet/gunk/drp/v1/fee"
+	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+)
+
+const defaultRemitType = "Send"
+
+func (s *Svc) CompareQuotes(ctx context.Context, req *fpb.CompareQuotesRequest) (*fpb.CompareQuotesResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Amount, validation.Required, validation.By(func(interface{}) error {
+			a := req.Amount
+			return validation.ValidateStruct(a,
+				validation.Field(&a.Amount, validation.Required, is.Int),
+				validation.Field(&a.SourceCurrency, is.CurrencyCode),
+				validation.Field(&a.DestinationCurrency, validation.Required, is.CurrencyCode),
+				validation.Field(&a.DestinationCountry, validation.Required, is.CountryCode2),
+			)
+		})),
+		validation.Field(&req.Email, is.EmailFormat),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	src := req.Amount.GetSourceCurrency()
+	if src == "" {
+		src = "PHP"
+	}
+	amt, err := currency.NewMinor(req.Amount.GetAmount(), src)
+	if err != nil {
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, "invalid amount"))
+	}
+	rt := req.GetRemitType()
+	if rt == "" {
+		rt = defaultRemitType
+	}
+	want := make(map[string]bool, len(req.GetPartners()))
+	for _, p := range req.GetPartners() {
+		want[p] = true
+	}
+
+	ps, err := s.remit.ListPartners(ctx, "PH")
+	if err != nil {
+		logging.WithError(err, log).Error("listing partners")
+		return nil, util.HandleServiceErr(err)
+	}
+	rs := []core.FeeInquiryReq{}
+	for _, p := range ps {
+		t, ok := p.SendTypes[rt]
+		if !ok || (len(want) > 0 && !want[p.Code]) {
+			continue
+		}
+		rs = append(rs, core.FeeInquiryReq{
+			RemitPartner:      p.Code,
+			RemitType:         t,
+			PrincipalAmount:   amt,
+			DestinationAmount: req.Amount.GetDestinationAmount(),
+			DestCountry:       req.Amount.GetDestinationCountry(),
+			DestCurrency:      req.Amount.GetDestinationCurrency(),
+			Email:             req.GetEmail(),
+		})
+	}
+
+	qs := s.fee.CompareQuotes(ctx, rs)
+	res := &fpb.CompareQuotesResponse{Quotes: make([]*fpb.PartnerQuote, len(qs))}
+	for i, q := range qs {
+		pq := &fpb.PartnerQuote{
+			RemitPartner: q.RemitPartner,
+			RemitType:    q.RemitType,
+		}
+		if q.Err != nil {
+			pq.Error = status.Convert(util.HandleServiceErr(q.Err)).Message()
+			res.Quotes[i] = pq
+			continue
+		}
+		pq.DeliveryMethod = q.DeliveryMethod
+		pq.ExchangeRate = q.ExchangeRate
+		pq.Fee = &tpb.Amount{
+			Amount:   q.Fee.Number(),
+			Currency: q.Fee.CurrencyCode(),
+		}
+		pq.ReceiveAmount = &tpb.Amount{
+			Amount:   q.ReceiveAmount.Number(),
+			Currency: q.ReceiveAmount.CurrencyCode(),
+		}
+		res.Quotes[i] = pq
+	}
+	return res, nil
+}
@@ -15,8 +15,13 @@ import (
 )
 
 type (
-	WUVal   struct{}
-	USSCVal struct{}
+	WUVal           struct{}
+	USSCVal         struct{}
+	CEBVal          struct{}
+	AYAVal          struct{}
+	IEVal           struct{}
+	PerahubRemitVal struct{}
+	WISEVal         struct{}
 )
 
 func (*WUVal) Kind() string {
@@ -27,8 +32,28 @@ func (*USSCVal) Kind() string {
 	return static.USSCCode
 }
 
+func (*CEBVal) Kind() string {
+	return static.CEBCode
+}
+
+func (*AYAVal) Kind() string {
+	return static.AYACode
+}
+
+func (*IEVal) Kind() string {
+	return static.IECode
+}
+
+func (*PerahubRemitVal) Kind() string {
+	return static.PerahubRemit
+}
+
+func (*WISEVal) Kind() string {
+	return static.WISECode
+}
+
 func NewValidators() []Validator {
-	return []Validator{&WUVal{}, &USSCVal{}}
+	return []Validator{&WUVal{}, &USSCVal{}, &CEBVal{}, &AYAVal{}, &IEVal{}, &PerahubRemitVal{}, &WISEVal{}}
 }
 
 type Validator interface {
@@ -39,10 +64,12 @@ type Validator interface {
 type RemcoStore interface {
 	ListPartners(context.Context, string) ([]core.Remco, error)
 	SendRemitType(ctx context.Context, partner, remTyp string, code bool) (*core.SendRemitType, error)
+	PartnerExists(ctx context.Context, partner, country string) bool
 }
 
 type FeeStore interface {
 	FeeInquiry(ctx context.Context, r core.FeeInquiryReq) (map[string]string, error)
+	CompareQuotes(ctx context.Context, rs []core.FeeInquiryReq) []core.PartnerQuote
 }
 
 type Svc struct {
@@ -17,12 +17,15 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	fpb "brank.as/petnet/gunk/drp/v1/fee"
 	pnpb "brank.as/petnet/gunk/drp/v1/partner"
 	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+	dfpb "brank.as/petnet/gunk/dsa/v2/fees"
 )
 
 var _testStorage *postgres.Storage
@@ -205,6 +208,182 @@ func (USSCVal) Test(t *testing.T) {
 	}
 }
 
+func (CEBVal) Test(t *testing.T) {
+	st := newTestStorage(t)
+
+	tests := []struct {
+		desc          string
+		feeInquiryReq *fpb.FeeInquiryRequest
+		want          *fpb.FeeInquiryResponse
+		ptnrErr       bool
+	}{
+		{
+			desc:          "Success",
+			feeInquiryReq: cebFeeInquiryReq,
+			want: &fpb.FeeInquiryResponse{
+				Fees: map[string]string{
+					"principal_amount": "100000",
+					"service_charge":   "100",
+					"total_amount":     "100100",
+				},
+			},
+		},
+		{
+			desc:          "Partner Error",
+			feeInquiryReq: cebFeeInquiryReq,
+			ptnrErr:       true,
+		},
+	}
+
+	uid := uuid.New().String()
+	nmd := metautils.NiceMD(metadata.Pairs(hydra.ClientIDKey, uid, "owner", uid))
+	ctx := nmd.ToIncoming(context.Background())
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			h := newTestSvc(t, st, test.ptnrErr)
+			got, err := h.FeeInquiry(ctx, test.feeInquiryReq)
+			if err := checkError(t, err, test.ptnrErr); err != nil {
+				t.Fatal(err)
+			}
+
+			o := cmp.Options{
+				cmpopts.IgnoreUnexported(
+					fpb.FeeInquiryResponse{},
+				),
+			}
+			if !cmp.Equal(test.want, got, o) {
+				t.Error("(-want +got): ", cmp.Diff(test.want, got, o))
+			}
+		})
+	}
+}
+
+func (AYAVal) Test(t *testing.T)          { testFeeTable(t, static.AYACode) }
+func (IEVal) Test(t *testing.T)           { testFeeTable(t, static.IECode) }
+func (PerahubRemitVal) Test(t *testing.T) { testFeeTable(t, static.PerahubRemit) }
+func (WISEVal) Test(t *testing.T)         { testFeeTable(t, static.WISECode) }
+
+func testFeeTable(t *testing.T, partner string) {
+	st := newTestStorage(t)
+
+	tests := []struct {
+		desc   string
+		amount string
+		want   *fpb.FeeInquiryResponse
+		code   codes.Code
+	}{
+		{
+			desc:   "Success",
+			amount: "100000",
+			want: &fpb.FeeInquiryResponse{
+				Fees: map[string]string{
+					"principal_amount": "100000",
+					"service_charge":   "2500",
+					"total_amount":     "102500",
+				},
+			},
+		},
+		{
+			desc:   "No Fee Configured",
+			amount: "100000000",
+			code:   codes.NotFound,
+		},
+	}
+
+	uid := uuid.New().String()
+	nmd := metautils.NiceMD(metadata.Pairs(hydra.ClientIDKey, uid, hydra.OrgIDKey, uid))
+	ctx := nmd.ToIncoming(context.Background())
+
+	for _, test := range tests {
+		test := test
+		t.Run(partner+" "+test.desc, func(t *testing.T) {
+			h := newTestSvc(t, st, false)
+			got, err := h.FeeInquiry(ctx, &fpb.FeeInquiryRequest{
+				RemitPartner: partner,
+				Amount: &tpb.SendAmount{
+					Amount: test.amount,
+				},
+			})
+			if status.Code(err) != test.code {
+				t.Fatalf("want code %v, got error %v", test.code, err)
+			}
+
+			o := cmp.Options{
+				cmpopts.IgnoreUnexported(
+					fpb.FeeInquiryResponse{},
+				),
+			}
+			if !cmp.Equal(test.want, got, o) {
+				t.Error("(-want +got): ", cmp.Diff(test.want, got, o))
+			}
+		})
+	}
+}
+
+func TestFeeInquiryUnsupported(t *testing.T) {
+	h := New(&fakeRemco{}, fee.New(nil, nil, fee.WithFeeTable(&fakeFees{})), NewValidators())
+	uid := uuid.New().String()
+	ctx := metautils.NiceMD(metadata.Pairs(hydra.OrgIDKey, uid)).ToIncoming(context.Background())
+
+	// payout only partners have neither a fee inquiry nor a fee table
+	if _, err := h.FeeInquiry(ctx, &fpb.FeeInquiryRequest{
+		RemitPartner: static.RIACode,
+		Amount:       &tpb.SendAmount{Amount: "100000"},
+	}); status.Code(err) != codes.Unimplemented {
+		t.Errorf("want code %v, got error %v", codes.Unimplemented, err)
+	}
+
+	// sending partners without a live fee inquiry use the DSA fee table
+	for _, p := range []string{static.AYACode, static.IECode, static.PerahubRemit, static.WISECode} {
+		got, err := h.FeeInquiry(ctx, &fpb.FeeInquiryRequest{
+			RemitPartner: p,
+			Amount:       &tpb.SendAmount{Amount: "100000"},
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", p, err)
+		}
+		if sc := got.GetFees()["service_charge"]; sc != "2500" {
+			t.Errorf("%s: want service charge 2500, got %q", p, sc)
+		}
+	}
+}
+
+// fakeRemco knows every partner.
+type fakeRemco struct {
+	RemcoStore
+}
+
+func (*fakeRemco) PartnerExists(context.Context, string, string) bool { return true }
+
+// fakeFees serves the fee table of every DSA.
+type fakeFees struct {
+	dfpb.OrgFeesServiceClient
+}
+
+func (*fakeFees) ListFees(ctx context.Context, in *dfpb.ListFeesRequest, opts ...grpc.CallOption) (*dfpb.ListFeesResponse, error) {
+	return &dfpb.ListFeesResponse{
+		Fees: []*dfpb.Fee{{
+			OrgID:    in.GetOrgID(),
+			Type:     dfpb.FeeType_TypeFee,
+			Schedule: &dfpb.Schedule{Status: dfpb.FeeStatus_Active},
+			Rates: []*dfpb.Rate{
+				{MinVolume: "1", MaxVolume: "5000", TxnRate: "25"},
+				{MinVolume: "5000.01", MaxVolume: "50000", TxnRate: "50"},
+			},
+		}},
+		Total: 1,
+	}, nil
+}
+
+var cebFeeInquiryReq = &fpb.FeeInquiryRequest{
+	RemitPartner: static.CEBCode,
+	Amount: &tpb.SendAmount{
+		Amount: "100000",
+	},
+}
+
 var wuFeeInquiryReq = &fpb.FeeInquiryRequest{
 	RemitPartner: static.WUCode,
 	RemitType:    "Send",
@@ -284,7 +463,7 @@ func newTestSvc(t *testing.T, store *postgres.Storage, ptnrErr bool) *Svc {
 	}
 
 	st := static.New(ph, store)
-	fc := fee.New(store, ph)
+	fc := fee.New(store, ph, fee.WithFeeTable(&fakeFees{}))
 
 	tvs := NewValidators()
 	h := New(st, fc, tvs)
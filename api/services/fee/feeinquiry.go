@@ -9,7 +9,6 @@ import (
 	"google.golang.org/grpc/status"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/core/static"
 	"brank.as/petnet/api/integration/perahub"
 	"brank.as/petnet/serviceutil/logging"
 
@@ -21,12 +20,16 @@ func (s *Svc) FeeInquiry(ctx context.Context, req *fpb.FeeInquiryRequest) (*fpb.
 	log := logging.FromContext(ctx)
 
 	pn := req.GetRemitPartner()
-	if !static.PartnerExists(pn, "PH") {
+	if !s.remit.PartnerExists(ctx, pn, "PH") {
 		log.Error("partner doesn't exist")
 		return nil, status.Error(codes.NotFound, "partner doesn't exist")
 	}
 
-	r, err := s.validators[pn].FeeInquiryValidate(ctx, s.remit, req)
+	v, ok := s.validators[pn]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "fee inquiry is not supported for the partner")
+	}
+	r, err := v.FeeInquiryValidate(ctx, s.remit, req)
 	if err != nil {
 		log.Error(err)
 		return nil, err
@@ -95,3 +98,53 @@ func (s *USSCVal) FeeInquiryValidate(ctx context.Context, st RemcoStore, req *fp
 	}
 	return res, nil
 }
+
+func (s *CEBVal) FeeInquiryValidate(ctx context.Context, st RemcoStore, req *fpb.FeeInquiryRequest) (*core.FeeInquiryReq, error) {
+	if err := validation.ValidateStruct(req.Amount,
+		validation.Field(&req.Amount.Amount, validation.Required, is.Int),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res := &core.FeeInquiryReq{
+		RemitPartner:    req.RemitPartner,
+		PrincipalAmount: core.MustMinor(req.Amount.Amount, "PHP"),
+		DestCountry:     "PH",
+		DestCurrency:    "PHP",
+	}
+	return res, nil
+}
+
+func (s *AYAVal) FeeInquiryValidate(ctx context.Context, st RemcoStore, req *fpb.FeeInquiryRequest) (*core.FeeInquiryReq, error) {
+	return feeTableValidate(req)
+}
+
+func (s *IEVal) FeeInquiryValidate(ctx context.Context, st RemcoStore, req *fpb.FeeInquiryRequest) (*core.FeeInquiryReq, error) {
+	return feeTableValidate(req)
+}
+
+func (s *PerahubRemitVal) FeeInquiryValidate(ctx context.Context, st RemcoStore, req *fpb.FeeInquiryRequest) (*core.FeeInquiryReq, error) {
+	return feeTableValidate(req)
+}
+
+func (s *WISEVal) FeeInquiryValidate(ctx context.Context, st RemcoStore, req *fpb.FeeInquiryRequest) (*core.FeeInquiryReq, error) {
+	return feeTableValidate(req)
+}
+
+// feeTableValidate validates inquiries answered from the DSA fee tables,
+// which are configured in pesos.
+func feeTableValidate(req *fpb.FeeInquiryRequest) (*core.FeeInquiryReq, error) {
+	if err := validation.ValidateStruct(req.Amount,
+		validation.Field(&req.Amount.Amount, validation.Required, is.Int),
+		validation.Field(&req.Amount.DestinationCurrency, is.CurrencyCode),
+		validation.Field(&req.Amount.DestinationCountry, is.CountryCode2),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res := &core.FeeInquiryReq{
+		RemitPartner:    req.RemitPartner,
+		PrincipalAmount: core.MustMinor(req.Amount.Amount, "PHP"),
+		DestCountry:     req.Amount.DestinationCountry,
+		DestCurrency:    req.Amount.DestinationCurrency,
+	}
+	return res, nil
+}
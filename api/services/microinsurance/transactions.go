@@ -69,6 +69,8 @@ func (s *Svc) GetTransactionList(ctx context.Context, req *migunk.GetTransaction
 		validation.Field(&req.DateFrom, validation.Required, validation.Date("2006-01-02")),
 		validation.Field(&req.DateTo, validation.Required, validation.Date("2006-01-02")),
 		validation.Field(&req.OrgID, validation.Required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.TellerID, is.UUID),
 	)
 	if err != nil {
 		return nil, err
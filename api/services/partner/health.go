@@ -0,0 +1,55 @@
+package partner
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"brank.as/petnet/api/integration/perahub"
+	ppb "brank.as/petnet/gunk/drp/v1/partner"
+)
+
+// PartnerHealth reports the circuit breaker state of the partners called
+// since start, or of the requested partner.
+func (s *Svc) PartnerHealth(ctx context.Context, req *ppb.PartnerHealthRequest) (*ppb.PartnerHealthResponse, error) {
+	res := &ppb.PartnerHealthResponse{}
+	if s.health == nil {
+		return res, nil
+	}
+	for _, h := range s.health.PartnerHealth() {
+		if req.GetPartnerCode() != "" && h.Partner != req.GetPartnerCode() {
+			continue
+		}
+		ph := &ppb.PartnerHealth{
+			PartnerCode: h.Partner,
+			State:       string(h.State),
+			Failures:    int32(h.Failures),
+		}
+		if !h.OpenedAt.IsZero() {
+			ph.OpenedAt = timestamppb.New(h.OpenedAt)
+			ph.RetryAt = timestamppb.New(h.RetryAt)
+		}
+		res.Partners = append(res.Partners, ph)
+	}
+	return res, nil
+}
+
+// flagUnavailable flags the partners whose circuit is open, or removes them
+// if exclude is set. Half open partners are kept so the probe can get through.
+func (s *Svc) flagUnavailable(pt map[string]*ppb.RemitPartner, exclude bool) map[string]*ppb.RemitPartner {
+	if s.health == nil {
+		return pt
+	}
+	for _, h := range s.health.PartnerHealth() {
+		p, ok := pt[h.Partner]
+		if !ok || h.State != perahub.BreakerOpen {
+			continue
+		}
+		if exclude {
+			delete(pt, h.Partner)
+			continue
+		}
+		p.Unavailable = true
+	}
+	return pt
+}
@@ -0,0 +1,59 @@
+package partner
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/integration/perahub"
+	ppb "brank.as/petnet/gunk/drp/v1/partner"
+)
+
+type fakeHealth []perahub.PartnerHealth
+
+func (f fakeHealth) PartnerHealth() []perahub.PartnerHealth { return f }
+
+func TestPartnerHealth(t *testing.T) {
+	opened := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := New(nil, nil, nil, nil, nil, nil, WithHealth(fakeHealth{
+		{Partner: static.CEBCode, State: perahub.BreakerOpen, Failures: 5, OpenedAt: opened, RetryAt: opened.Add(time.Minute)},
+		{Partner: static.USSCCode, State: perahub.BreakerHalfOpen, Failures: 5, OpenedAt: opened, RetryAt: opened},
+		{Partner: static.WUCode, State: perahub.BreakerClosed},
+	}))
+
+	got, err := s.PartnerHealth(context.Background(), &ppb.PartnerHealthRequest{PartnerCode: static.CEBCode})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &ppb.PartnerHealthResponse{Partners: []*ppb.PartnerHealth{{
+		PartnerCode: static.CEBCode,
+		State:       string(perahub.BreakerOpen),
+		Failures:    5,
+		OpenedAt:    timestamppb.New(opened),
+		RetryAt:     timestamppb.New(opened.Add(time.Minute)),
+	}}}
+	if !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Error("(-want +got): ", cmp.Diff(want, got, protocmp.Transform()))
+	}
+
+	list := func() map[string]*ppb.RemitPartner {
+		return map[string]*ppb.RemitPartner{
+			static.CEBCode:  {PartnerCode: static.CEBCode},
+			static.USSCCode: {PartnerCode: static.USSCCode},
+			static.WUCode:   {PartnerCode: static.WUCode},
+		}
+	}
+	flagged := s.flagUnavailable(list(), false)
+	if !flagged[static.CEBCode].Unavailable || flagged[static.USSCCode].Unavailable || flagged[static.WUCode].Unavailable {
+		t.Errorf("want only the open partner flagged, got %v", flagged)
+	}
+	excluded := s.flagUnavailable(list(), true)
+	if _, ok := excluded[static.CEBCode]; ok || len(excluded) != 2 {
+		t.Errorf("want the open partner excluded, got %v", excluded)
+	}
+}
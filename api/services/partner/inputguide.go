@@ -7,11 +7,12 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"brank.as/petnet/api/core"
 	coreerror "brank.as/petnet/api/core/error"
-	"brank.as/petnet/api/core/static"
 	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
 	"brank.as/petnet/api/util"
 	ppb "brank.as/petnet/gunk/drp/v1/partner"
 	"brank.as/petnet/serviceutil/logging"
@@ -20,7 +21,7 @@ import (
 func (s *Svc) InputGuide(ctx context.Context, req *ppb.InputGuideRequest) (*ppb.InputGuideResponse, error) {
 	log := logging.FromContext(ctx)
 	pn := req.GetRemitPartner()
-	if !static.PartnerExists(pn, "PH") {
+	if !s.remit.PartnerExists(ctx, pn, "PH") {
 		log.Error("partner doesn't exist")
 		return nil, util.HandleServiceErr(coreerror.NewCoreError(codes.NotFound, "partner doesn't exist"))
 	}
@@ -56,6 +57,41 @@ func (s *Svc) InputGuide(ctx context.Context, req *ppb.InputGuideRequest) (*ppb.
 	return ig, nil
 }
 
+// RefreshInputGuide fetches the input guide of a partner again.
+func (s *Svc) RefreshInputGuide(ctx context.Context, req *ppb.RefreshInputGuideRequest) (*ppb.RefreshInputGuideResponse, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only PetNet can refresh input guides")
+	}
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.RemitPartner, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ig, ds, err := s.partner.RefreshInputGuide(ctx, req.GetRemitPartner())
+	if err != nil {
+		logging.WithError(err, log).Error("refreshing input guide")
+		if _, ok := err.(*perahub.Error); ok {
+			return nil, util.HandleServiceErr(coreerror.NewCoreError(codes.Internal, "internal error occurred"))
+		}
+		return nil, util.HandleServiceErr(err)
+	}
+	res := &ppb.RefreshInputGuideResponse{
+		RemitPartner: ig.Partner,
+		Refreshed:    timestamppb.New(ig.Refreshed),
+	}
+	for _, d := range ds {
+		res.Changes = append(res.Changes, &ppb.InputGuideChange{
+			Group:   d.Group,
+			Added:   d.Added,
+			Removed: d.Removed,
+			Changed: d.Changed,
+		})
+	}
+	return res, nil
+}
+
 func (*WUVal) InputGuideValidate(ctx context.Context, req *ppb.InputGuideRequest) (*core.InputGuideRequest, error) {
 	if err := validation.ValidateStruct(req,
 		validation.Field(&req.SourceCountry, validation.Required, is.CountryCode2),
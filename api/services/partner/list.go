@@ -59,7 +59,7 @@ func (s *Svc) RemitPartners(ctx context.Context, req *ppb.RemitPartnersRequest)
 		return nil, status.Error(codes.Internal, "listing partners failed")
 	}
 
-	return &ppb.RemitPartnersResponse{Partners: epg}, nil
+	return &ppb.RemitPartnersResponse{Partners: s.flagUnavailable(epg, req.GetExcludeUnavailable())}, nil
 }
 
 func (s *Svc) enabledPartners(ctx context.Context, pt map[string]*ppb.RemitPartner) (map[string]*ppb.RemitPartner, error) {
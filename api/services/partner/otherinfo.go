@@ -0,0 +1,110 @@
+package partner
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/api/util"
+	ppb "brank.as/petnet/gunk/drp/v1/partner"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// ListOtherInfoFields lists the other info fields of a partner.
+func (s *Svc) ListOtherInfoFields(ctx context.Context, req *ppb.ListOtherInfoFieldsRequest) (*ppb.ListOtherInfoFieldsResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.RemitPartner, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	fs, err := s.partner.OtherInfoFields(ctx, req.GetRemitPartner())
+	if err != nil {
+		logging.WithError(err, log).Error("listing other info fields")
+		return nil, util.HandleServiceErr(err)
+	}
+	res := &ppb.ListOtherInfoFieldsResponse{}
+	for _, f := range fs {
+		res.Fields = append(res.Fields, toOtherInfoField(f))
+	}
+	return res, nil
+}
+
+// SetOtherInfoField adds or replaces an other info field of a partner.
+func (s *Svc) SetOtherInfoField(ctx context.Context, req *ppb.SetOtherInfoFieldRequest) (*ppb.OtherInfoField, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only PetNet can manage other info fields")
+	}
+	f := req.GetField()
+	if f == nil {
+		return nil, status.Error(codes.InvalidArgument, "field is required")
+	}
+	if err := validation.ValidateStruct(f,
+		validation.Field(&f.RemitPartner, validation.Required),
+		validation.Field(&f.Name, validation.Required),
+		validation.Field(&f.Type, validation.In(
+			storage.OtherInfoText,
+			storage.OtherInfoNumber,
+			storage.OtherInfoDate,
+			storage.OtherInfoEmail,
+		)),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r, err := s.partner.SetOtherInfoField(ctx, storage.OtherInfoField{
+		Partner:   f.GetRemitPartner(),
+		Name:      f.GetName(),
+		Type:      f.GetType(),
+		Required:  f.GetRequired(),
+		Pattern:   f.GetPattern(),
+		Options:   f.GetOptions(),
+		Position:  int(f.GetPosition()),
+		UpdatedBy: f.GetUpdatedBy(),
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("setting other info field")
+		return nil, util.HandleServiceErr(err)
+	}
+	return toOtherInfoField(*r), nil
+}
+
+// DeleteOtherInfoField removes an other info field of a partner.
+func (s *Svc) DeleteOtherInfoField(ctx context.Context, req *ppb.DeleteOtherInfoFieldRequest) (*emptypb.Empty, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only PetNet can manage other info fields")
+	}
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.RemitPartner, validation.Required),
+		validation.Field(&req.Name, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.partner.DeleteOtherInfoField(ctx, req.GetRemitPartner(), req.GetName()); err != nil {
+		logging.WithError(err, log).Error("deleting other info field")
+		return nil, util.HandleServiceErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func toOtherInfoField(f storage.OtherInfoField) *ppb.OtherInfoField {
+	return &ppb.OtherInfoField{
+		RemitPartner: f.Partner,
+		Name:         f.Name,
+		Type:         f.Type,
+		Required:     f.Required,
+		Pattern:      f.Pattern,
+		Options:      f.Options,
+		Position:     int32(f.Position),
+		UpdatedBy:    f.UpdatedBy,
+		Created:      timestamppb.New(f.Created),
+		Updated:      timestamppb.New(f.Updated),
+	}
+}
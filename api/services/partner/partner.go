@@ -9,6 +9,8 @@ import (
 
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/integration/perahub"
+	"brank.as/petnet/api/storage"
 	"brank.as/petnet/serviceutil/logging"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 
@@ -118,13 +120,28 @@ func NewValidators() []Validator {
 type RemcoStore interface {
 	ListPartners(context.Context, string) ([]core.Remco, error)
 	SendRemitType(ctx context.Context, partner, remTyp string, code bool) (*core.SendRemitType, error)
+	PartnerExists(ctx context.Context, partner, country string) bool
+	RegistryPartners(ctx context.Context) ([]storage.RemitPartner, error)
+	RegistryPartner(ctx context.Context, code string) (*storage.RemitPartner, error)
+	CreateRegistryPartner(ctx context.Context, r storage.RemitPartner) (*storage.RemitPartner, error)
+	UpdateRegistryPartner(ctx context.Context, r storage.RemitPartner) (*storage.RemitPartner, error)
+	DeleteRegistryPartner(ctx context.Context, code string) error
 }
 
 type PartnerStore interface {
 	InputGuide(ctx context.Context, req core.InputGuideRequest) (*ppb.InputGuideResponse, error)
+	RefreshInputGuide(ctx context.Context, partner string) (*storage.InputGuide, []storage.InputGuideDiff, error)
+	OtherInfoFields(ctx context.Context, partner string) ([]storage.OtherInfoField, error)
+	SetOtherInfoField(ctx context.Context, f storage.OtherInfoField) (*storage.OtherInfoField, error)
+	DeleteOtherInfoField(ctx context.Context, partner, name string) error
 	GetPartnersRemco(ctx context.Context) (*ppb.GetPartnersRemcoResponse, error)
 }
 
+// HealthReporter reports the circuit breaker state of the partners.
+type HealthReporter interface {
+	PartnerHealth() []perahub.PartnerHealth
+}
+
 type Svc struct {
 	ppb.UnimplementedRemitPartnerServiceServer
 	remit      RemcoStore
@@ -132,10 +149,22 @@ type Svc struct {
 	cl         pfppb.PartnerServiceClient
 	scl        pfsvc.ServiceServiceClient
 	plcl       pl.PartnerListServiceClient
+	health     HealthReporter
 	validators map[string]Validator
 }
 
-func New(remit RemcoStore, st PartnerStore, cl pfppb.PartnerServiceClient, scl pfsvc.ServiceServiceClient, plcl pl.PartnerListServiceClient, vs []Validator) *Svc {
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithHealth reports the partner health and flags the failing partners when
+// listing partners.
+func WithHealth(h HealthReporter) Option {
+	return func(s *Svc) {
+		s.health = h
+	}
+}
+
+func New(remit RemcoStore, st PartnerStore, cl pfppb.PartnerServiceClient, scl pfsvc.ServiceServiceClient, plcl pl.PartnerListServiceClient, vs []Validator, opts ...Option) *Svc {
 	s := &Svc{
 		validators: make(map[string]Validator, len(vs)),
 		remit:      remit,
@@ -153,6 +182,9 @@ func New(remit RemcoStore, st PartnerStore, cl pfppb.PartnerServiceClient, scl p
 		}
 		s.validators[v.Kind()] = v
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return s
 }
 
@@ -0,0 +1,207 @@
+package partner
+
+import (
+	"context"
+	"encoding/json"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/api/util"
+	ppb "brank.as/petnet/gunk/drp/v1/partner"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// ListRegistryPartners lists the partners of the partner registry.
+func (s *Svc) ListRegistryPartners(ctx context.Context, req *ppb.ListRegistryPartnersRequest) (*ppb.ListRegistryPartnersResponse, error) {
+	log := logging.FromContext(ctx)
+	l, err := s.remit.RegistryPartners(ctx)
+	if err != nil {
+		logging.WithError(err, log).Error("listing registry partners")
+		return nil, util.HandleServiceErr(err)
+	}
+	res := &ppb.ListRegistryPartnersResponse{}
+	for _, r := range l {
+		if req.GetCountry() != "" && !contains(r.Countries, req.GetCountry()) {
+			continue
+		}
+		p, err := toRegistryPartner(r)
+		if err != nil {
+			logging.WithError(err, log).WithField("partner", r.Code).Error("invalid registry partner")
+			continue
+		}
+		res.Partners = append(res.Partners, p)
+	}
+	return res, nil
+}
+
+// GetRegistryPartner returns a partner of the partner registry.
+func (s *Svc) GetRegistryPartner(ctx context.Context, req *ppb.GetRegistryPartnerRequest) (*ppb.RegistryPartner, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.PartnerCode, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	r, err := s.remit.RegistryPartner(ctx, req.GetPartnerCode())
+	if err != nil {
+		logging.WithError(err, log).Error("getting registry partner")
+		return nil, util.HandleServiceErr(err)
+	}
+	return toRegistryPartner(*r)
+}
+
+// CreateRegistryPartner adds a partner to the partner registry.
+func (s *Svc) CreateRegistryPartner(ctx context.Context, req *ppb.CreateRegistryPartnerRequest) (*ppb.RegistryPartner, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only PetNet can manage the partner registry")
+	}
+	r, err := fromRegistryPartner(req.GetPartner())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.remit.CreateRegistryPartner(ctx, *r)
+	if err != nil {
+		logging.WithError(err, log).Error("creating registry partner")
+		return nil, util.HandleServiceErr(err)
+	}
+	return toRegistryPartner(*res)
+}
+
+// UpdateRegistryPartner updates a registered partner.
+func (s *Svc) UpdateRegistryPartner(ctx context.Context, req *ppb.UpdateRegistryPartnerRequest) (*ppb.RegistryPartner, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only PetNet can manage the partner registry")
+	}
+	r, err := fromRegistryPartner(req.GetPartner())
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.remit.UpdateRegistryPartner(ctx, *r)
+	if err != nil {
+		logging.WithError(err, log).Error("updating registry partner")
+		return nil, util.HandleServiceErr(err)
+	}
+	return toRegistryPartner(*res)
+}
+
+// DeleteRegistryPartner removes a partner from the partner registry.
+func (s *Svc) DeleteRegistryPartner(ctx context.Context, req *ppb.DeleteRegistryPartnerRequest) (*emptypb.Empty, error) {
+	log := logging.FromContext(ctx)
+	if !phmw.IsPetNet(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only PetNet can manage the partner registry")
+	}
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.PartnerCode, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.remit.DeleteRegistryPartner(ctx, req.GetPartnerCode()); err != nil {
+		logging.WithError(err, log).Error("deleting registry partner")
+		return nil, util.HandleServiceErr(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func fromRegistryPartner(p *ppb.RegistryPartner) (*storage.RemitPartner, error) {
+	if p == nil {
+		return nil, status.Error(codes.InvalidArgument, "partner is required")
+	}
+	if err := validation.ValidateStruct(p,
+		validation.Field(&p.PartnerCode, validation.Required, validation.Length(1, 20)),
+		validation.Field(&p.PartnerName, validation.Required),
+		validation.Field(&p.Countries, validation.Required, validation.Each(validation.Length(2, 2))),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	types := func(m map[string]*ppb.RegistryRemitType) ([]byte, error) {
+		r := make(map[string]storage.RemitType, len(m))
+		for k, t := range m {
+			if k == "" || t.GetCode() == "" {
+				return nil, status.Error(codes.InvalidArgument, "remit type name and code are required")
+			}
+			r[k] = storage.RemitType{
+				Code:        t.GetCode(),
+				Description: t.GetDescription(),
+				Receiver:    t.GetReceiver(),
+				BankAccount: t.GetBankAccount(),
+				Business:    t.GetBusiness(),
+			}
+		}
+		return json.Marshal(r)
+	}
+	st, err := types(p.GetSendTypes())
+	if err != nil {
+		return nil, err
+	}
+	dt, err := types(p.GetDisburseTypes())
+	if err != nil {
+		return nil, err
+	}
+	return &storage.RemitPartner{
+		Code:          p.GetPartnerCode(),
+		Name:          p.GetPartnerName(),
+		Countries:     p.GetCountries(),
+		SendTypes:     st,
+		DisburseTypes: dt,
+		Enabled:       p.GetEnabled(),
+		UpdatedBy:     p.GetUpdatedBy(),
+	}, nil
+}
+
+func toRegistryPartner(r storage.RemitPartner) (*ppb.RegistryPartner, error) {
+	types := func(b []byte) (map[string]*ppb.RegistryRemitType, error) {
+		var m map[string]storage.RemitType
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &m); err != nil {
+				return nil, status.Error(codes.Internal, "invalid remit types")
+			}
+		}
+		res := make(map[string]*ppb.RegistryRemitType, len(m))
+		for k, t := range m {
+			res[k] = &ppb.RegistryRemitType{
+				Code:        t.Code,
+				Description: t.Description,
+				Receiver:    t.Receiver,
+				BankAccount: t.BankAccount,
+				Business:    t.Business,
+			}
+		}
+		return res, nil
+	}
+	st, err := types(r.SendTypes)
+	if err != nil {
+		return nil, err
+	}
+	dt, err := types(r.DisburseTypes)
+	if err != nil {
+		return nil, err
+	}
+	return &ppb.RegistryPartner{
+		PartnerCode:   r.Code,
+		PartnerName:   r.Name,
+		Countries:     r.Countries,
+		SendTypes:     st,
+		DisburseTypes: dt,
+		Enabled:       r.Enabled,
+		UpdatedBy:     r.UpdatedBy,
+		Created:       timestamppb.New(r.Created),
+		Updated:       timestamppb.New(r.Updated),
+	}, nil
+}
+
+func contains(l []string, v string) bool {
+	for _, s := range l {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -8,7 +8,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"brank.as/petnet/api/core/static"
 	"brank.as/petnet/api/integration/perahub"
 	pnpb "brank.as/petnet/gunk/drp/v1/partner"
 	qpb "brank.as/petnet/gunk/drp/v1/quote"
@@ -19,7 +18,7 @@ func (s *Svc) QuoteRequirements(ctx context.Context, req *qpb.QuoteRequirementsR
 	log := logging.FromContext(ctx)
 	pn := req.GetRemitPartner()
 
-	if !static.PartnerExists(pn, "PH") {
+	if !s.reg.PartnerExists(ctx, pn, "PH") {
 		log.Error("partner doesn't exist")
 		return nil, status.Error(codes.NotFound, "partner doesn't exist")
 	}
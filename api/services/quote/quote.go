@@ -37,16 +37,23 @@ type QuoteStore interface {
 	QuoteRequirements(ctx context.Context, req *qpb.QuoteRequirementsRequest) (*qpb.QuoteRequirementsResponse, error)
 }
 
+// PartnerRegistry reports whether a partner is enabled in a country.
+type PartnerRegistry interface {
+	PartnerExists(ctx context.Context, partner, country string) bool
+}
+
 type Svc struct {
 	qpb.UnimplementedQuoteServiceServer
 	quote      QuoteStore
+	reg        PartnerRegistry
 	validators map[string]Validator
 }
 
-func New(st QuoteStore, vs []Validator) *Svc {
+func New(st QuoteStore, reg PartnerRegistry, vs []Validator) *Svc {
 	s := &Svc{
 		validators: make(map[string]Validator, len(vs)),
 		quote:      st,
+		reg:        reg,
 	}
 	for i, v := range vs {
 		switch {
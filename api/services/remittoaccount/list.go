@@ -0,0 +1,36 @@
+package remittoaccount
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/util"
+	"brank.as/petnet/serviceutil/logging"
+
+	rta "brank.as/petnet/gunk/drp/v1/remittoaccount"
+)
+
+func (s *Svc) RTATransactList(ctx context.Context, req *rta.RTATransactListRequest) (*rta.RTATransactListResponse, error) {
+	log := logging.FromContext(ctx).WithField("method", "service.remittoaccount.RTATransactList")
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgID, validation.Required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.TellerID, is.UUID),
+		validation.Field(&req.From, validation.Date("2006-01-02")),
+		validation.Field(&req.Until, validation.Date("2006-01-02")),
+	); err != nil {
+		logging.WithError(err, log).Error("validate request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.rtaStore.RTATransactList(ctx, req)
+	if err != nil {
+		logging.WithError(err, log).Error("failed to get RTA Transact List")
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
@@ -52,6 +52,7 @@ type RtaStore interface {
 	RTAInquire(context.Context, *rta.RTAInquireRequest, string) (*rta.RTAInquireResponse, error)
 	RTAPayment(context.Context, *rta.RTAPaymentRequest, string) (*rta.RTAPaymentResponse, error)
 	RTARetry(context.Context, *rta.RTARetryRequest, string) (*rta.RTARetryResponse, error)
+	RTATransactList(context.Context, *rta.RTATransactListRequest) (*rta.RTATransactListResponse, error)
 }
 
 type Svc struct {
@@ -0,0 +1,52 @@
+package revenue_commission
+
+import (
+	"context"
+	"time"
+
+	"github.com/bojanz/currency"
+
+	"brank.as/petnet/api/core/commission"
+	revcom_int "brank.as/petnet/api/integration/revenue-commission"
+	"brank.as/petnet/api/storage"
+	rspb "brank.as/petnet/gunk/dsa/v2/revenuesharing"
+)
+
+const reportCurrency = commission.Currency
+
+// dsaCommission returns the commission earned by the DSA on the aggregated
+// transactions of the month starting at from. volume is the monthly
+// transaction count of the service.
+func (s *Svc) dsaCommission(ctx context.Context, orgID string, r storage.ServiceTransactionReport, volume int, from time.Time) (currency.Amount, revcom_int.CommissionType, error) {
+	zero, _ := currency.NewAmount("0", reportCurrency)
+	if s.commission == nil {
+		return zero, "", nil
+	}
+
+	t := commission.Transaction{
+		OrgID:     orgID,
+		Service:   r.Service,
+		Partner:   r.Partner,
+		BoundType: r.BoundType,
+		Count:     r.Count,
+		Amount:    r.Amount,
+		Charges:   r.Charges,
+		Completed: from,
+	}
+	c, err := s.commission.Config(ctx, t)
+	if err != nil {
+		return zero, "", err
+	}
+	res, err := commission.Calculate(c, t, volume)
+	if err != nil {
+		return zero, "", err
+	}
+	if res.SharePercent == "" {
+		return zero, "", nil
+	}
+	typ := revcom_int.CommissionTypePercent
+	if res.SharingTierType == rspb.TierType_TIERPERCENTAGE.String() {
+		typ = revcom_int.CommissionTypeRange
+	}
+	return res.DSA, typ, nil
+}
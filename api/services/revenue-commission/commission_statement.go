@@ -0,0 +1,90 @@
+package revenue_commission
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core/commission"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/api/util"
+	revcom "brank.as/petnet/gunk/drp/v1/revenue-commission"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// GenerateCommissionStatement generates the commission statement of a DSA for
+// a month from the commission ledger.
+func (s *Svc) GenerateCommissionStatement(ctx context.Context, req *revcom.GenerateCommissionStatementRequest) (*revcom.GenerateCommissionStatementResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgID, validation.Required, is.UUID),
+		validation.Field(&req.YearMonth, validation.Required, validation.Date("200601")),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	frm, _ := time.ParseInLocation("200601", req.GetYearMonth(), time.Local)
+
+	pf, err := s.profileService.GetProfile(ctx, &ppb.GetProfileRequest{OrgID: req.GetOrgID()})
+	if err != nil {
+		logging.WithError(err, log).Error("get profile")
+		return nil, err
+	}
+
+	es, err := s.store.ListCommissionLedger(ctx, storage.CommissionFilter{
+		OrgID: req.GetOrgID(),
+		From:  frm,
+		Until: frm.AddDate(0, 1, 0),
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("list commission ledger")
+		return nil, util.HandleServiceErr(err)
+	}
+
+	st, err := commission.NewStatement(pf.GetProfile().GetDsaCode(), pf.GetProfile().GetBusinessInfo().GetCompanyName(), req.GetYearMonth(), es)
+	if err != nil {
+		logging.WithError(err, log).Error("build statement")
+		return nil, status.Error(codes.Internal, "failed to generate commission statement")
+	}
+
+	res := &revcom.GenerateCommissionStatementResponse{
+		TotalGrossFees:     st.Total.GrossFees.Round().Number(),
+		TotalDSACommission: st.Total.DSA.Round().Number(),
+	}
+	name := fmt.Sprintf("commission-statement-%s-%s", pf.GetProfile().GetDsaCode(), req.GetYearMonth())
+	switch req.GetFormat() {
+	case revcom.StatementFormat_StatementFormatPDF:
+		res.FileName, res.ContentType = name+".pdf", "application/pdf"
+		res.Content, err = st.PDF()
+	default:
+		res.FileName, res.ContentType = name+".csv", "text/csv"
+		res.Content, err = st.CSV()
+	}
+	if err != nil {
+		logging.WithError(err, log).Error("render statement")
+		return nil, status.Error(codes.Internal, "failed to generate commission statement")
+	}
+
+	for _, l := range st.Lines {
+		res.Items = append(res.Items, &revcom.CommissionStatementItem{
+			Service:          l.Service,
+			Partner:          l.Partner,
+			BoundType:        l.BoundType,
+			TransactionCount: int32(l.Count),
+			PrincipalAmount:  l.Amount.Round().Number(),
+			GrossFees:        l.GrossFees.Round().Number(),
+			Revenue:          l.Revenue.Round().Number(),
+			PartnerTier:      l.PartnerTier,
+			SharingTier:      l.SharingTier,
+			DSACommission:    l.DSA.Round().Number(),
+			PETNETCommission: l.PETNET.Round().Number(),
+		})
+	}
+	return res, nil
+}
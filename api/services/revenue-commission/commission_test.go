@@ -0,0 +1,91 @@
+package revenue_commission
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"brank.as/petnet/api/core/commission"
+	revcom_int "brank.as/petnet/api/integration/revenue-commission"
+	"brank.as/petnet/api/storage"
+	pcpb "brank.as/petnet/gunk/dsa/v2/partnercommission"
+	rspb "brank.as/petnet/gunk/dsa/v2/revenuesharing"
+)
+
+type fakeCommission commission.Config
+
+func (f *fakeCommission) Config(context.Context, commission.Transaction) (*commission.Config, error) {
+	c := commission.Config(*f)
+	return &c, nil
+}
+
+func TestDSACommission(t *testing.T) {
+	t.Parallel()
+	r := storage.ServiceTransactionReport{Count: 4, Amount: "2000", Charges: "40"}
+	fixed := &pcpb.PartnerCommission{TierType: pcpb.TierType_FIXED, Amount: "2.5"}
+	tests := []struct {
+		desc     string
+		c        iCommissionConfig
+		volume   int
+		want     string
+		wantType revcom_int.CommissionType
+	}{
+		{
+			desc: "No Commission Service",
+			want: "0",
+		},
+		{
+			desc: "Sharing Not Configured",
+			c:    &fakeCommission{Partner: fixed},
+			want: "0",
+		},
+		{
+			desc: "Charges Shared",
+			c: &fakeCommission{
+				Sharing: &rspb.RevenueSharing{TierType: rspb.TierType_PERCENTAGE, Amount: "50"},
+			},
+			want:     "20",
+			wantType: revcom_int.CommissionTypePercent,
+		},
+		{
+			desc: "Partner Commission Shared",
+			c: &fakeCommission{
+				Sharing: &rspb.RevenueSharing{TierType: rspb.TierType_PERCENTAGE, Amount: "50"},
+				Partner: fixed,
+			},
+			want:     "5",
+			wantType: revcom_int.CommissionTypePercent,
+		},
+		{
+			desc: "Volume Tier",
+			c: &fakeCommission{
+				Sharing: &rspb.RevenueSharing{TierType: rspb.TierType_TIERPERCENTAGE},
+				SharingTiers: []*rspb.RevenueSharingTier{
+					{MinValue: "1", MaxValue: "100", Amount: "10"},
+					{MinValue: "101", Amount: "20"},
+				},
+				Partner: fixed,
+			},
+			volume:   500,
+			want:     "2",
+			wantType: revcom_int.CommissionTypeRange,
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			s := &Svc{commission: test.c}
+			got, typ, err := s.dsaCommission(context.Background(), "org", r, test.volume, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Round().String() != test.want+".00 PHP" {
+				t.Errorf("want %s got %s", test.want, got.Round())
+			}
+			if typ != test.wantType {
+				t.Errorf("want type %q got %q", test.wantType, typ)
+			}
+		})
+	}
+}
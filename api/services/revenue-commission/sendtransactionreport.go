@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/bojanz/currency"
+
 	revcom_int "brank.as/petnet/api/integration/revenue-commission"
 	"brank.as/petnet/api/storage"
 	revcom "brank.as/petnet/gunk/drp/v1/revenue-commission"
@@ -19,29 +22,13 @@ import (
 
 // SendTransactionReport ...
 func (s *Svc) SendTransactionReport(ctx context.Context, req *revcom.SendTransactionReportRequest) (*emptypb.Empty, error) {
-	log := logging.FromContext(ctx)
-	dsaList, err := s.dsaStore.ListDSA(ctx)
-	if err != nil {
-		log.WithError(err).Error("failed to get dsa list")
+	if err := s.SyncTransactionReport(ctx); err != nil {
 		return nil, err
 	}
-	for _, dsaDtls := range dsaList {
-		trxCount := s.getTransactionReport(ctx, dsaDtls.DsaCode)
-		err := s.sendDataToPeraHub(ctx, dsaDtls.DsaCode, trxCount)
-		if err != nil {
-			log.WithError(err).Error("failed to send data to perahub")
-			continue
-		}
-		err = s.sendDataToDbProfile(ctx, dsaDtls.DsaCode, trxCount)
-		if err != nil {
-			log.WithError(err).Error("failed to send data to db profile")
-			continue
-		}
-	}
 	return new(emptypb.Empty), nil
 }
 
-// SendTransactionReport ...
+// SyncTransactionReport ...
 func (s *Svc) SyncTransactionReport(ctx context.Context) error {
 	log := logging.FromContext(ctx)
 	dsaList, err := s.dsaStore.ListDSA(ctx)
@@ -50,13 +37,17 @@ func (s *Svc) SyncTransactionReport(ctx context.Context) error {
 		return err
 	}
 	for _, dsaDtls := range dsaList {
-		trxCount := s.getTransactionReport(ctx, dsaDtls.DsaCode)
-		err := s.sendDataToPeraHub(ctx, dsaDtls.DsaCode, trxCount)
+		rpt, err := s.getTransactionReport(ctx, dsaDtls.DsaCode)
+		if err != nil {
+			log.WithError(err).Error("failed to get transaction report")
+			continue
+		}
+		err = s.sendDataToPeraHub(ctx, dsaDtls.DsaCode, rpt)
 		if err != nil {
 			log.WithError(err).Error("failed to send data to perahub")
 			continue
 		}
-		err = s.sendDataToDbProfile(ctx, dsaDtls.DsaCode, trxCount)
+		err = s.sendDataToDbProfile(ctx, dsaDtls.DsaCode, rpt)
 		if err != nil {
 			log.WithError(err).Error("failed to send data to db profile")
 			continue
@@ -65,42 +56,83 @@ func (s *Svc) SyncTransactionReport(ctx context.Context) error {
 	return nil
 }
 
+// transactionReport is the monthly transaction report of a DSA.
+type transactionReport struct {
+	orgID             string
+	remittanceCount   int
+	cicoCount         int
+	billsPaymentCount int
+	insuranceCount    int
+	commission        currency.Amount
+	commissionType    revcom_int.CommissionType
+}
+
 // getTransactionReport ...
-func (s *Svc) getTransactionReport(ctx context.Context, dsaCode string) int32 {
+func (s *Svc) getTransactionReport(ctx context.Context, dsaCode string) (*transactionReport, error) {
 	log := logging.FromContext(ctx).WithField("method", "getTransactionReport")
 	frm, untl := convertYearMonth()
 	pf, err := s.profileService.GetProfileByDsaCode(ctx, &ppb.GetProfileByDsaCodeRequest{DsaCode: dsaCode})
 	if err != nil {
-		log.Error("failed to get profile by dsa code", err)
-		return 0
+		return nil, err
+	}
+	orgID := pf.GetProfile().GetOrgID()
+	if orgID == "" {
+		return nil, errors.New("dsa code not found" + dsaCode)
 	}
 
-	str, err := s.store.GetTransactionReport(ctx, &storage.LRHFilter{
-		DsaID:     pf.GetProfile().GetOrgID(), // should be dsa id
-		TxnStatus: string(storage.SuccessStatus),
-		TxnStep:   string(storage.ConfirmStep),
-		From:      frm,
-		Until:     untl,
+	// untl is the last day of the month, the report runs until the next day
+	untl = untl.AddDate(0, 0, 1)
+	rs, err := s.store.ListServiceTransactionReport(ctx, storage.ServiceReportFilter{
+		OrgID: orgID,
+		From:  frm,
+		Until: untl,
 	})
 	if err != nil {
-		log.Error("failed to get transaction report", err)
-		return 0
+		return nil, err
+	}
+
+	rpt := &transactionReport{
+		orgID:          orgID,
+		commissionType: revcom_int.CommissionTypePercent,
+	}
+	rpt.commission, _ = currency.NewAmount("0", reportCurrency)
+	volume := map[storage.ServiceType]int{}
+	for _, r := range rs {
+		volume[r.Service] += r.Count
 	}
-	return int32(str.RemitTransactionCount)
+	rpt.remittanceCount = volume[storage.RemittanceService]
+	rpt.cicoCount = volume[storage.CashInCashOutService]
+	rpt.billsPaymentCount = volume[storage.BillsPaymentService]
+	rpt.insuranceCount = volume[storage.MicroInsuranceService]
+
+	for _, r := range rs {
+		c, typ, err := s.dsaCommission(ctx, orgID, r, volume[r.Service], frm)
+		if err != nil {
+			log.WithError(err).WithField("service", r.Service).WithField("partner", r.Partner).Error("failed to compute dsa commission")
+			continue
+		}
+		if rpt.commission, err = rpt.commission.Add(c); err != nil {
+			return nil, err
+		}
+		if typ == revcom_int.CommissionTypeRange {
+			rpt.commissionType = typ
+		}
+	}
+	return rpt, nil
 }
 
 // sendDataToPeraHub ...
-func (s *Svc) sendDataToPeraHub(ctx context.Context, dsaCode string, trxCount int32) error {
+func (s *Svc) sendDataToPeraHub(ctx context.Context, dsaCode string, rpt *transactionReport) error {
 	_, err := s.commissionFeeStore.CreateTransactionCount(ctx, &revcom_int.SaveTransactionCountRequest{
 		DsaCode:           dsaCode,
 		YearMonth:         getYearMonth(),
-		RemittanceCount:   json.Number(fmt.Sprintf("%d", trxCount)),
-		CiCoCount:         json.Number("0"), // TODO: Add CashInCashOut Count
-		BillsPaymentCount: json.Number("0"), // TODO: Add BillsPayment Count
-		InsuranceCount:    json.Number("0"), // TODO: Add Insurance Count
+		RemittanceCount:   json.Number(strconv.Itoa(rpt.remittanceCount)),
+		CiCoCount:         json.Number(strconv.Itoa(rpt.cicoCount)),
+		BillsPaymentCount: json.Number(strconv.Itoa(rpt.billsPaymentCount)),
+		InsuranceCount:    json.Number(strconv.Itoa(rpt.insuranceCount)),
 		UpdatedBy:         "Admin",
-		DsaCommission:     json.Number("0"), // TODO: Add Dsa Commission
-		DsaCommissionType: "0",              // TODO: Add Commission Type
+		DsaCommission:     json.Number(rpt.commission.Number()),
+		DsaCommissionType: rpt.commissionType,
 	})
 	if err != nil {
 		return err
@@ -109,25 +141,16 @@ func (s *Svc) sendDataToPeraHub(ctx context.Context, dsaCode string, trxCount in
 }
 
 // sendDataToDbProfile ...
-func (s *Svc) sendDataToDbProfile(ctx context.Context, dsaCode string, trxCount int32) error {
-	profile, err := s.profileService.GetProfileByDsaCode(ctx, &ppb.GetProfileByDsaCodeRequest{DsaCode: dsaCode})
-	if err != nil {
-		return err
-	}
-	orgId := profile.GetProfile().GetOrgID()
-
-	if orgId == "" {
-		return errors.New("dsa code not found" + dsaCode)
-	}
-	_, err = s.revenueReport.CreateRevenueSharingReport(ctx, &rsr.CreateRevenueSharingReportRequest{
-		OrgID:             orgId,
+func (s *Svc) sendDataToDbProfile(ctx context.Context, dsaCode string, rpt *transactionReport) error {
+	_, err := s.revenueReport.CreateRevenueSharingReport(ctx, &rsr.CreateRevenueSharingReportRequest{
+		OrgID:             rpt.orgID,
 		Created:           timestamppb.Now(),
-		RemittanceCount:   trxCount,
-		CicoCount:         0,   // TODO: Add CashInCashOut Count
-		BillsPaymentCount: 0,   // TODO: Add BillsPayment Count
-		InsuranceCount:    0,   // TODO: Add Insurance Count
-		DsaCommission:     "0", // TODO: Add Dsa Commission
-		CommissionType:    "0", // TODO: Add Commission Type
+		RemittanceCount:   int32(rpt.remittanceCount),
+		CicoCount:         int32(rpt.cicoCount),
+		BillsPaymentCount: int32(rpt.billsPaymentCount),
+		InsuranceCount:    int32(rpt.insuranceCount),
+		DsaCommission:     rpt.commission.Number(),
+		CommissionType:    string(rpt.commissionType),
 		DsaCode:           dsaCode,
 		YearMonth:         getYearMonth(),
 	})
@@ -6,6 +6,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 
+	"brank.as/petnet/api/core/commission"
 	revcom_int "brank.as/petnet/api/integration/revenue-commission"
 	"brank.as/petnet/api/storage"
 	"brank.as/petnet/api/storage/postgres"
@@ -51,7 +52,13 @@ type iDSACommissionStore interface {
 
 // iStore contract for DSA store
 type iStore interface {
-	GetTransactionReport(ctx context.Context, pf *storage.LRHFilter) (*storage.RemitHistory, error)
+	ListServiceTransactionReport(ctx context.Context, f storage.ServiceReportFilter) ([]storage.ServiceTransactionReport, error)
+	ListCommissionLedger(ctx context.Context, f storage.CommissionFilter) ([]storage.CommissionLedger, error)
+}
+
+// iCommissionConfig contract for the commission configuration of a DSA
+type iCommissionConfig interface {
+	Config(ctx context.Context, t commission.Transaction) (*commission.Config, error)
 }
 
 // iRevenueReportClient contract for saving revenue sharing report
@@ -68,6 +75,7 @@ type Svc struct {
 	commissionFeeStore iCommissionFeeStore
 	revenueReport      iRevenueReportClient
 	dsaCommissionStore iDSACommissionStore
+	commission         iCommissionConfig
 	revcom.UnimplementedRevenueCommissionServiceServer
 	dsa.UnimplementedDSAServiceServer
 	profileService ppb.OrgProfileServiceClient
@@ -129,6 +137,13 @@ func WithDSACommissionStore(dsaCommStore iDSACommissionStore) Option {
 	}
 }
 
+// WithCommission ...
+func WithCommission(calc *commission.Svc) Option {
+	return func(s *Svc) {
+		s.commission = calc
+	}
+}
+
 // RegisterSvc register the remit service.
 func (s *Svc) RegisterSvc(srv *grpc.Server) error {
 	revcom.RegisterRevenueCommissionServiceServer(srv, s)
@@ -0,0 +1,63 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/serviceutil/logging"
+
+	tpb "brank.as/petnet/gunk/drp/v1/teller"
+	ptpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) GetTeller(ctx context.Context, req *tpb.GetTellerRequest) (*tpb.GetTellerResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	t, err := s.getTeller(ctx, req.GetID())
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetTellerResponse{Teller: toTeller(t)}, nil
+}
+
+func (s *Svc) ListTellers(ctx context.Context, req *tpb.ListTellersRequest) (*tpb.ListTellersResponse, error) {
+	log := logging.FromContext(ctx)
+
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.Status, validation.In(Enabled, Disabled)),
+		validation.Field(&req.Limit, validation.Min(0)),
+		validation.Field(&req.Offset, validation.Min(0)),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.tcl.ListTellers(ctx, &ptpb.ListTellersRequest{
+		OrgID:    phmw.GetDSA(ctx),
+		BranchID: req.GetBranchID(),
+		Status:   fromStatus(req.GetStatus()),
+		Limit:    req.GetLimit(),
+		Offset:   req.GetOffset(),
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("list tellers")
+		return nil, status.Error(codes.Internal, "failed to list tellers")
+	}
+	ts := make([]*tpb.Teller, len(res.GetTellers()))
+	for i, t := range res.GetTellers() {
+		ts[i] = toTeller(t)
+	}
+	return &tpb.ListTellersResponse{
+		Tellers: ts,
+		Total:   res.GetTotal(),
+	}, nil
+}
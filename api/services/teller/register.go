@@ -0,0 +1,58 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/serviceutil/logging"
+
+	tpb "brank.as/petnet/gunk/drp/v1/teller"
+	ptpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) RegisterTeller(ctx context.Context, req *tpb.RegisterTellerRequest) (*tpb.RegisterTellerResponse, error) {
+	log := logging.FromContext(ctx)
+
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Username, validation.Required),
+		validation.Field(&req.Password, validation.Required),
+		validation.Field(&req.FirstName, validation.Required),
+		validation.Field(&req.LastName, validation.Required),
+		validation.Field(&req.Email, validation.Required, is.EmailFormat),
+		validation.Field(&req.BranchID, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res, err := s.tcl.RegisterTeller(ctx, &ptpb.RegisterTellerRequest{
+		Teller: &ptpb.Teller{
+			OrgID:      phmw.GetDSA(ctx),
+			Username:   req.GetUsername(),
+			FirstName:  req.GetFirstName(),
+			MiddleName: req.GetMiddleName(),
+			LastName:   req.GetLastName(),
+			Email:      req.GetEmail(),
+			Phone:      fromPhone(req.GetPhone()),
+			Birthdate:  fromDate(req.GetBirthdate()),
+			BranchID:   req.GetBranchID(),
+			OperatorID: req.GetOperatorID(),
+			TerminalID: req.GetTerminalID(),
+			Status:     ptpb.TellerStatus_Enabled,
+		},
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.AlreadyExists:
+			return nil, err
+		}
+		logging.WithError(err, log).Error("register teller")
+		return nil, status.Error(codes.Internal, "failed to register teller")
+	}
+	return &tpb.RegisterTellerResponse{ID: res.GetID()}, nil
+}
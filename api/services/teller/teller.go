@@ -0,0 +1,140 @@
+package teller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/serviceutil/logging"
+
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	tpb "brank.as/petnet/gunk/drp/v1/teller"
+	ptpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+// Teller statuses as exposed on the API.
+const (
+	Enabled  = "ENABLED"
+	Disabled = "DISABLED"
+)
+
+// Svc manages the tellers of the authenticated DSA. Teller logins are RBAC
+// users of the DSA org, the profile service signs them up along with the
+// teller details it keeps.
+type Svc struct {
+	tpb.UnimplementedTellerServiceServer
+	tcl ptpb.TellerServiceClient
+}
+
+func New(tcl ptpb.TellerServiceClient) *Svc {
+	return &Svc{tcl: tcl}
+}
+
+// RegisterSvc the teller service.
+func (s *Svc) RegisterSvc(srv *grpc.Server) error {
+	tpb.RegisterTellerServiceServer(srv, s)
+	return nil
+}
+
+// RegisterGateway teller endpoints.
+func (s *Svc) RegisterGateway(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) error {
+	return tpb.RegisterTellerServiceHandlerFromEndpoint(ctx, mux, endpoint, opts)
+}
+
+// getTeller returns the teller if it belongs to the authenticated DSA, tellers
+// of other orgs are reported as not found.
+func (s *Svc) getTeller(ctx context.Context, id string) (*ptpb.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	res, err := s.tcl.GetTeller(ctx, &ptpb.GetTellerRequest{ID: id})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "teller not found")
+		}
+		logging.WithError(err, log).Error("get teller")
+		return nil, status.Error(codes.Internal, "failed to get teller")
+	}
+	if res.GetTeller().GetOrgID() != phmw.GetDSA(ctx) {
+		return nil, status.Error(codes.NotFound, "teller not found")
+	}
+	return res.GetTeller(), nil
+}
+
+func toTeller(t *ptpb.Teller) *tpb.Teller {
+	return &tpb.Teller{
+		ID:         t.GetID(),
+		Status:     toStatus(t.GetStatus()),
+		Username:   t.GetUsername(),
+		FirstName:  t.GetFirstName(),
+		MiddleName: t.GetMiddleName(),
+		LastName:   t.GetLastName(),
+		Email:      t.GetEmail(),
+		Phone:      toPhone(t.GetPhone()),
+		Birthdate:  toDate(t.GetBirthdate()),
+		BranchID:   t.GetBranchID(),
+		OperatorID: t.GetOperatorID(),
+		TerminalID: t.GetTerminalID(),
+		Created:    t.GetCreated(),
+	}
+}
+
+func toStatus(s ptpb.TellerStatus) string {
+	switch s {
+	case ptpb.TellerStatus_Enabled:
+		return Enabled
+	case ptpb.TellerStatus_Disabled:
+		return Disabled
+	}
+	return ""
+}
+
+func fromStatus(s string) ptpb.TellerStatus {
+	switch s {
+	case Enabled:
+		return ptpb.TellerStatus_Enabled
+	case Disabled:
+		return ptpb.TellerStatus_Disabled
+	}
+	return ptpb.TellerStatus_UnknownTellerStatus
+}
+
+// Phone numbers are stored as "<country code> <number>".
+func fromPhone(p *ppb.PhoneNumber) string {
+	if p.GetNumber() == "" {
+		return ""
+	}
+	return strings.TrimSpace(p.GetCountryCode() + " " + p.GetNumber())
+}
+
+func toPhone(s string) *ppb.PhoneNumber {
+	if s == "" {
+		return nil
+	}
+	p := strings.SplitN(s, " ", 2)
+	if len(p) == 1 {
+		return &ppb.PhoneNumber{Number: p[0]}
+	}
+	return &ppb.PhoneNumber{CountryCode: p[0], Number: p[1]}
+}
+
+// Birthdates are stored as "YYYY-MM-DD".
+func fromDate(d *ppb.Date) string {
+	if d.GetYear() == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s-%s-%s", d.GetYear(), d.GetMonth(), d.GetDay())
+}
+
+func toDate(s string) *ppb.Date {
+	p := strings.SplitN(s, "-", 3)
+	if len(p) != 3 {
+		return nil
+	}
+	return &ppb.Date{Year: p[0], Month: p[1], Day: p[2]}
+}
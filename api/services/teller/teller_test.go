@@ -0,0 +1,123 @@
+package teller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	tpb "brank.as/petnet/gunk/drp/v1/teller"
+	ptpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+type tellerClient struct {
+	ptpb.TellerServiceClient
+	ts map[string]*ptpb.Teller
+}
+
+func (c *tellerClient) RegisterTeller(_ context.Context, req *ptpb.RegisterTellerRequest, _ ...grpc.CallOption) (*ptpb.RegisterTellerResponse, error) {
+	t := req.GetTeller()
+	t.ID = uuid.NewString()
+	t.UserID = uuid.NewString()
+	c.ts[t.ID] = t
+	return &ptpb.RegisterTellerResponse{ID: t.ID, UserID: t.UserID}, nil
+}
+
+func (c *tellerClient) GetTeller(_ context.Context, req *ptpb.GetTellerRequest, _ ...grpc.CallOption) (*ptpb.GetTellerResponse, error) {
+	t, ok := c.ts[req.GetID()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "teller not found")
+	}
+	return &ptpb.GetTellerResponse{Teller: t}, nil
+}
+
+func (c *tellerClient) SetTellerStatus(_ context.Context, req *ptpb.SetTellerStatusRequest, _ ...grpc.CallOption) (*ptpb.SetTellerStatusResponse, error) {
+	t := c.ts[req.GetID()]
+	t.Status = req.GetStatus()
+	return &ptpb.SetTellerStatusResponse{Teller: t}, nil
+}
+
+func (c *tellerClient) AssignTellerBranch(_ context.Context, req *ptpb.AssignTellerBranchRequest, _ ...grpc.CallOption) (*ptpb.AssignTellerBranchResponse, error) {
+	t := c.ts[req.GetID()]
+	t.BranchID = req.GetBranchID()
+	return &ptpb.AssignTellerBranchResponse{Teller: t}, nil
+}
+
+func dsaCtx(oid string) context.Context {
+	return metautils.NiceMD(metadata.Pairs("owner", oid)).ToIncoming(context.Background())
+}
+
+func TestTeller(t *testing.T) {
+	t.Parallel()
+	oid := uuid.NewString()
+	ctx := dsaCtx(oid)
+
+	tcl := &tellerClient{ts: map[string]*ptpb.Teller{}}
+	s := New(tcl)
+
+	if _, err := s.RegisterTeller(ctx, &tpb.RegisterTellerRequest{
+		Username: "teller",
+		Email:    "not-an-email",
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want invalid argument, got %v", err)
+	}
+
+	res, err := s.RegisterTeller(ctx, &tpb.RegisterTellerRequest{
+		Username:   "teller",
+		Password:   "Pa$$w0rd",
+		FirstName:  "first",
+		LastName:   "last",
+		Email:      "teller@example.com",
+		Phone:      &ppb.PhoneNumber{CountryCode: "63", Number: "9171234567"},
+		Birthdate:  &ppb.Date{Year: "1990", Month: "01", Day: "02"},
+		OperatorID: "OP-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tcl.ts[res.GetID()].GetOrgID(); got != oid {
+		t.Errorf("want teller in the DSA org %q, got %q", oid, got)
+	}
+
+	bid := uuid.NewString()
+	if _, err := s.AssignTellerBranch(ctx, &tpb.AssignTellerBranchRequest{
+		ID:       res.GetID(),
+		BranchID: bid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.DisableTeller(ctx, &tpb.DisableTellerRequest{ID: res.GetID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &tpb.Teller{
+		ID:         res.GetID(),
+		Status:     Disabled,
+		Username:   "teller",
+		FirstName:  "first",
+		LastName:   "last",
+		Email:      "teller@example.com",
+		Phone:      &ppb.PhoneNumber{CountryCode: "63", Number: "9171234567"},
+		Birthdate:  &ppb.Date{Year: "1990", Month: "01", Day: "02"},
+		BranchID:   bid,
+		OperatorID: "OP-1",
+	}
+	o := cmpopts.IgnoreUnexported(tpb.Teller{}, ppb.PhoneNumber{}, ppb.Date{})
+	if !cmp.Equal(want, got.GetTeller(), o) {
+		t.Error("(-want +got): ", cmp.Diff(want, got.GetTeller(), o))
+	}
+
+	if _, err := s.EnableTeller(dsaCtx(uuid.NewString()), &tpb.EnableTellerRequest{
+		ID: res.GetID(),
+	}); status.Code(err) != codes.NotFound {
+		t.Errorf("want not found for another DSA's teller, got %v", err)
+	}
+}
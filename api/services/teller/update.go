@@ -0,0 +1,87 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/serviceutil/logging"
+
+	tpb "brank.as/petnet/gunk/drp/v1/teller"
+	ptpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) EnableTeller(ctx context.Context, req *tpb.EnableTellerRequest) (*tpb.EnableTellerResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	t, err := s.setStatus(ctx, req.GetID(), ptpb.TellerStatus_Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.EnableTellerResponse{Teller: toTeller(t)}, nil
+}
+
+func (s *Svc) DisableTeller(ctx context.Context, req *tpb.DisableTellerRequest) (*tpb.DisableTellerResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	t, err := s.setStatus(ctx, req.GetID(), ptpb.TellerStatus_Disabled)
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.DisableTellerResponse{Teller: toTeller(t)}, nil
+}
+
+func (s *Svc) setStatus(ctx context.Context, id string, st ptpb.TellerStatus) (*ptpb.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	if _, err := s.getTeller(ctx, id); err != nil {
+		return nil, err
+	}
+	res, err := s.tcl.SetTellerStatus(ctx, &ptpb.SetTellerStatusRequest{
+		ID:     id,
+		Status: st,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("set teller status")
+		return nil, status.Error(codes.Internal, "failed to update teller status")
+	}
+	return res.GetTeller(), nil
+}
+
+func (s *Svc) AssignTellerBranch(ctx context.Context, req *tpb.AssignTellerBranchRequest) (*tpb.AssignTellerBranchResponse, error) {
+	log := logging.FromContext(ctx)
+
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, err := s.getTeller(ctx, req.GetID()); err != nil {
+		return nil, err
+	}
+	res, err := s.tcl.AssignTellerBranch(ctx, &ptpb.AssignTellerBranchRequest{
+		ID:       req.GetID(),
+		BranchID: req.GetBranchID(),
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+		logging.WithError(err, log).Error("assign teller branch")
+		return nil, status.Error(codes.Internal, "failed to assign teller branch")
+	}
+	return &tpb.AssignTellerBranchResponse{Teller: toTeller(res.GetTeller())}, nil
+}
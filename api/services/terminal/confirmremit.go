@@ -7,6 +7,7 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"brank.as/petnet/api/core"
 	phmw "brank.as/petnet/api/perahub-middleware"
@@ -17,6 +18,16 @@ import (
 )
 
 func (S *Svc) ConfirmRemit(ctx context.Context, req *tpb.ConfirmRemitRequest) (*tpb.ConfirmRemitResponse, error) {
+	res, err := S.idempotent(ctx, "ConfirmRemit", req, &tpb.ConfirmRemitResponse{}, func() (proto.Message, error) {
+		return S.confirmRemit(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*tpb.ConfirmRemitResponse), nil
+}
+
+func (S *Svc) confirmRemit(ctx context.Context, req *tpb.ConfirmRemitRequest) (*tpb.ConfirmRemitResponse, error) {
 	log := logging.FromContext(ctx)
 
 	pn, err := S.remit.GetPartnerByTxnID(ctx, req.TransactionID)
@@ -32,6 +43,7 @@ func (S *Svc) ConfirmRemit(ctx context.Context, req *tpb.ConfirmRemitRequest) (*
 		logging.WithError(err, log).Error("failed validating request")
 		return nil, util.HandleServiceErr(err)
 	}
+	r.OTP = req.GetOTP()
 	remit, err := S.remit.ProcessRemit(ctx, *r, pn)
 	if err != nil {
 		return nil, util.HandleServiceErr(err)
@@ -10,6 +10,7 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/api/core/static"
@@ -24,6 +25,16 @@ import (
 )
 
 func (s *Svc) CreateRemit(ctx context.Context, req *tpb.CreateRemitRequest) (*tpb.CreateRemitResponse, error) {
+	res, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, func() (proto.Message, error) {
+		return s.createRemit(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*tpb.CreateRemitResponse), nil
+}
+
+func (s *Svc) createRemit(ctx context.Context, req *tpb.CreateRemitRequest) (*tpb.CreateRemitResponse, error) {
 	log := logging.FromContext(ctx)
 	pn := req.GetRemitPartner()
 	orgType := phmw.GetOrgInfo(ctx)
@@ -36,11 +47,17 @@ func (s *Svc) CreateRemit(ctx context.Context, req *tpb.CreateRemitRequest) (*tp
 		return nil, util.HandleServiceErr(err)
 	}
 
+	s.prefillRemitter(ctx, pn, req)
 	r, err := s.validators[pn].CreateRemitValidate(ctx, req, remType)
 	if err != nil {
 		logging.WithError(err, log).Error("validate request")
 		return nil, util.HandleServiceErr(err)
 	}
+	if err := s.validateOtherInfo(ctx, pn, req.GetOtherInfo()); err != nil {
+		logging.WithError(err, log).Error("validate other info")
+		return nil, util.HandleServiceErr(err)
+	}
+	r.OtherInfo = req.GetOtherInfo()
 
 	rmt, err := s.remit.StageCreateRemit(ctx, *r, pn)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"brank.as/petnet/api/core"
 	"brank.as/petnet/api/core/static"
@@ -22,6 +23,16 @@ import (
 const defaultCountry = "ZZ"
 
 func (s *Svc) DisburseRemit(ctx context.Context, req *tpb.DisburseRemitRequest) (*tpb.DisburseRemitResponse, error) {
+	res, err := s.idempotent(ctx, "DisburseRemit", req, &tpb.DisburseRemitResponse{}, func() (proto.Message, error) {
+		return s.disburseRemit(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*tpb.DisburseRemitResponse), nil
+}
+
+func (s *Svc) disburseRemit(ctx context.Context, req *tpb.DisburseRemitRequest) (*tpb.DisburseRemitResponse, error) {
 	log := logging.FromContext(ctx)
 
 	pn := req.GetRemitPartner()
@@ -40,6 +51,10 @@ func (s *Svc) DisburseRemit(ctx context.Context, req *tpb.DisburseRemitRequest)
 	if err != nil {
 		return nil, util.HandleServiceErr(err)
 	}
+	if err := s.validateOtherInfo(ctx, pn, req.GetOtherInfo()); err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	r.OtherInfo = req.GetOtherInfo()
 
 	rmt, err := s.remit.StageDisburseRemit(ctx, *r, pn)
 	if err != nil {
@@ -0,0 +1,105 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+
+	"brank.as/petnet/api/core"
+	coreerror "brank.as/petnet/api/core/error"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// prefillRemitter fills the remitter details missing from the request with
+// the KYC given when the customer was enrolled, so tellers only need the
+// customer code for enrolled customers. Details sent in the request are kept.
+func (s *Svc) prefillRemitter(ctx context.Context, partner string, req *tpb.CreateRemitRequest) {
+	id := req.GetRemitter().GetPartnerMemberID()
+	if id == "" {
+		return
+	}
+	log := logging.FromContext(ctx)
+	kyc, err := s.remit.EnrolledRemitter(ctx, partner, id)
+	if err != nil {
+		// customers not enrolled through the platform send their full details
+		var cErr *coreerror.Error
+		if !errors.As(err, &cErr) || cErr.Code != codes.NotFound {
+			logging.WithError(err, log).Error("getting enrolled remitter")
+		}
+		return
+	}
+	req.Remitter = fillRemitter(req.GetRemitter(), *kyc)
+}
+
+func fillRemitter(r *tpb.UserKYC, k core.UserKYC) *tpb.UserKYC {
+	if r.ContactInfo == nil {
+		r.ContactInfo = &tpb.Contact{}
+	}
+	c := r.ContactInfo
+	str(&c.FirstName, k.FName)
+	str(&c.MiddleName, k.MdName)
+	str(&c.LastName, k.LName)
+	str(&c.Email, k.Email)
+	if c.Address == nil && k.Address != (core.Address{}) {
+		c.Address = &tpb.Address{
+			Address1:   k.Address.Address1,
+			Address2:   k.Address.Address2,
+			City:       k.Address.City,
+			State:      k.Address.State,
+			PostalCode: k.Address.PostalCode,
+			Country:    k.Address.Country,
+			Zone:       k.Address.Zone,
+			Province:   k.Address.Province,
+		}
+	}
+	if c.GetPhone().GetNumber() == "" && k.Phone.Number != "" {
+		c.Phone = &ppb.PhoneNumber{CountryCode: k.Phone.CtyCode, Number: k.Phone.Number}
+	}
+	if c.GetMobile().GetNumber() == "" && k.Mobile.Number != "" {
+		c.Mobile = &ppb.PhoneNumber{CountryCode: k.Mobile.CtyCode, Number: k.Mobile.Number}
+	}
+
+	if r.Employment == nil && k.Employment != (core.Employment{}) {
+		r.Employment = &tpb.Employment{
+			Employer:      k.Employment.Employer,
+			OccupationID:  k.Employment.OccupationID,
+			Occupation:    k.Employment.Occupation,
+			PositionLevel: k.Employment.PositionLevel,
+		}
+	}
+	if r.Birthdate == nil && k.BirthDate != (core.Date{}) {
+		r.Birthdate = &ppb.Date{Year: k.BirthDate.Year, Month: k.BirthDate.Month, Day: k.BirthDate.Day}
+	}
+	if r.Identification == nil && k.PrimaryID.Number != "" {
+		id := k.PrimaryID
+		r.Identification = &ppb.Identification{
+			Type:    id.IDType,
+			Number:  id.Number,
+			Country: id.Country,
+			City:    id.City,
+		}
+		if id.Issued != (core.Date{}) {
+			r.Identification.Issued = &ppb.Date{Year: id.Issued.Year, Month: id.Issued.Month, Day: id.Issued.Day}
+		}
+		if id.Expiry != nil {
+			r.Identification.Expiration = &ppb.Date{Year: id.Expiry.Year, Month: id.Expiry.Month, Day: id.Expiry.Day}
+		}
+	}
+	if r.Gender == tpb.Gender__ {
+		r.Gender = tpb.Gender(tpb.Gender_value[k.Gender])
+	}
+	str(&r.BirthCountry, k.BirthCountry)
+	str(&r.Nationality, k.Nationality)
+	str(&r.SourceFunds, k.SourceFunds)
+	str(&r.Email, k.Email)
+	return r
+}
+
+func str(dst *string, v string) {
+	if *dst == "" {
+		*dst = v
+	}
+}
@@ -0,0 +1,53 @@
+package terminal
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"brank.as/petnet/api/core"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+)
+
+func TestFillRemitter(t *testing.T) {
+	kyc := core.UserKYC{
+		FName:        "Juan",
+		LName:        "Dela Cruz",
+		Gender:       "Male",
+		Email:        "juan@example.com",
+		Mobile:       core.PhoneNumber{CtyCode: "63", Number: "9171234567"},
+		Address:      core.Address{Address1: "1 Rizal St", City: "Manila", Country: "PH"},
+		BirthDate:    core.Date{Year: "1990", Month: "1", Day: "2"},
+		BirthCountry: "PH",
+		Nationality:  "PH",
+		Employment:   core.Employment{Occupation: "Teacher"},
+		PrimaryID:    core.Identification{IDType: "PASSPORT", Number: "P1234", Country: "PH"},
+	}
+	got := fillRemitter(&tpb.UserKYC{
+		PartnerMemberID: "CC1",
+		ContactInfo:     &tpb.Contact{FirstName: "Pedro"},
+		Nationality:     "US",
+	}, kyc)
+	want := &tpb.UserKYC{
+		PartnerMemberID: "CC1",
+		ContactInfo: &tpb.Contact{
+			FirstName: "Pedro",
+			LastName:  "Dela Cruz",
+			Email:     "juan@example.com",
+			Address:   &tpb.Address{Address1: "1 Rizal St", City: "Manila", Country: "PH"},
+			Mobile:    &ppb.PhoneNumber{CountryCode: "63", Number: "9171234567"},
+		},
+		Employment:     &tpb.Employment{Occupation: "Teacher"},
+		Birthdate:      &ppb.Date{Year: "1990", Month: "1", Day: "2"},
+		BirthCountry:   "PH",
+		Gender:         tpb.Gender_Male,
+		Nationality:    "US",
+		Identification: &ppb.Identification{Type: "PASSPORT", Number: "P1234", Country: "PH"},
+		Email:          "juan@example.com",
+	}
+	if !cmp.Equal(want, got, protocmp.Transform()) {
+		t.Error(cmp.Diff(want, got, protocmp.Transform()))
+	}
+}
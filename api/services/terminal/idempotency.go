@@ -0,0 +1,177 @@
+package terminal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/api/util"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const defaultIdempotencyWindow = 24 * time.Hour
+
+type IdempotencyStore interface {
+	CreateRemitIdempotency(context.Context, storage.RemitIdempotency, time.Time) (*storage.RemitIdempotency, error)
+	CompleteRemitIdempotency(context.Context, storage.RemitIdempotency) (*storage.RemitIdempotency, error)
+	GetRemitIdempotency(ctx context.Context, dsaID, key string) (*storage.RemitIdempotency, error)
+	DeleteRemitIdempotency(ctx context.Context, dsaID, key string) error
+}
+
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithIdempotency enables the Idempotency-Key handling for the create, confirm
+// and disburse remit requests. Keys are kept for the given window, after which
+// they can be reused.
+func WithIdempotency(st IdempotencyStore, window time.Duration) Option {
+	return func(s *Svc) {
+		if window <= 0 {
+			window = defaultIdempotencyWindow
+		}
+		s.idem = st
+		s.idemWindow = window
+	}
+}
+
+// idempotent runs f at most once per idempotency key. A repeated request with
+// the same key gets the stored response of the first request decoded into res.
+func (s *Svc) idempotent(ctx context.Context, method string, req, res proto.Message, f func() (proto.Message, error)) (proto.Message, error) {
+	key := phmw.GetIdempotencyKey(ctx)
+	if s.idem == nil || key == "" {
+		return f()
+	}
+
+	// keys are scoped per DSA, a request without one could collide with
+	// another tenant's keys.
+	dsaID := phmw.GetDSA(ctx)
+	if dsaID == "" {
+		return nil, status.Error(codes.Unauthenticated, "missing dsa for idempotency key")
+	}
+	log := logging.FromContext(ctx).WithField("idempotency_key", key)
+	hash, err := requestHash(method, req)
+	if err != nil {
+		logging.WithError(err, log).Error("hashing request")
+		return nil, status.Error(codes.Internal, "processing failed")
+	}
+
+	_, err = s.idem.CreateRemitIdempotency(ctx, storage.RemitIdempotency{
+		DsaID:       dsaID,
+		IdemKey:     key,
+		Method:      method,
+		RequestHash: hash,
+	}, time.Now().Add(-s.idemWindow))
+	switch {
+	case err == storage.Conflict:
+		return s.replay(ctx, dsaID, key, method, hash, res)
+	case err != nil:
+		logging.WithError(err, log).Error("storing idempotency key")
+		return nil, status.Error(codes.Internal, "processing failed")
+	}
+
+	// release the key so the request can be retried, also once the request
+	// context is done.
+	release := func() {
+		if err := s.idem.DeleteRemitIdempotency(util.Detach(ctx), dsaID, key); err != nil {
+			logging.WithError(err, log).Error("releasing idempotency key")
+		}
+	}
+	r, err := f()
+	if err != nil {
+		// only a request refused as invalid can be retried with the same key,
+		// any other failure may have reached the partner and is kept as the
+		// outcome of the key.
+		if status.Code(err) == codes.InvalidArgument {
+			release()
+		} else {
+			s.fail(ctx, dsaID, key, err)
+		}
+		return nil, err
+	}
+
+	// without a stored response the key would stay reserved and every retry
+	// would be aborted until the window expires.
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(r)
+	if err != nil {
+		logging.WithError(err, log).Error("marshaling response")
+		release()
+		return r, nil
+	}
+	if _, err := s.idem.CompleteRemitIdempotency(ctx, storage.RemitIdempotency{
+		DsaID:    dsaID,
+		IdemKey:  key,
+		Response: b,
+	}); err != nil {
+		logging.WithError(err, log).Error("storing idempotency response")
+		release()
+	}
+	return r, nil
+}
+
+// fail stores the error of a request as the outcome of its idempotency key.
+func (s *Svc) fail(ctx context.Context, dsaID, key string, rErr error) {
+	log := logging.FromContext(ctx).WithField("idempotency_key", key)
+	b, err := proto.Marshal(status.Convert(rErr).Proto())
+	if err != nil {
+		logging.WithError(err, log).Error("marshaling error status")
+		return
+	}
+	if _, err := s.idem.CompleteRemitIdempotency(util.Detach(ctx), storage.RemitIdempotency{
+		DsaID:   dsaID,
+		IdemKey: key,
+		Error:   b,
+	}); err != nil {
+		// the key stays reserved without an outcome until the window expires.
+		logging.WithError(err, log).Error("storing idempotency error")
+	}
+}
+
+func (s *Svc) replay(ctx context.Context, dsaID, key, method, hash string, res proto.Message) (proto.Message, error) {
+	log := logging.FromContext(ctx).WithField("idempotency_key", key)
+	ri, err := s.idem.GetRemitIdempotency(ctx, dsaID, key)
+	if err != nil {
+		if err == storage.ErrNotFound {
+			return nil, status.Error(codes.Aborted, "request with this idempotency key is being processed, please retry")
+		}
+		logging.WithError(err, log).Error("getting idempotency key")
+		return nil, status.Error(codes.Internal, "processing failed")
+	}
+	if ri.Method != method || ri.RequestHash != hash {
+		return nil, status.Error(codes.AlreadyExists, "idempotency key already used for a different request")
+	}
+	if len(ri.Error) > 0 {
+		st := &spb.Status{}
+		if err := proto.Unmarshal(ri.Error, st); err != nil {
+			logging.WithError(err, log).Error("unmarshaling stored error")
+			return nil, status.Error(codes.Internal, "processing failed")
+		}
+		return nil, status.ErrorProto(st)
+	}
+	if len(ri.Response) == 0 {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is being processed, please retry")
+	}
+	if err := proto.Unmarshal(ri.Response, res); err != nil {
+		logging.WithError(err, log).Error("unmarshaling stored response")
+		return nil, status.Error(codes.Internal, "processing failed")
+	}
+	return res, nil
+}
+
+func requestHash(method string, req proto.Message) (string, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,209 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	phmw "brank.as/petnet/api/perahub-middleware"
+	"brank.as/petnet/api/storage"
+	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+)
+
+type fakeIdem struct {
+	mu          sync.Mutex
+	keys        map[string]storage.RemitIdempotency
+	completeErr error
+}
+
+func newFakeIdem() *fakeIdem {
+	return &fakeIdem{keys: map[string]storage.RemitIdempotency{}}
+}
+
+func (f *fakeIdem) CreateRemitIdempotency(_ context.Context, ri storage.RemitIdempotency, _ time.Time) (*storage.RemitIdempotency, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	k := ri.DsaID + "/" + ri.IdemKey
+	if _, ok := f.keys[k]; ok {
+		return nil, storage.Conflict
+	}
+	f.keys[k] = ri
+	return &ri, nil
+}
+
+func (f *fakeIdem) CompleteRemitIdempotency(_ context.Context, ri storage.RemitIdempotency) (*storage.RemitIdempotency, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.completeErr != nil {
+		return nil, f.completeErr
+	}
+	k := ri.DsaID + "/" + ri.IdemKey
+	r, ok := f.keys[k]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	r.Response = ri.Response
+	r.Error = ri.Error
+	f.keys[k] = r
+	return &r, nil
+}
+
+func (f *fakeIdem) GetRemitIdempotency(_ context.Context, dsaID, key string) (*storage.RemitIdempotency, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	r, ok := f.keys[dsaID+"/"+key]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return &r, nil
+}
+
+func (f *fakeIdem) DeleteRemitIdempotency(_ context.Context, dsaID, key string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.keys, dsaID+"/"+key)
+	return nil
+}
+
+func idemCtx(dsa, key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"owner", dsa,
+		phmw.IdempotencyKey, key,
+	))
+}
+
+func TestIdempotent(t *testing.T) {
+	t.Parallel()
+	req := &tpb.CreateRemitRequest{OrderID: "order-1"}
+	want := &tpb.CreateRemitResponse{TransactionID: "txn-1"}
+
+	calls := 0
+	create := func() (proto.Message, error) {
+		calls++
+		return want, nil
+	}
+
+	t.Run("Replay", func(t *testing.T) {
+		s := &Svc{}
+		WithIdempotency(newFakeIdem(), 0)(s)
+		ctx := idemCtx("dsa-1", "key-1")
+		calls = 0
+		for i := 0; i < 2; i++ {
+			got, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, create)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(want, got, protocmp.Transform()) {
+				t.Error(cmp.Diff(want, got, protocmp.Transform()))
+			}
+		}
+		if calls != 1 {
+			t.Errorf("want 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("Conflict", func(t *testing.T) {
+		s := &Svc{}
+		WithIdempotency(newFakeIdem(), 0)(s)
+		ctx := idemCtx("dsa-1", "key-1")
+		if _, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, create); err != nil {
+			t.Fatal(err)
+		}
+		other := &tpb.CreateRemitRequest{OrderID: "order-2"}
+		_, err := s.idempotent(ctx, "CreateRemit", other, &tpb.CreateRemitResponse{}, create)
+		if got := status.Code(err); got != codes.AlreadyExists {
+			t.Errorf("want %v, got %v", codes.AlreadyExists, got)
+		}
+	})
+
+	t.Run("InProgress", func(t *testing.T) {
+		st := newFakeIdem()
+		s := &Svc{}
+		WithIdempotency(st, 0)(s)
+		ctx := idemCtx("dsa-1", "key-1")
+		hash, err := requestHash("CreateRemit", req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		st.keys["dsa-1/key-1"] = storage.RemitIdempotency{
+			DsaID:       "dsa-1",
+			IdemKey:     "key-1",
+			Method:      "CreateRemit",
+			RequestHash: hash,
+		}
+		_, err = s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, create)
+		if got := status.Code(err); got != codes.Aborted {
+			t.Errorf("want %v, got %v", codes.Aborted, got)
+		}
+	})
+
+	t.Run("ReleaseOnInvalid", func(t *testing.T) {
+		st := newFakeIdem()
+		s := &Svc{}
+		WithIdempotency(st, 0)(s)
+		ctx := idemCtx("dsa-1", "key-1")
+		_, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, func() (proto.Message, error) {
+			return nil, status.Error(codes.InvalidArgument, "missing receiver")
+		})
+		if got := status.Code(err); got != codes.InvalidArgument {
+			t.Errorf("want %v, got %v", codes.InvalidArgument, got)
+		}
+		if len(st.keys) != 0 {
+			t.Error("key not released")
+		}
+	})
+
+	t.Run("KeepOnError", func(t *testing.T) {
+		st := newFakeIdem()
+		s := &Svc{}
+		WithIdempotency(st, 0)(s)
+		ctx := idemCtx("dsa-1", "key-1")
+		calls = 0
+		fail := func() (proto.Message, error) {
+			calls++
+			return nil, status.Error(codes.Unavailable, "partner down")
+		}
+		for i := 0; i < 2; i++ {
+			_, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, fail)
+			if got := status.Code(err); got != codes.Unavailable {
+				t.Errorf("want %v, got %v", codes.Unavailable, got)
+			}
+		}
+		if calls != 1 {
+			t.Errorf("want 1 call, got %d", calls)
+		}
+	})
+
+	t.Run("ReleaseOnCompleteError", func(t *testing.T) {
+		st := newFakeIdem()
+		st.completeErr = errors.New("db down")
+		s := &Svc{}
+		WithIdempotency(st, 0)(s)
+		ctx := idemCtx("dsa-1", "key-1")
+		if _, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, create); err != nil {
+			t.Fatal(err)
+		}
+		if len(st.keys) != 0 {
+			t.Error("key not released")
+		}
+	})
+
+	t.Run("MissingDSA", func(t *testing.T) {
+		s := &Svc{}
+		WithIdempotency(newFakeIdem(), 0)(s)
+		ctx := idemCtx("", "key-1")
+		_, err := s.idempotent(ctx, "CreateRemit", req, &tpb.CreateRemitResponse{}, create)
+		if got := status.Code(err); got != codes.Unauthenticated {
+			t.Errorf("want %v, got %v", codes.Unauthenticated, got)
+		}
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	tspb "google.golang.org/protobuf/types/known/timestamppb"
@@ -27,6 +28,8 @@ func (s *Svc) ListRemit(ctx context.Context, req *tpb.ListRemitRequest) (*tpb.Li
 		validation.Field(&req.SortOrder, validation.Min(0)),
 		validation.Field(&req.SortByColumn, validation.Min(0)),
 		validation.Field(&req.ControlNumbers),
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.TellerID, is.UUID),
 		validation.Field(&req.From, validation.When(req.From != "", validation.By(func(interface{}) error {
 			var err error
 			ft, err = time.Parse("2006-01-02", req.From)
@@ -57,6 +60,9 @@ func (s *Svc) ListRemit(ctx context.Context, req *tpb.ListRemitRequest) (*tpb.Li
 		ControlNumbers: req.ControlNumbers,
 		ExcludePartner: req.ExcludePartner,
 		ExcludeType:    req.ExcludeType,
+		PageToken:      req.GetPageToken(),
+		BranchID:       req.GetBranchID(),
+		TellerID:       req.GetTellerID(),
 	})
 	if err != nil {
 		switch t := err.(type) {
@@ -146,12 +152,18 @@ func (s *Svc) ListRemit(ctx context.Context, req *tpb.ListRemitRequest) (*tpb.Li
 			},
 			TransactionStagedTime:    tm(t.TxnStagedTime),
 			TransactionCompletedTime: tm(t.TxnCompletedTime),
+			OTPUsed:                  t.OTPUsed,
+			PayoutStatus:             t.PayoutStatus,
+			PaidOutTime:              tm(t.PaidOutTime),
+			BranchID:                 t.BranchID,
+			TellerID:                 t.TellerID,
 		}
 	}
 
 	return &tpb.ListRemitResponse{
-		Next:        req.Offset + int32(len(rmt)),
-		Remittances: rmt,
-		Total:       int32(res.Total),
+		Next:          req.Offset + int32(len(rmt)),
+		Remittances:   rmt,
+		Total:         int32(res.Total),
+		NextPageToken: res.NextPageToken,
 	}, nil
 }
@@ -35,7 +35,7 @@ func (s *Svc) LookupRemit(ctx context.Context, req *tpb.LookupRemitRequest) (*tp
 	}
 
 	// todo(robin): if we will be using other countries change country to dynamic
-	if !static.PartnerExists(pn, "PH") {
+	if !s.lk.PartnerExists(ctx, pn, "PH") {
 		log.Error("partner doesn't exist")
 		return nil, util.HandleServiceErr(status.Error(codes.NotFound, coreerror.MsgPartnerDoesntExist))
 	}
@@ -0,0 +1,40 @@
+package terminal
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+type OtherInfoStore interface {
+	OtherInfoFields(ctx context.Context, partner string) ([]storage.OtherInfoField, error)
+}
+
+// WithOtherInfo enforces the other info fields of the partner on the create
+// and disburse remit requests.
+func WithOtherInfo(st OtherInfoStore) Option {
+	return func(s *Svc) {
+		s.oi = st
+	}
+}
+
+func (s *Svc) validateOtherInfo(ctx context.Context, partner string, oi map[string]string) error {
+	if s.oi == nil {
+		return nil
+	}
+	log := logging.FromContext(ctx)
+	fs, err := s.oi.OtherInfoFields(ctx, partner)
+	if err != nil {
+		logging.WithError(err, log).Error("get other info fields")
+		return status.Error(codes.Internal, "processing failed")
+	}
+	if err := validation.Validate(oi, valOtherInfo(fs)); err != nil {
+		return status.Error(codes.InvalidArgument, validation.Errors{"other_info": err}.Error())
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package terminal
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"brank.as/petnet/api/util"
+	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// RequestRemitOTP sends the OTP required to confirm a staged remittance.
+func (s *Svc) RequestRemitOTP(ctx context.Context, req *tpb.RequestRemitOTPRequest) (*tpb.RequestRemitOTPResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.TransactionID, validation.Required),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	o, err := s.remit.RequestOTP(ctx, req.GetTransactionID())
+	if err != nil {
+		logging.WithError(err, log).Error("requesting remit otp")
+		return nil, util.HandleServiceErr(err)
+	}
+	return &tpb.RequestRemitOTPResponse{
+		TransactionID: o.TxnID,
+		Expires:       tspb.New(o.Expires),
+	}, nil
+}
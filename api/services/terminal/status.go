@@ -0,0 +1,57 @@
+package terminal
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	coreerror "brank.as/petnet/api/core/error"
+	"brank.as/petnet/api/util"
+	tpb "brank.as/petnet/gunk/drp/v1/terminal"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// RemitStatus reports whether a sent remittance has been paid out by the partner.
+func (s *Svc) RemitStatus(ctx context.Context, req *tpb.RemitStatusRequest) (*tpb.RemitStatusResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.RemitPartner, validation.Required),
+		validation.Field(&req.ControlNumber, validation.Required),
+	); err != nil {
+		logging.WithError(err, log).Error("validation error")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+
+	if !s.lk.PartnerExists(ctx, req.GetRemitPartner(), "PH") {
+		log.Error("partner doesn't exist")
+		return nil, util.HandleServiceErr(status.Error(codes.NotFound, coreerror.MsgPartnerDoesntExist))
+	}
+
+	rs, err := s.remit.RemitStatus(ctx, req.GetControlNumber(), req.GetRemitPartner())
+	if err != nil {
+		logging.WithError(err, log).Error("remit status")
+		return nil, util.HandleServiceErr(err)
+	}
+
+	res := &tpb.RemitStatusResponse{
+		RemitPartner:  rs.RemitPartner,
+		ControlNumber: rs.ControlNo,
+		Status:        rs.Status,
+		PartnerStatus: rs.PartnerStatus,
+		SenderName:    rs.SenderName,
+		ReceiverName:  rs.ReceiverName,
+	}
+	if rs.RemitAmount.CurrencyCode() != "" {
+		res.RemitAmount = &tpb.Amount{
+			Amount:   rs.RemitAmount.Number(),
+			Currency: rs.RemitAmount.CurrencyCode(),
+		}
+	}
+	if !rs.FilingTime.IsZero() {
+		res.FilingTime = tspb.New(rs.FilingTime)
+	}
+	return res, nil
+}
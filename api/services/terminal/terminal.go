@@ -3,6 +3,7 @@ package terminal
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -153,13 +154,17 @@ type RemitStore interface {
 	ProcessRemit(context.Context, core.ProcessRemit, string) (*core.ProcessRemit, error)
 	SearchRemit(context.Context, core.SearchRemit, string) (*core.SearchRemit, error)
 	ListRemit(ctx context.Context, f core.FilterList) (*core.SearchRemitResponse, error)
+	RemitStatus(ctx context.Context, controlNo, partner string) (*core.RemitStatus, error)
+	EnrolledRemitter(ctx context.Context, partner, id string) (*core.UserKYC, error)
 	GetPartnerByTxnID(context.Context, string) (string, error)
+	RequestOTP(context.Context, string) (*storage.RemitOTP, error)
 }
 
 type RemitLookup interface {
 	SendRemitType(ctx context.Context, partner, remTyp string, code bool) (*core.SendRemitType, error)
 	DisburseRemitType(ctx context.Context, partner, remTyp string, code bool) (*core.DisburseRemitType, error)
 	GetISO(ctx context.Context, c string) (*storage.ISOCty, error)
+	PartnerExists(ctx context.Context, partner, country string) bool
 }
 
 type Svc struct {
@@ -167,10 +172,13 @@ type Svc struct {
 	remit      RemitStore
 	validators map[string]Validator
 	lk         RemitLookup
+	idem       IdempotencyStore
+	idemWindow time.Duration
+	oi         OtherInfoStore
 }
 
 // New Remit service.
-func New(remit RemitStore, lk RemitLookup, vs []Validator) (*Svc, error) {
+func New(remit RemitStore, lk RemitLookup, vs []Validator, opts ...Option) (*Svc, error) {
 	s := &Svc{
 		validators: make(map[string]Validator, len(vs)),
 		remit:      remit,
@@ -185,6 +193,9 @@ func New(remit RemitStore, lk RemitLookup, vs []Validator) (*Svc, error) {
 		}
 		s.validators[v.Kind()] = v
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	return s, nil
 }
 
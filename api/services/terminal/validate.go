@@ -2,9 +2,11 @@ package terminal
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"brank.as/petnet/api/core"
+	"brank.as/petnet/api/storage"
 	ppb "brank.as/petnet/gunk/drp/v1/profile"
 	tpb "brank.as/petnet/gunk/drp/v1/terminal"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -215,6 +217,40 @@ func valTxn(r *tpb.Transaction, incl ...TxnInclude) validation.Rule {
 	})
 }
 
+// valOtherInfo validates the other info values against the other info fields
+// of the partner.
+func valOtherInfo(fs []storage.OtherInfoField) validation.Rule {
+	return validation.By(func(v interface{}) error {
+		m, _ := v.(map[string]string)
+		errs := validation.Errors{}
+		for _, f := range fs {
+			rs := []validation.Rule{validation.When(f.Required, validation.Required)}
+			switch f.Type {
+			case storage.OtherInfoNumber:
+				rs = append(rs, is.Float)
+			case storage.OtherInfoDate:
+				rs = append(rs, validation.Date("2006-01-02"))
+			case storage.OtherInfoEmail:
+				rs = append(rs, is.EmailFormat)
+			}
+			if f.Pattern != "" {
+				if re, err := regexp.Compile(f.Pattern); err == nil {
+					rs = append(rs, validation.Match(re))
+				}
+			}
+			if len(f.Options) > 0 {
+				opts := make([]interface{}, len(f.Options))
+				for i, o := range f.Options {
+					opts[i] = o
+				}
+				rs = append(rs, validation.In(opts...))
+			}
+			errs[f.Name] = validation.Validate(m[f.Name], rs...)
+		}
+		return errs.Filter()
+	})
+}
+
 func validateDate(r *ppb.Date) validation.Rule {
 	return validation.By(func(interface{}) error {
 		return (core.ToDate(r)).Validate()
@@ -0,0 +1,75 @@
+package terminal
+
+import (
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestValOtherInfo(t *testing.T) {
+	fs := []storage.OtherInfoField{
+		{Name: "purpose", Required: true, Options: []string{"GIFT", "SAVINGS"}},
+		{Name: "amount", Type: storage.OtherInfoNumber},
+		{Name: "birth_date", Type: storage.OtherInfoDate},
+		{Name: "email", Type: storage.OtherInfoEmail},
+		{Name: "ref", Pattern: `^[A-Z]{3}\d+$`},
+	}
+	tests := []struct {
+		desc    string
+		in      map[string]string
+		wantErr []string
+	}{
+		{
+			desc: "Valid",
+			in: map[string]string{
+				"purpose":    "GIFT",
+				"amount":     "10.50",
+				"birth_date": "1990-01-31",
+				"email":      "juan@example.com",
+				"ref":        "ABC123",
+			},
+		},
+		{
+			desc: "Only Required",
+			in:   map[string]string{"purpose": "SAVINGS"},
+		},
+		{
+			desc:    "Missing Required",
+			wantErr: []string{"purpose"},
+		},
+		{
+			desc: "Invalid",
+			in: map[string]string{
+				"purpose":    "LOAN",
+				"amount":     "ten",
+				"birth_date": "31/01/1990",
+				"email":      "juan",
+				"ref":        "abc",
+			},
+			wantErr: []string{"purpose", "amount", "birth_date", "email", "ref"},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			err := validation.Validate(test.in, valOtherInfo(fs))
+			if len(test.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("valOtherInfo() = got error %v, want nil", err)
+				}
+				return
+			}
+			errs, ok := err.(validation.Errors)
+			if !ok || len(errs) != len(test.wantErr) {
+				t.Fatalf("valOtherInfo() = got error %v, want errors for %v", err, test.wantErr)
+			}
+			for _, f := range test.wantErr {
+				if errs[f] == nil {
+					t.Errorf("valOtherInfo() = missing error for %s", f)
+				}
+			}
+		})
+	}
+}
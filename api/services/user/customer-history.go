@@ -0,0 +1,35 @@
+package user
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core/static"
+	cu "brank.as/petnet/api/core/user"
+	"brank.as/petnet/api/util"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) CustomerHistory(ctx context.Context, req *ppb.CustomerHistoryRequest) (*ppb.CustomerHistoryResponse, error) {
+	log := logging.FromContext(ctx)
+	ub := req.GetPartner() == cu.UBCode
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Partner, validation.Required, validation.In(static.WUCode, cu.UBCode)),
+		validation.Field(&req.CustomerID, validation.Required),
+		validation.Field(&req.OperatorCode, validation.When(ub, validation.Required)),
+		validation.Field(&req.LocationCode, validation.When(ub, validation.Required)),
+		validation.Field(&req.SessionID, validation.When(ub, validation.Required)),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	res, err := s.user.CustomerHistory(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
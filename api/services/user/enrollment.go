@@ -0,0 +1,119 @@
+package user
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/api/core/static"
+	"brank.as/petnet/api/util"
+	ppb "brank.as/petnet/gunk/drp/v1/profile"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+func (s *Svc) StartEnrollment(ctx context.Context, req *ppb.StartEnrollmentRequest) (*ppb.StartEnrollmentResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Partner, validation.Required, validation.In(static.WUCode)),
+		validation.Field(&req.Customer, validation.Required, validation.By(func(interface{}) error {
+			return validateCustomer(req.Customer)
+		})),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	res, err := s.user.StartEnrollment(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
+
+func (s *Svc) ConfirmEnrollment(ctx context.Context, req *ppb.ConfirmEnrollmentRequest) (*ppb.ConfirmEnrollmentResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Partner, validation.Required, validation.In(static.WUCode)),
+		validation.Field(&req.EnrollmentID, validation.Required, is.UUID),
+		validation.Field(&req.OTP, validation.Required, is.Digit),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	res, err := s.user.ConfirmEnrollment(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
+
+func (s *Svc) UpdateCustomer(ctx context.Context, req *ppb.UpdateCustomerRequest) (*ppb.UpdateCustomerResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Partner, validation.Required, validation.In(static.WUCode)),
+		validation.Field(&req.CustomerCode, validation.Required, is.Alphanumeric),
+		validation.Field(&req.Customer, validation.Required, validation.By(func(interface{}) error {
+			return validateCustomer(req.Customer)
+		})),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	res, err := s.user.UpdateCustomer(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
+
+func (s *Svc) LookupCustomer(ctx context.Context, req *ppb.LookupCustomerRequest) (*ppb.LookupCustomerResponse, error) {
+	log := logging.FromContext(ctx)
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Partner, validation.Required, validation.In(static.WUCode)),
+		validation.Field(&req.CustomerCode, validation.Required, is.Alphanumeric),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		return nil, util.HandleServiceErr(status.Error(codes.InvalidArgument, err.Error()))
+	}
+	res, err := s.user.LookupCustomer(ctx, req)
+	if err != nil {
+		return nil, util.HandleServiceErr(err)
+	}
+	return res, nil
+}
+
+func validateCustomer(c *ppb.Customer) error {
+	return validation.ValidateStruct(c,
+		validation.Field(&c.FirstName, validation.Required),
+		validation.Field(&c.LastName, validation.Required),
+		validation.Field(&c.BirthDate, validation.Required),
+		validation.Field(&c.Nationality, validation.Required, is.CountryCode2),
+		validation.Field(&c.BirthCountry, is.CountryCode2),
+		validation.Field(&c.Email, is.EmailFormat),
+		validation.Field(&c.Mobile, validation.Required, validation.By(func(interface{}) error {
+			m := c.Mobile
+			return validation.ValidateStruct(m,
+				validation.Field(&m.Number, validation.Required, is.Digit),
+			)
+		})),
+		validation.Field(&c.PresentAddress, validation.Required, validation.By(func(interface{}) error {
+			a := c.PresentAddress
+			return validation.ValidateStruct(a,
+				validation.Field(&a.Address1, validation.Required),
+				validation.Field(&a.City, validation.Required),
+				validation.Field(&a.Country, validation.Required, is.CountryCode2),
+			)
+		})),
+		validation.Field(&c.Identification, validation.Required, validation.By(func(interface{}) error {
+			id := c.Identification
+			return validation.ValidateStruct(id,
+				validation.Field(&id.Type, validation.Required),
+				validation.Field(&id.Number, validation.Required),
+				validation.Field(&id.Country, validation.Required, is.CountryCode2),
+			)
+		})),
+		validation.Field(&c.SecurityQuestions, validation.Length(0, 3)),
+	)
+}
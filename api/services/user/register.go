@@ -10,7 +10,6 @@ import (
 	"google.golang.org/grpc/status"
 
 	"brank.as/petnet/api/core"
-	"brank.as/petnet/api/core/static"
 	"brank.as/petnet/api/integration/perahub"
 	pnpb "brank.as/petnet/gunk/drp/v1/partner"
 	ppb "brank.as/petnet/gunk/drp/v1/profile"
@@ -21,7 +20,7 @@ func (s *Svc) RegisterUser(ctx context.Context, req *ppb.RegisterUserRequest) (*
 	log := logging.FromContext(ctx)
 	pn := req.GetRemitPartner()
 
-	if !static.PartnerExists(pn, "PH") {
+	if !s.reg.PartnerExists(ctx, pn, "PH") {
 		log.Error("partner doesn't exist")
 		return nil, status.Error(codes.NotFound, "partner doesn't exist")
 	}
@@ -52,18 +52,30 @@ type UserStore interface {
 	RefreshRecipient(ctx context.Context, req *ppb.UpdateRecipientRequest) (*ppb.UpdateRecipientResponse, error)
 	GetRecipients(ctx context.Context, req *ppb.GetRecipientsRequest) (*ppb.GetRecipientsResponse, error)
 	DeleteRecipient(ctx context.Context, req *ppb.DeleteRecipientRequest) (*ppb.DeleteRecipientResponse, error)
+	CustomerHistory(ctx context.Context, req *ppb.CustomerHistoryRequest) (*ppb.CustomerHistoryResponse, error)
+	StartEnrollment(ctx context.Context, req *ppb.StartEnrollmentRequest) (*ppb.StartEnrollmentResponse, error)
+	ConfirmEnrollment(ctx context.Context, req *ppb.ConfirmEnrollmentRequest) (*ppb.ConfirmEnrollmentResponse, error)
+	UpdateCustomer(ctx context.Context, req *ppb.UpdateCustomerRequest) (*ppb.UpdateCustomerResponse, error)
+	LookupCustomer(ctx context.Context, req *ppb.LookupCustomerRequest) (*ppb.LookupCustomerResponse, error)
+}
+
+// PartnerRegistry reports whether a partner is enabled in a country.
+type PartnerRegistry interface {
+	PartnerExists(ctx context.Context, partner, country string) bool
 }
 
 type Svc struct {
 	ppb.UnimplementedProfileServiceServer
 	user       UserStore
+	reg        PartnerRegistry
 	validators map[string]Validator
 }
 
-func New(st UserStore, vs []Validator) *Svc {
+func New(st UserStore, reg PartnerRegistry, vs []Validator) *Svc {
 	s := &Svc{
 		validators: make(map[string]Validator, len(vs)),
 		user:       st,
+		reg:        reg,
 	}
 	for i, v := range vs {
 		switch {
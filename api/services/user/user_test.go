@@ -77,7 +77,7 @@ func newTestSvc(t *testing.T, st *postgres.Storage) (*Svc, *perahub.HTTPMock) {
 	if err != nil {
 		t.Fatal("setting up perahub integration: ", err)
 	}
-	return New(uc.New(st, ph), NewValidators()), cl
+	return New(uc.New(st, ph), static.New(ph, st), NewValidators()), cl
 }
 
 type tester interface {
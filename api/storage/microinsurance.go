@@ -61,6 +61,8 @@ type MicroInsuranceHistory struct {
 	Created          time.Time      `db:"created"`
 	Updated          time.Time      `db:"updated"`
 	OrgID            string         `db:"org_id"`
+	BranchID         string         `db:"branch_id"`
+	TellerID         string         `db:"teller_id"`
 	Total            int
 }
 
@@ -87,4 +89,7 @@ type MicroInsuranceFilter struct {
 	From         time.Time
 	Until        time.Time
 	OrgID        string
+	BranchID     string
+	TellerID     string
+	After        *Cursor
 }
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrInvalidPageToken is returned when a page token can't be decoded.
+var ErrInvalidPageToken = status.Error(codes.InvalidArgument, "invalid page token")
+
+// omitSortByColumn is the sort column of list requests that don't sort.
+const omitSortByColumn = "OmitSortByColumn"
+
+// Cursor is the position of the last row of a page listed in keyset order,
+// which is newest first by creation time and then by id.
+type Cursor struct {
+	Created time.Time
+	ID      string
+}
+
+// Token encodes the cursor as an opaque page token.
+func (c Cursor) Token() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.Created.UTC().Format(time.RFC3339Nano) + "|" + c.ID))
+}
+
+// ParseCursor decodes a page token made by Cursor.Token. An empty token
+// returns a nil cursor, which lists the first page.
+func ParseCursor(tok string) (*Cursor, error) {
+	if tok == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(tok)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	p := strings.SplitN(string(b), "|", 2)
+	if len(p) != 2 {
+		return nil, ErrInvalidPageToken
+	}
+	// the id is compared to uuid columns, anything else fails the query.
+	if _, err := uuid.Parse(p[1]); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	t, err := time.Parse(time.RFC3339Nano, p[0])
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return &Cursor{Created: t, ID: p[1]}, nil
+}
+
+// NextPageToken returns the token of the page after a page of n rows listed
+// with limit and sorted by the column, or "" when there is no next page or the
+// rows aren't listed in keyset order.
+func NextPageToken(sortByColumn string, limit, n int, last Cursor) string {
+	if sortByColumn != "" && sortByColumn != omitSortByColumn {
+		return ""
+	}
+	if limit <= 0 || n < limit {
+		return ""
+	}
+	return last.Token()
+}
@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCursor(t *testing.T) {
+	c := Cursor{
+		Created: time.Date(2022, 3, 4, 5, 6, 7, 891011000, time.UTC),
+		ID:      "2e2f1f0c-4ad0-4a5b-9ed1-4a5e1c0d1b9e",
+	}
+	got, err := ParseCursor(c.Token())
+	if err != nil {
+		t.Fatalf("ParseCursor() = got error %v, want nil", err)
+	}
+	if !got.Created.Equal(c.Created) || got.ID != c.ID {
+		t.Fatalf("ParseCursor() = got %+v, want %+v", got, c)
+	}
+
+	if got, err := ParseCursor(""); got != nil || err != nil {
+		t.Fatalf("ParseCursor() = got %v, %v, want nil, nil", got, err)
+	}
+	bad := Cursor{Created: c.Created, ID: "1' OR '1'='1"}.Token()
+	for _, tok := range []string{"not-a-token!", "bm90LWEtY3Vyc29y", "MjAyMi0wMy0wNHw", bad} {
+		if _, err := ParseCursor(tok); err != ErrInvalidPageToken {
+			t.Errorf("ParseCursor(%q) = got error %v, want %v", tok, err, ErrInvalidPageToken)
+		}
+	}
+}
+
+func TestNextPageToken(t *testing.T) {
+	c := Cursor{Created: time.Now(), ID: "id"}
+	tests := []struct {
+		name  string
+		col   string
+		limit int
+		n     int
+		want  string
+	}{
+		{name: "Full", col: "OmitSortByColumn", limit: 2, n: 2, want: c.Token()},
+		{name: "NoColumn", limit: 2, n: 2, want: c.Token()},
+		{name: "LastPage", col: "OmitSortByColumn", limit: 2, n: 1},
+		{name: "NoLimit", col: "OmitSortByColumn", n: 2},
+		{name: "Sorted", col: "ControlNumber", limit: 2, n: 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := NextPageToken(test.col, test.limit, test.n, c); got != test.want {
+				t.Fatalf("NextPageToken() = got %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"brank.as/petnet/api/storage"
+)
+
+const createBillerWalletBalance = `
+INSERT INTO biller_wallet_balance (
+	partner,
+	balance,
+	currency,
+	threshold,
+	low,
+	alerted,
+	error
+) VALUES (
+	:partner,
+	:balance,
+	:currency,
+	:threshold,
+	:low,
+	:alerted,
+	:error
+) RETURNING *
+`
+
+// CreateBillerWalletBalance records a biller wallet balance sample.
+func (s *Storage) CreateBillerWalletBalance(ctx context.Context, r storage.BillerWalletBalance) (*storage.BillerWalletBalance, error) {
+	if r.Partner == "" {
+		return nil, storage.ErrInvalid
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, createBillerWalletBalance)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		return nil, fmt.Errorf("executing biller wallet balance insert: %w", err)
+	}
+	return &r, nil
+}
+
+// SetBillerWalletBalanceAlerted records that the low balance alert of the
+// sample was delivered.
+func (s *Storage) SetBillerWalletBalanceAlerted(ctx context.Context, id string) error {
+	const setAlerted = `UPDATE biller_wallet_balance SET alerted = true WHERE id = $1 RETURNING id`
+	if err := s.db.QueryRowxContext(ctx, setAlerted, id).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return storage.ErrNotFound
+		}
+		return fmt.Errorf("executing biller wallet balance alerted update: %w", err)
+	}
+	return nil
+}
+
+const latestBillerWalletBalances = `
+SELECT DISTINCT ON (partner) *
+FROM biller_wallet_balance
+WHERE error = ''
+ORDER BY partner, created DESC
+`
+
+// LatestBillerWalletBalances returns the last successful sample of each biller.
+func (s *Storage) LatestBillerWalletBalances(ctx context.Context) ([]storage.BillerWalletBalance, error) {
+	r := []storage.BillerWalletBalance{}
+	if err := s.db.SelectContext(ctx, &r, latestBillerWalletBalances); err != nil {
+		return nil, fmt.Errorf("executing latest biller wallet balances: %w", err)
+	}
+	return r, nil
+}
+
+const listBillerWalletBalance = `
+SELECT *
+FROM biller_wallet_balance
+WHERE ($1 = '' OR partner = $1)
+AND created >= $2
+AND created < $3
+ORDER BY created DESC
+LIMIT $4
+`
+
+// ListBillerWalletBalance lists the balance samples in the filter, newest
+// first.
+func (s *Storage) ListBillerWalletBalance(ctx context.Context, f storage.BillerWalletBalanceFilter) ([]storage.BillerWalletBalance, error) {
+	if f.Limit <= 0 {
+		f.Limit = 100
+	}
+	if f.Until.IsZero() {
+		f.Until = time.Now()
+	}
+	r := []storage.BillerWalletBalance{}
+	if err := s.db.SelectContext(ctx, &r, listBillerWalletBalance, f.Partner, f.From, f.Until, f.Limit); err != nil {
+		return nil, fmt.Errorf("executing biller wallet balance list: %w", err)
+	}
+	return r, nil
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestBillerWalletBalance(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	if _, err := ts.CreateBillerWalletBalance(ctx, storage.BillerWalletBalance{}); err != storage.ErrInvalid {
+		t.Fatalf("CreateBillerWalletBalance() = got error %v, want %v", err, storage.ErrInvalid)
+	}
+	samples := []storage.BillerWalletBalance{
+		{Partner: "BYC", Balance: 500000, Currency: "PHP", Threshold: 100000},
+		{Partner: "ECP", Balance: 90000, Currency: "PHP", Threshold: 100000, Low: true},
+		{Partner: "BYC", Balance: 80000, Currency: "PHP", Threshold: 100000, Low: true},
+		{Partner: "ECP", Currency: "PHP", Error: "timeout"},
+	}
+	want := make([]storage.BillerWalletBalance, 0, len(samples))
+	for _, b := range samples {
+		got, err := ts.CreateBillerWalletBalance(ctx, b)
+		if err != nil {
+			t.Fatalf("CreateBillerWalletBalance() = got error %v, want nil", err)
+		}
+		if got.ID == "" || got.Created.IsZero() {
+			t.Fatalf("CreateBillerWalletBalance() = want id and created, got %+v", got)
+		}
+		want = append(want, *got)
+		// keep the samples ordered by created
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	o := cmpopts.IgnoreFields(storage.BillerWalletBalance{}, "Created")
+	latest, err := ts.LatestBillerWalletBalances(ctx)
+	if err != nil {
+		t.Fatalf("LatestBillerWalletBalances() = got error %v, want nil", err)
+	}
+	if !cmp.Equal([]storage.BillerWalletBalance{want[2], want[1]}, latest, o) {
+		t.Error(cmp.Diff([]storage.BillerWalletBalance{want[2], want[1]}, latest, o))
+	}
+
+	if err := ts.SetBillerWalletBalanceAlerted(ctx, want[1].ID); err != nil {
+		t.Fatalf("SetBillerWalletBalanceAlerted() = got error %v, want nil", err)
+	}
+	want[1].Alerted = true
+	latest, err = ts.LatestBillerWalletBalances(ctx)
+	if err != nil {
+		t.Fatalf("LatestBillerWalletBalances() = got error %v, want nil", err)
+	}
+	if !cmp.Equal([]storage.BillerWalletBalance{want[2], want[1]}, latest, o) {
+		t.Error(cmp.Diff([]storage.BillerWalletBalance{want[2], want[1]}, latest, o))
+	}
+	if err := ts.SetBillerWalletBalanceAlerted(ctx, uuid.NewString()); err != storage.ErrNotFound {
+		t.Fatalf("SetBillerWalletBalanceAlerted() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+
+	l, err := ts.ListBillerWalletBalance(ctx, storage.BillerWalletBalanceFilter{
+		Partner: "BYC",
+		From:    time.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("ListBillerWalletBalance() = got error %v, want nil", err)
+	}
+	if !cmp.Equal([]storage.BillerWalletBalance{want[2], want[0]}, l, o) {
+		t.Error(cmp.Diff([]storage.BillerWalletBalance{want[2], want[0]}, l, o))
+	}
+}
@@ -49,7 +49,9 @@ INSERT INTO bill_payment (
 	tpa_id,
 	type,
 	txnid,
-	org_id
+	org_id,
+	branch_id,
+	teller_id
 ) VALUES (
 	:bill_id,
 	:biller_tag,
@@ -89,7 +91,9 @@ INSERT INTO bill_payment (
 	:tpa_id,
 	:type,
 	:txnid,
-	:org_id
+	:org_id,
+	:branch_id,
+	:teller_id
 ) RETURNING
 *
 `
@@ -142,6 +146,25 @@ func (s *Storage) UpdateBillPayment(ctx context.Context, r storage.BillPayment)
 	return &r, nil
 }
 
+// SetBillPaymentStatus moves the bill payment from status old to status new.
+// It returns storage.ErrNotFound when the row does not have status old, so a
+// caller can use it to claim a row before acting on it.
+func (s *Storage) SetBillPaymentStatus(ctx context.Context, billPaymentID string, old, new storage.TxnStatus) error {
+	const setBillPaymentStatus = `
+UPDATE bill_payment
+SET bill_payment_status = $3
+WHERE bill_payment_id = $1 AND bill_payment_status = $2
+RETURNING bill_payment_id`
+	var id string
+	if err := s.db.QueryRowxContext(ctx, setBillPaymentStatus, billPaymentID, old, new).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return storage.ErrNotFound
+		}
+		return fmt.Errorf("executing bill payment status update: %w", err)
+	}
+	return nil
+}
+
 func (s *Storage) GetBillPayment(ctx context.Context, billPaymentID string) (*storage.BillPayment, error) {
 	const getBillPayment = `SELECT * FROM bill_payment WHERE bill_payment_id = $1`
 	var r storage.BillPayment
@@ -159,7 +182,12 @@ func (s *Storage) ListBillPayment(ctx context.Context, f storage.BillPaymentFilt
 	ut := ""
 	tr := ""
 	tc := ":total_count_where:"
-	b := NewBuilder(fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM bill_payment %s) SELECT *, cnt.total FROM bill_payment left join cnt on true`, tc))
+	q := fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM bill_payment %s) SELECT *, cnt.total FROM bill_payment left join cnt on true`, tc)
+	if f.After != nil {
+		// keyset pages skip the total, counting scans every matching row.
+		q = `SELECT * FROM bill_payment`
+	}
+	b := NewBuilder(q)
 	if !f.From.IsZero() {
 		ft = f.From.Format("2006-01-02")
 	}
@@ -194,6 +222,10 @@ func (s *Storage) ListBillPayment(ctx context.Context, f storage.BillPaymentFilt
 	default:
 		scol = ""
 	}
+	if f.After != nil {
+		scol, scolAmount = "", ""
+		f.Offset = 0
+	}
 
 	b.Where("bill_payment_id", eq, f.BillPaymentID).
 		Where("bill_id", eq, f.BillID).
@@ -205,8 +237,14 @@ func (s *Storage) ListBillPayment(ctx context.Context, f storage.BillPaymentFilt
 		Where("trx_date", eq, tr, CompareDate()).
 		Where("org_id", eq, f.OrgID).
 		Where("reference_number", eq, f.ReferenceNumber).
+		Where("branch_id", eq, f.BranchID).
+		Where("teller_id", eq, f.TellerID).
 		WhereNotIn("partner_id", f.ExcludePartners).
-		SortByColumn(scol, f.SortOrder).
+		After("created", "bill_payment_id", f.After)
+	if scol == "" && scolAmount == "" {
+		b.SortByKeyset("created", "bill_payment_id")
+	}
+	b.SortByColumn(scol, f.SortOrder).
 		SortByColumnTextToJson(scolAmount, "amount", f.SortOrder).
 		Limit(f.Limit).
 		Offset(f.Offset).AddTotalQuery(tc)
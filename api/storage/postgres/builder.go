@@ -187,3 +187,29 @@ func (b *Builder) SortByColumnTextToJson(col string, key string, o storage.SortO
 	b.query = b.query + fmt.Sprintf(" ORDER BY cast(cast(%s AS json)->>'%s' as int) %s", col, key, o)
 	return b
 }
+
+// After adds the keyset condition listing the rows after the cursor, see
+// SortByKeyset.
+func (b *Builder) After(createdCol, idCol string, c *storage.Cursor) *Builder {
+	if c == nil {
+		return b
+	}
+
+	clause := "WHERE"
+	if strings.Contains(b.query, "WHERE") {
+		clause = "AND"
+	}
+	wq := fmt.Sprintf(" %s (%s, %s) < (:after_created, CAST(:after_id AS uuid))", clause, createdCol, idCol)
+	b.query = b.query + wq
+	b.allwhere = b.allwhere + wq
+	b.args["after_created"] = c.Created
+	b.args["after_id"] = c.ID
+	return b
+}
+
+// SortByKeyset orders the rows newest first by creation time and then by id,
+// the order keyset pages are listed in.
+func (b *Builder) SortByKeyset(createdCol, idCol string) *Builder {
+	b.query = b.query + fmt.Sprintf(" ORDER BY %s DESC, %s DESC", createdCol, idCol)
+	return b
+}
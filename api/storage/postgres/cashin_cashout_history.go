@@ -32,7 +32,9 @@ INSERT INTO cico_history (
 	error_time,
 	error_type,
 	created_by,
-	created
+	created,
+	branch_id,
+	teller_id
 ) VALUES (
 	:org_id,
 	:svc_provider,
@@ -53,7 +55,9 @@ INSERT INTO cico_history (
 	:error_time,
 	:error_type,
 	:created_by,
-	:created
+	:created,
+	:branch_id,
+	:teller_id
 ) RETURNING *`
 
 func (s *Storage) CreateCICOHistory(ctx context.Context, req storage.CashInCashOutHistory) (*storage.CashInCashOutHistory, error) {
@@ -194,7 +198,12 @@ func (s *Storage) ListCICOHistory(ctx context.Context, f storage.CashInCashOutHi
 
 func (s *Storage) ListCICOTrx(ctx context.Context, f storage.CashInCashOutTrxListFilter) ([]storage.CashInCashOutHistory, error) {
 	tc := ":total_count_where:"
-	b := NewBuilder(fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM cico_history %s) SELECT *, cnt.total FROM cico_history left join cnt on true`, tc))
+	q := fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM cico_history %s) SELECT *, cnt.total FROM cico_history left join cnt on true`, tc)
+	if f.After != nil {
+		// keyset pages skip the total, counting scans every matching row.
+		q = `SELECT * FROM cico_history`
+	}
+	b := NewBuilder(q)
 	ft := ""
 	ut := ""
 	if !f.From.IsZero() {
@@ -228,6 +237,10 @@ func (s *Storage) ListCICOTrx(ctx context.Context, f storage.CashInCashOutTrxLis
 	default:
 		scol = ""
 	}
+	if f.After != nil {
+		scol = ""
+		f.Offset = 0
+	}
 
 	b.Where("reference_number", eq, f.ReferenceNumber).
 		Where("org_id", eq, f.OrgID).
@@ -238,10 +251,16 @@ func (s *Storage) ListCICOTrx(ctx context.Context, f storage.CashInCashOutTrxLis
 		Where("txn_status", eq, f.TxnStatus).
 		Where("petnet_trackingno", eq, f.PetnetTrackingNo).
 		Where("provider_trackingno", eq, f.ProviderTrackingNo).
+		Where("branch_id", eq, f.BranchID).
+		Where("teller_id", eq, f.TellerID).
 		WhereNotIn("trx_provider", f.ExcludeProviders).
 		Where("created", gtOrEq, ft, CompareDate()).
 		Where("created", ltOrEq, ut, CompareDate()).
-		SortByColumn(scol, f.SortOrder).
+		After("created", "id", f.After)
+	if scol == "" && scolAmount == "" {
+		b.SortByKeyset("created", "id")
+	}
+	b.SortByColumn(scol, f.SortOrder).
 		SortByColumnTextToJson(scolAmount, "amount", f.SortOrder).
 		Limit(f.Limit).
 		Offset(f.Offset).AddTotalQuery(tc)
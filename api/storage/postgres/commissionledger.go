@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const createCommissionLedger = `
+INSERT INTO commission_ledger (
+	org_id,
+	service,
+	transaction_id,
+	partner,
+	bound_type,
+	principal_amount,
+	charges,
+	revenue,
+	dsa_commission,
+	petnet_commission,
+	partner_tier_type,
+	partner_rate,
+	sharing_tier_type,
+	share_percent,
+	txn_completed_time
+) VALUES (
+	:org_id,
+	:service,
+	:transaction_id,
+	:partner,
+	:bound_type,
+	:principal_amount,
+	:charges,
+	:revenue,
+	:dsa_commission,
+	:petnet_commission,
+	:partner_tier_type,
+	:partner_rate,
+	:sharing_tier_type,
+	:share_percent,
+	:txn_completed_time
+) RETURNING
+id, created
+`
+
+// CreateCommissionLedger records the commission earned on a transaction.
+// storage.Conflict is returned if the transaction is already in the ledger.
+func (s *Storage) CreateCommissionLedger(ctx context.Context, r storage.CommissionLedger) (*storage.CommissionLedger, error) {
+	log := logging.FromContext(ctx)
+	log.WithField("commission_ledger", r).Trace("storing")
+
+	stmt, err := s.db.PrepareNamedContext(ctx, createCommissionLedger)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code == pqUnique {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing commission ledger insert: %w", err)
+	}
+	return &r, nil
+}
+
+const listCommissionLedger = `
+SELECT *
+FROM commission_ledger
+WHERE org_id = $1
+AND txn_completed_time >= $2
+AND txn_completed_time < $3
+AND ($4 = '' OR service = $4)
+ORDER BY txn_completed_time, service, partner
+`
+
+// ListCommissionLedger lists the commission ledger entries of a DSA.
+func (s *Storage) ListCommissionLedger(ctx context.Context, f storage.CommissionFilter) ([]storage.CommissionLedger, error) {
+	if f.OrgID == "" {
+		return nil, fmt.Errorf("org id cannot be empty")
+	}
+	r := []storage.CommissionLedger{}
+	if err := s.db.SelectContext(ctx, &r, listCommissionLedger, f.OrgID, f.From, f.Until, string(f.Service)); err != nil {
+		return nil, fmt.Errorf("executing commission ledger list: %w", err)
+	}
+	return r, nil
+}
+
+// completedTransactions selects the completed transactions of all services
+// completed in [$1, $2). Amounts are converted to PHP the same way as
+// listServiceTransactionReport.
+const completedTransactions = `
+	SELECT
+		dsa_id AS org_id,
+		'REMITTANCE' AS service,
+		remit_id::text AS transaction_id,
+		remco_id AS partner,
+		CASE remit_type WHEN 'SEND' THEN 'OUTBOUND' WHEN 'DISBURSE' THEN 'INBOUND' ELSE '' END AS bound_type,
+		COALESCE(NULLIF(remittance->'source_amt'->>'amount', '')::numeric, 0) / 100 AS principal_amount,
+		COALESCE(NULLIF(remittance->'charge'->>'amount', '')::numeric, 0) / 100 AS charges,
+		txn_completed_time
+	FROM remit_history
+	WHERE txn_status = 'SUCCESS'
+	AND txn_step = 'CONFIRM'
+	AND txn_completed_time >= $1
+	AND txn_completed_time < $2
+	UNION ALL
+	SELECT
+		org_id,
+		'BILLSPAYMENT' AS service,
+		bill_payment_id::text AS transaction_id,
+		partner_id AS partner,
+		'' AS bound_type,
+		COALESCE(NULLIF(NULLIF(amount, '')::jsonb->>'amount', '')::numeric, 0) / 100 AS principal_amount,
+		COALESCE(NULLIF(partner_charge, '')::numeric, 0) AS charges,
+		trx_date AS txn_completed_time
+	FROM bill_payment
+	WHERE bill_payment_status = 'SUCCESS'
+	AND trx_date >= $1
+	AND trx_date < $2
+	UNION ALL
+	SELECT
+		org_id::text,
+		'CASHINCASHOUT' AS service,
+		id::text AS transaction_id,
+		partner_code AS partner,
+		'' AS bound_type,
+		COALESCE(NULLIF(principal_amount, '')::numeric, 0) AS principal_amount,
+		COALESCE(NULLIF(charges, '')::numeric, 0) AS charges,
+		trx_date AS txn_completed_time
+	FROM cico_history
+	WHERE txn_status = 'SUCCESS'
+	AND trx_date >= $1
+	AND trx_date < $2
+	UNION ALL
+	SELECT
+		COALESCE(NULLIF(org_id, ''), dsa_id) AS org_id,
+		'MICROINSURANCE' AS service,
+		id::text AS transaction_id,
+		'RuralNet' AS partner,
+		'' AS bound_type,
+		COALESCE(NULLIF(amount, '')::numeric, 0) AS principal_amount,
+		0 AS charges,
+		trx_date AS txn_completed_time
+	FROM micro_insurance_history
+	WHERE error_code = ''
+	AND trx_status NOT IN ('', 'Failed', 'FAIL')
+	AND trx_date >= $1
+	AND trx_date < $2
+	UNION ALL
+	SELECT
+		org_id,
+		'ELOAD' AS service,
+		id::text AS transaction_id,
+		partner,
+		'' AS bound_type,
+		amount::numeric / 100 AS principal_amount,
+		0 AS charges,
+		trx_date AS txn_completed_time
+	FROM eload_history
+	WHERE txn_status = 'SUCCESS'
+	AND trx_date >= $1
+	AND trx_date < $2
+`
+
+const countCompletedTransactions = `
+SELECT COUNT(*) FROM (` + completedTransactions + `) t
+WHERE t.org_id = $3
+AND t.service = $4
+`
+
+// CountCompletedTransactions returns the number of transactions of a service
+// completed by a DSA in the filter period.
+func (s *Storage) CountCompletedTransactions(ctx context.Context, f storage.CommissionFilter) (int, error) {
+	var n int
+	if err := s.db.GetContext(ctx, &n, countCompletedTransactions, f.From, f.Until, f.OrgID, string(f.Service)); err != nil {
+		return 0, fmt.Errorf("executing completed transaction count: %w", err)
+	}
+	return n, nil
+}
+
+const listUnpostedCommission = `
+SELECT * FROM (` + completedTransactions + `) t
+WHERE t.org_id <> ''
+AND NOT EXISTS (
+	SELECT 1 FROM commission_ledger cl
+	WHERE cl.service = t.service AND cl.transaction_id = t.transaction_id
+)
+ORDER BY t.txn_completed_time
+LIMIT $3
+`
+
+// ListUnpostedCommission lists the completed transactions of all services not
+// yet in the commission ledger. Only the transaction fields of the returned
+// entries are set.
+func (s *Storage) ListUnpostedCommission(ctx context.Context, f storage.CommissionFilter) ([]storage.CommissionLedger, error) {
+	if f.Limit <= 0 {
+		f.Limit = 100
+	}
+	r := []storage.CommissionLedger{}
+	if err := s.db.SelectContext(ctx, &r, listUnpostedCommission, f.From, f.Until, f.Limit); err != nil {
+		return nil, fmt.Errorf("executing unposted commission list: %w", err)
+	}
+	return r, nil
+}
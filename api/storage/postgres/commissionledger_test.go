@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestCommissionLedger(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+	oid := uuid.NewString()
+	now := time.Now()
+
+	cico, err := ts.CreateCICOHistory(ctx, storage.CashInCashOutHistory{
+		OrgID:            oid,
+		PartnerCode:      "GCASH",
+		PetnetTrackingNo: uuid.NewString(),
+		TxnStatus:        "SUCCESS",
+		PrincipalAmount:  1000,
+		Charges:          15,
+		TotalAmount:      1015,
+		TrxDate:          now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f := storage.CommissionFilter{
+		From:  now.Add(-time.Hour),
+		Until: now.Add(time.Hour),
+		Limit: 1000,
+	}
+	unposted := func() *storage.CommissionLedger {
+		l, err := ts.ListUnpostedCommission(ctx, f)
+		if err != nil {
+			t.Fatalf("ListUnpostedCommission() = got error %v, want nil", err)
+		}
+		for _, e := range l {
+			if e.TxnID == cico.ID {
+				return &e
+			}
+		}
+		return nil
+	}
+	e := unposted()
+	if e == nil {
+		t.Fatal("ListUnpostedCommission() = want transaction listed")
+	}
+	if e.OrgID != oid || e.Service != storage.CashInCashOutService || e.Partner != "GCASH" {
+		t.Fatalf("ListUnpostedCommission() = got %+v", e)
+	}
+
+	e.Revenue = "15.00"
+	e.DSACommission = "7.50"
+	e.PETNETCommission = "7.50"
+	e.SharingTierType = "PERCENTAGE"
+	e.SharePercent = "50"
+	in := *e
+	got, err := ts.CreateCommissionLedger(ctx, in)
+	if err != nil {
+		t.Fatalf("CreateCommissionLedger() = got error %v, want nil", err)
+	}
+	if got.ID == "" {
+		t.Fatal("CreateCommissionLedger() = want id")
+	}
+	if _, err := ts.CreateCommissionLedger(ctx, in); err != storage.Conflict {
+		t.Fatalf("CreateCommissionLedger() = got error %v, want conflict", err)
+	}
+	if unposted() != nil {
+		t.Fatal("ListUnpostedCommission() = posted transaction listed again")
+	}
+
+	f.OrgID = oid
+	f.Service = storage.CashInCashOutService
+	n, err := ts.CountCompletedTransactions(ctx, f)
+	if err != nil {
+		t.Fatalf("CountCompletedTransactions() = got error %v, want nil", err)
+	}
+	if n != 1 {
+		t.Errorf("CountCompletedTransactions() = got %d, want 1", n)
+	}
+
+	l, err := ts.ListCommissionLedger(ctx, f)
+	if err != nil {
+		t.Fatalf("ListCommissionLedger() = got error %v, want nil", err)
+	}
+	o := cmpopts.IgnoreFields(storage.CommissionLedger{}, "ID", "Created", "TxnCompletedTime", "Amount", "Charges")
+	if !cmp.Equal([]storage.CommissionLedger{in}, l, o) {
+		t.Error(cmp.Diff([]storage.CommissionLedger{in}, l, o))
+	}
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+)
+
+const createCustomerEnrollment = `
+INSERT INTO customer_enrollment (
+	org_id,
+	user_id,
+	partner,
+	mobile,
+	status,
+	details,
+	registration
+) VALUES (
+	:org_id,
+	:user_id,
+	:partner,
+	:mobile,
+	:status,
+	:details,
+	:registration
+) RETURNING *
+`
+
+// CreateCustomerEnrollment records a pending partner enrollment.
+func (s *Storage) CreateCustomerEnrollment(ctx context.Context, e storage.CustomerEnrollment) (*storage.CustomerEnrollment, error) {
+	if e.OrgID == "" || e.Partner == "" {
+		return nil, storage.ErrInvalid
+	}
+	if e.Status == "" {
+		e.Status = storage.EnrollmentPending
+	}
+	if len(e.Details) == 0 {
+		e.Details = []byte("{}")
+	}
+	if len(e.Registration) == 0 {
+		e.Registration = []byte("{}")
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, createCustomerEnrollment)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&e, e); err != nil {
+		return nil, fmt.Errorf("executing customer enrollment insert: %w", err)
+	}
+	return &e, nil
+}
+
+// GetCustomerEnrollment returns storage.ErrNotFound if there is no enrollment
+// with the given id in the org.
+func (s *Storage) GetCustomerEnrollment(ctx context.Context, orgID, id string) (*storage.CustomerEnrollment, error) {
+	const getCustomerEnrollment = `SELECT * FROM customer_enrollment WHERE org_id = $1 AND id = $2`
+	var e storage.CustomerEnrollment
+	if err := s.db.GetContext(ctx, &e, getCustomerEnrollment, orgID, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing customer enrollment get: %w", err)
+	}
+	return &e, nil
+}
+
+// ClaimCustomerEnrollment moves a pending enrollment to confirming so it is
+// registered with the partner once. storage.ErrNotFound is returned if there
+// is no pending enrollment with the given id in the org.
+func (s *Storage) ClaimCustomerEnrollment(ctx context.Context, orgID, id string) (*storage.CustomerEnrollment, error) {
+	const claimCustomerEnrollment = `
+UPDATE customer_enrollment SET
+	status = $4,
+	updated = NOW()
+WHERE org_id = $1 AND id = $2 AND status = $3
+RETURNING *
+`
+	var e storage.CustomerEnrollment
+	if err := s.db.GetContext(ctx, &e, claimCustomerEnrollment, orgID, id, storage.EnrollmentPending, storage.EnrollmentConfirming); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing customer enrollment claim: %w", err)
+	}
+	return &e, nil
+}
+
+// ReleaseCustomerEnrollment moves a confirming enrollment back to pending
+// after the partner rejected the registration.
+func (s *Storage) ReleaseCustomerEnrollment(ctx context.Context, orgID, id string) error {
+	const releaseCustomerEnrollment = `
+UPDATE customer_enrollment SET
+	status = $4,
+	updated = NOW()
+WHERE org_id = $1 AND id = $2 AND status = $3
+`
+	res, err := s.db.ExecContext(ctx, releaseCustomerEnrollment, orgID, id, storage.EnrollmentConfirming, storage.EnrollmentPending)
+	if err != nil {
+		return fmt.Errorf("executing customer enrollment release: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+// PurgeCustomerEnrollments deletes the pending enrollments started before the
+// given time, with their registration requests, and returns the number
+// deleted. Enrollments being confirmed are kept, the partner may have enrolled
+// the customer already.
+func (s *Storage) PurgeCustomerEnrollments(ctx context.Context, before time.Time) (int64, error) {
+	const purgeCustomerEnrollments = `
+DELETE FROM customer_enrollment
+WHERE status NOT IN ('ENROLLED', 'CONFIRMING')
+AND created < $1
+`
+	res, err := s.db.ExecContext(ctx, purgeCustomerEnrollments, before)
+	if err != nil {
+		return 0, fmt.Errorf("executing customer enrollment purge: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+const enrollCustomer = `
+UPDATE customer_enrollment SET
+	status = :status,
+	customer_code = :customer_code,
+	wu_card_no = :wu_card_no,
+	registration = '{}',
+	updated = NOW()
+WHERE org_id = :org_id AND id = :id AND status = 'CONFIRMING'
+RETURNING *
+`
+
+// EnrollCustomer marks a confirming enrollment as enrolled with the partner
+// customer code and drops the registration request. storage.Conflict is
+// returned if the customer code is already enrolled in the org.
+func (s *Storage) EnrollCustomer(ctx context.Context, e storage.CustomerEnrollment) (*storage.CustomerEnrollment, error) {
+	if e.OrgID == "" || e.ID == "" || e.CustomerCode == "" {
+		return nil, storage.ErrInvalid
+	}
+	e.Status = storage.EnrollmentEnrolled
+	stmt, err := s.db.PrepareNamedContext(ctx, enrollCustomer)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&e, e); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code == pqUnique {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing customer enrollment update: %w", err)
+	}
+	return &e, nil
+}
+
+// GetEnrolledCustomer looks up an enrolled customer by partner customer code
+// or WU card number. storage.ErrNotFound is returned if the customer was not
+// enrolled through the org.
+func (s *Storage) GetEnrolledCustomer(ctx context.Context, orgID, partner, id string) (*storage.CustomerEnrollment, error) {
+	const getEnrolledCustomer = `
+SELECT * FROM customer_enrollment
+WHERE org_id = $1 AND partner = $2 AND status = $3 AND (customer_code = $4 OR wu_card_no = $4)
+ORDER BY updated DESC
+LIMIT 1
+`
+	if id == "" {
+		return nil, storage.ErrNotFound
+	}
+	var e storage.CustomerEnrollment
+	if err := s.db.GetContext(ctx, &e, getEnrolledCustomer, orgID, partner, storage.EnrollmentEnrolled, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing enrolled customer get: %w", err)
+	}
+	return &e, nil
+}
+
+const updateEnrolledCustomer = `
+UPDATE customer_enrollment SET
+	mobile = COALESCE(NULLIF(:mobile, ''), mobile),
+	details = :details,
+	updated = NOW()
+WHERE org_id = :org_id AND partner = :partner AND customer_code = :customer_code
+RETURNING *
+`
+
+// UpdateEnrolledCustomer replaces the KYC details of an enrolled customer.
+func (s *Storage) UpdateEnrolledCustomer(ctx context.Context, e storage.CustomerEnrollment) (*storage.CustomerEnrollment, error) {
+	if e.OrgID == "" || e.Partner == "" || e.CustomerCode == "" || len(e.Details) == 0 {
+		return nil, storage.ErrInvalid
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, updateEnrolledCustomer)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&e, e); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing enrolled customer update: %w", err)
+	}
+	return &e, nil
+}
@@ -0,0 +1,146 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestCustomerEnrollment(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+	const (
+		org     = "20000000-0000-0000-0000-000000000000"
+		partner = "WU"
+	)
+
+	if _, err := ts.CreateCustomerEnrollment(ctx, storage.CustomerEnrollment{}); err != storage.ErrInvalid {
+		t.Fatalf("CreateCustomerEnrollment() = got error %v, want %v", err, storage.ErrInvalid)
+	}
+	in := storage.CustomerEnrollment{
+		OrgID:        org,
+		UserID:       "10000000-0000-0000-0000-000000000000",
+		Partner:      partner,
+		Mobile:       "09170000000",
+		Details:      []byte(`{"FName":"Juan"}`),
+		Registration: []byte(`{"Answer1":"secret"}`),
+	}
+	pe, err := ts.CreateCustomerEnrollment(ctx, in)
+	if err != nil {
+		t.Fatalf("CreateCustomerEnrollment() = got error %v, want nil", err)
+	}
+	if pe.ID == "" || pe.Status != storage.EnrollmentPending {
+		t.Fatalf("CreateCustomerEnrollment() = want id and pending status, got %+v", pe)
+	}
+	if _, err := ts.GetCustomerEnrollment(ctx, "30000000-0000-0000-0000-000000000000", pe.ID); err != storage.ErrNotFound {
+		t.Errorf("GetCustomerEnrollment() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+	if _, err := ts.GetEnrolledCustomer(ctx, org, partner, "CC-1"); err != storage.ErrNotFound {
+		t.Errorf("GetEnrolledCustomer() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+
+	if _, err := ts.EnrollCustomer(ctx, storage.CustomerEnrollment{
+		ID:           pe.ID,
+		OrgID:        org,
+		CustomerCode: "CC-1",
+	}); err != storage.ErrNotFound {
+		t.Fatalf("EnrollCustomer() = got error %v, want %v for unclaimed enrollment", err, storage.ErrNotFound)
+	}
+	ce, err := ts.ClaimCustomerEnrollment(ctx, org, pe.ID)
+	if err != nil {
+		t.Fatalf("ClaimCustomerEnrollment() = got error %v, want nil", err)
+	}
+	if ce.Status != storage.EnrollmentConfirming {
+		t.Errorf("ClaimCustomerEnrollment() = got status %s, want %s", ce.Status, storage.EnrollmentConfirming)
+	}
+	if _, err := ts.ClaimCustomerEnrollment(ctx, org, pe.ID); err != storage.ErrNotFound {
+		t.Fatalf("ClaimCustomerEnrollment() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+	if err := ts.ReleaseCustomerEnrollment(ctx, org, pe.ID); err != nil {
+		t.Fatalf("ReleaseCustomerEnrollment() = got error %v, want nil", err)
+	}
+	if _, err := ts.ClaimCustomerEnrollment(ctx, org, pe.ID); err != nil {
+		t.Fatalf("ClaimCustomerEnrollment() = got error %v, want nil", err)
+	}
+
+	en, err := ts.EnrollCustomer(ctx, storage.CustomerEnrollment{
+		ID:           pe.ID,
+		OrgID:        org,
+		CustomerCode: "CC-1",
+		WUCardNo:     "500000001",
+	})
+	if err != nil {
+		t.Fatalf("EnrollCustomer() = got error %v, want nil", err)
+	}
+	if en.Status != storage.EnrollmentEnrolled || en.Mobile != in.Mobile || en.Registration.String() != "{}" {
+		t.Errorf("EnrollCustomer() = got %+v, want enrolled %s", en, in.Mobile)
+	}
+	for _, id := range []string{"CC-1", "500000001"} {
+		g, err := ts.GetEnrolledCustomer(ctx, org, partner, id)
+		if err != nil {
+			t.Fatalf("GetEnrolledCustomer(%s) = got error %v, want nil", id, err)
+		}
+		if g.ID != pe.ID {
+			t.Errorf("GetEnrolledCustomer(%s) = got id %s, want %s", id, g.ID, pe.ID)
+		}
+	}
+
+	dup, err := ts.CreateCustomerEnrollment(ctx, in)
+	if err != nil {
+		t.Fatalf("CreateCustomerEnrollment() = got error %v, want nil", err)
+	}
+	if _, err := ts.ClaimCustomerEnrollment(ctx, org, dup.ID); err != nil {
+		t.Fatalf("ClaimCustomerEnrollment() = got error %v, want nil", err)
+	}
+	if _, err := ts.EnrollCustomer(ctx, storage.CustomerEnrollment{
+		ID:           dup.ID,
+		OrgID:        org,
+		CustomerCode: "CC-1",
+	}); err != storage.Conflict {
+		t.Errorf("EnrollCustomer() = got error %v, want %v", err, storage.Conflict)
+	}
+	if err := ts.ReleaseCustomerEnrollment(ctx, org, dup.ID); err != nil {
+		t.Fatalf("ReleaseCustomerEnrollment() = got error %v, want nil", err)
+	}
+
+	up, err := ts.UpdateEnrolledCustomer(ctx, storage.CustomerEnrollment{
+		OrgID:        org,
+		Partner:      partner,
+		CustomerCode: "CC-1",
+		Details:      []byte(`{"FName":"Pedro"}`),
+	})
+	if err != nil {
+		t.Fatalf("UpdateEnrolledCustomer() = got error %v, want nil", err)
+	}
+	if up.Mobile != in.Mobile || up.Details.String() != `{"FName": "Pedro"}` {
+		t.Errorf("UpdateEnrolledCustomer() = got %+v", up)
+	}
+
+	cf, err := ts.CreateCustomerEnrollment(ctx, in)
+	if err != nil {
+		t.Fatalf("CreateCustomerEnrollment() = got error %v, want nil", err)
+	}
+	if _, err := ts.ClaimCustomerEnrollment(ctx, org, cf.ID); err != nil {
+		t.Fatalf("ClaimCustomerEnrollment() = got error %v, want nil", err)
+	}
+
+	// the enrolled and confirming customers are kept, the abandoned duplicate
+	// is purged
+	n, err := ts.PurgeCustomerEnrollments(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeCustomerEnrollments() = got error %v, want nil", err)
+	}
+	if n != 1 {
+		t.Errorf("PurgeCustomerEnrollments() = got %d, want 1", n)
+	}
+	if _, err := ts.GetCustomerEnrollment(ctx, org, dup.ID); err != storage.ErrNotFound {
+		t.Errorf("GetCustomerEnrollment() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+	for _, id := range []string{pe.ID, cf.ID} {
+		if _, err := ts.GetCustomerEnrollment(ctx, org, id); err != nil {
+			t.Errorf("GetCustomerEnrollment(%s) = got error %v, want nil", id, err)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+)
+
+// Elector is a leader election client backed by a postgres session level
+// advisory lock. The instance holding the lock is the leader until its
+// database session ends.
+type Elector struct {
+	db   *sql.DB
+	key  int64
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+// NewElector returns an Elector competing for the advisory lock key.
+func (s *Storage) NewElector(key int64) *Elector {
+	return &Elector{db: s.db.DB, key: key}
+}
+
+// IsLead reports whether this instance holds the lock, acquiring it if free.
+func (e *Elector) IsLead(ctx context.Context) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn != nil {
+		if err := e.conn.PingContext(ctx); err == nil {
+			return true
+		}
+		// session lost, the lock was released with it
+		e.conn.Close()
+		e.conn = nil
+	}
+
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		return false
+	}
+	var ok bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&ok); err != nil || !ok {
+		conn.Close()
+		return false
+	}
+	e.conn = conn
+	return true
+}
+
+// Close releases the lock if held.
+func (e *Elector) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.conn == nil {
+		return nil
+	}
+	defer func() { e.conn = nil }()
+	if _, err := e.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", e.key); err != nil {
+		// drop the session so the lock is not kept by a pooled connection
+		e.conn.Raw(func(interface{}) error { return driver.ErrBadConn })
+		e.conn.Close()
+		return err
+	}
+	return e.conn.Close()
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+)
+
+const createELoadHistory = `
+INSERT INTO eload_history (
+	org_id,
+	user_id,
+	partner,
+	product_code,
+	product_type,
+	provider,
+	target_mobile_no,
+	amount,
+	currency,
+	reference_number,
+	trace_id,
+	txn_status,
+	error_code,
+	error_message,
+	idempotency_key,
+	branch_id,
+	teller_id,
+	trx_date
+) VALUES (
+	:org_id,
+	:user_id,
+	:partner,
+	:product_code,
+	:product_type,
+	:provider,
+	:target_mobile_no,
+	:amount,
+	:currency,
+	:reference_number,
+	:trace_id,
+	:txn_status,
+	:error_code,
+	:error_message,
+	:idempotency_key,
+	:branch_id,
+	:teller_id,
+	:trx_date
+) RETURNING *
+`
+
+// CreateELoadHistory records an eload sale. It returns storage.Conflict if the
+// org already recorded a sale with the idempotency key.
+func (s *Storage) CreateELoadHistory(ctx context.Context, r storage.ELoadHistory) (*storage.ELoadHistory, error) {
+	if r.OrgID == "" || r.TrxDate.IsZero() {
+		return nil, storage.ErrInvalid
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, createELoadHistory)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		pErr, ok := err.(*pq.Error)
+		if ok && pErr.Code == pqUnique {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing eload history insert: %w", err)
+	}
+	return &r, nil
+}
+
+const updateELoadHistory = `
+UPDATE eload_history
+SET
+	reference_number = :reference_number,
+	trace_id = :trace_id,
+	txn_status = :txn_status,
+	error_code = :error_code,
+	error_message = :error_message
+WHERE id = :id
+RETURNING *
+`
+
+// UpdateELoadHistory records the outcome of an eload sale.
+func (s *Storage) UpdateELoadHistory(ctx context.Context, r storage.ELoadHistory) (*storage.ELoadHistory, error) {
+	if r.ID == "" {
+		return nil, storage.ErrInvalid
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, updateELoadHistory)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing eload history update: %w", err)
+	}
+	return &r, nil
+}
+
+// GetELoadHistoryByIdempotencyKey returns storage.ErrNotFound if the org has no
+// eload sale with the idempotency key.
+func (s *Storage) GetELoadHistoryByIdempotencyKey(ctx context.Context, orgID, key string) (*storage.ELoadHistory, error) {
+	const getELoadHistory = `SELECT * FROM eload_history WHERE org_id = $1 AND idempotency_key = $2`
+	var r storage.ELoadHistory
+	if err := s.db.GetContext(ctx, &r, getELoadHistory, orgID, key); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing eload history get: %w", err)
+	}
+	return &r, nil
+}
+
+// ReviewPendingELoadHistory flags the eload sales still pending since before
+// the given time as needing review and returns the number flagged.
+func (s *Storage) ReviewPendingELoadHistory(ctx context.Context, before time.Time) (int64, error) {
+	const reviewPending = `
+UPDATE eload_history
+SET txn_status = 'NEEDS_REVIEW'
+WHERE txn_status = 'PENDING'
+AND created < $1
+`
+	res, err := s.db.ExecContext(ctx, reviewPending, before)
+	if err != nil {
+		return 0, fmt.Errorf("executing eload history review: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// GetELoadHistory returns storage.ErrNotFound if there is no eload sale with the
+// given id.
+func (s *Storage) GetELoadHistory(ctx context.Context, id string) (*storage.ELoadHistory, error) {
+	const getELoadHistory = `SELECT * FROM eload_history WHERE id = $1`
+	var r storage.ELoadHistory
+	if err := s.db.GetContext(ctx, &r, getELoadHistory, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing eload history get: %w", err)
+	}
+	return &r, nil
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestELoadHistory(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	if _, err := ts.CreateELoadHistory(ctx, storage.ELoadHistory{}); err != storage.ErrInvalid {
+		t.Fatalf("CreateELoadHistory() = got error %v, want %v", err, storage.ErrInvalid)
+	}
+	in := storage.ELoadHistory{
+		OrgID:           "20000000-0000-0000-0000-000000000000",
+		UserID:          "10000000-0000-0000-0000-000000000000",
+		Partner:         "ELOAD",
+		ProductCode:     "SMGS50",
+		ProductType:     "Eload",
+		Provider:        "SMART",
+		TargetMobileNo:  "09170000000",
+		Amount:          5000,
+		Currency:        "PHP",
+		ReferenceNumber: "RRN-1",
+		TraceID:         "TID-1",
+		TxnStatus:       string(storage.SuccessStatus),
+		BranchID:        "30000000-0000-0000-0000-000000000000",
+		TellerID:        "40000000-0000-0000-0000-000000000000",
+		TrxDate:         time.Now().UTC().Truncate(time.Second),
+	}
+	got, err := ts.CreateELoadHistory(ctx, in)
+	if err != nil {
+		t.Fatalf("CreateELoadHistory() = got error %v, want nil", err)
+	}
+	if got.ID == "" || got.Created.IsZero() {
+		t.Fatalf("CreateELoadHistory() = want id and created, got %+v", got)
+	}
+
+	o := []cmp.Option{
+		cmpopts.IgnoreFields(storage.ELoadHistory{}, "ID", "Created"),
+		cmpopts.EquateApproxTime(time.Second),
+	}
+	g, err := ts.GetELoadHistory(ctx, got.ID)
+	if err != nil {
+		t.Fatalf("GetELoadHistory() = got error %v, want nil", err)
+	}
+	if !cmp.Equal(in, *g, o...) {
+		t.Error(cmp.Diff(in, *g, o...))
+	}
+
+	if _, err := ts.GetELoadHistory(ctx, "30000000-0000-0000-0000-000000000000"); err != storage.ErrNotFound {
+		t.Errorf("GetELoadHistory() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+
+	pin := in
+	pin.ReferenceNumber, pin.TraceID = "", ""
+	pin.TxnStatus = string(storage.PendingStatus)
+	pin.IdempotencyKey = "key-1"
+	p, err := ts.CreateELoadHistory(ctx, pin)
+	if err != nil {
+		t.Fatalf("CreateELoadHistory() = got error %v, want nil", err)
+	}
+	if _, err := ts.CreateELoadHistory(ctx, pin); err != storage.Conflict {
+		t.Fatalf("CreateELoadHistory() = got error %v, want %v", err, storage.Conflict)
+	}
+	g, err = ts.GetELoadHistoryByIdempotencyKey(ctx, pin.OrgID, "key-1")
+	if err != nil {
+		t.Fatalf("GetELoadHistoryByIdempotencyKey() = got error %v, want nil", err)
+	}
+	if g.ID != p.ID {
+		t.Errorf("GetELoadHistoryByIdempotencyKey() = got id %q, want %q", g.ID, p.ID)
+	}
+	if _, err := ts.GetELoadHistoryByIdempotencyKey(ctx, pin.OrgID, "key-2"); err != storage.ErrNotFound {
+		t.Errorf("GetELoadHistoryByIdempotencyKey() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+
+	n, err := ts.ReviewPendingELoadHistory(ctx, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("ReviewPendingELoadHistory() = got error %v, want nil", err)
+	}
+	if n != 1 {
+		t.Errorf("ReviewPendingELoadHistory() = got %d, want 1", n)
+	}
+
+	p.TxnStatus = string(storage.SuccessStatus)
+	p.ReferenceNumber = "RRN-2"
+	u, err := ts.UpdateELoadHistory(ctx, *p)
+	if err != nil {
+		t.Fatalf("UpdateELoadHistory() = got error %v, want nil", err)
+	}
+	if u.TxnStatus != string(storage.SuccessStatus) || u.ReferenceNumber != "RRN-2" {
+		t.Errorf("UpdateELoadHistory() = got %+v", u)
+	}
+	p.ID = "30000000-0000-0000-0000-000000000000"
+	if _, err := ts.UpdateELoadHistory(ctx, *p); err != storage.ErrNotFound {
+		t.Errorf("UpdateELoadHistory() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+}
@@ -17,7 +17,7 @@ INSERT INTO inputguide (
 	:partner,
 	:inputguide
 ) RETURNING
-created,updated
+refreshed,created,updated
 `
 
 func (s *Storage) CreateInputGuide(ctx context.Context, r storage.InputGuide) (*storage.InputGuide, error) {
@@ -72,3 +72,74 @@ func (s *Storage) GetInputGuide(ctx context.Context, partner string) (*storage.I
 	}
 	return &r, nil
 }
+
+const refreshInputGuide = `
+	UPDATE inputguide
+	SET
+		inputguide= :inputguide,
+		refreshed= now()
+	WHERE partner= :partner
+	RETURNING refreshed, updated, created`
+
+// RefreshInputGuide replaces the input guide of the partner with the one fetched
+// again from the partner, storing it if it's not cached yet.
+func (s *Storage) RefreshInputGuide(ctx context.Context, r storage.InputGuide) (*storage.InputGuide, error) {
+	stmt, err := s.db.PrepareNamedContext(ctx, refreshInputGuide)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		if err == sql.ErrNoRows {
+			return s.CreateInputGuide(ctx, r)
+		}
+		return nil, fmt.Errorf("executing inputguide refresh: %w", err)
+	}
+	return &r, nil
+}
+
+const createInputGuideDiff = `
+INSERT INTO inputguide_diff (
+	partner,
+	group_name,
+	added,
+	removed,
+	changed
+) VALUES (
+	:partner,
+	:group_name,
+	:added,
+	:removed,
+	:changed
+) RETURNING
+id,created
+`
+
+func (s *Storage) CreateInputGuideDiff(ctx context.Context, r storage.InputGuideDiff) (*storage.InputGuideDiff, error) {
+	stmt, err := s.db.PrepareNamedContext(ctx, createInputGuideDiff)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		return nil, fmt.Errorf("executing inputguide diff insert: %w", err)
+	}
+	return &r, nil
+}
+
+const listInputGuideDiff = `
+SELECT *
+FROM inputguide_diff
+WHERE partner= $1
+ORDER BY created DESC
+LIMIT $2
+`
+
+// ListInputGuideDiff lists the latest changes of the input guide of a partner.
+func (s *Storage) ListInputGuideDiff(ctx context.Context, partner string, limit int) ([]storage.InputGuideDiff, error) {
+	var r []storage.InputGuideDiff
+	if err := s.db.Select(&r, listInputGuideDiff, partner, limit); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
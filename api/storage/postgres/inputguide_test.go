@@ -36,7 +36,7 @@ func TestInputGuide(t *testing.T) {
 			t.Fatalf("GetInputGuide() = got error %v, want nil", err)
 		}
 		o := []cmp.Option{
-			cmpopts.IgnoreFields(storage.InputGuide{}, "Created", "Updated"),
+			cmpopts.IgnoreFields(storage.InputGuide{}, "Refreshed", "Created", "Updated"),
 		}
 		if !cmp.Equal(in, got, o...) {
 			t.Error(cmp.Diff(in, got, o...))
@@ -75,3 +75,57 @@ func TestInputGuide(t *testing.T) {
 		}
 	})
 }
+
+func TestInputGuideRefresh(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	in := storage.InputGuide{
+		Partner: "TF",
+		Data: storage.InputGuideData{
+			storage.IGIDsGroup: {{Value: "PP", Name: "Passport"}},
+		},
+	}
+	got, err := ts.RefreshInputGuide(ctx, in)
+	if err != nil {
+		t.Fatalf("RefreshInputGuide() = got error %v, want nil", err)
+	}
+	if got.Refreshed.IsZero() {
+		t.Fatal("refreshed shouldn't be empty")
+	}
+	refreshed := got.Refreshed
+
+	in.Data[storage.IGIDsGroup] = []storage.Input{{Value: "DL", Name: "Driver's License"}}
+	got, err = ts.RefreshInputGuide(ctx, in)
+	if err != nil {
+		t.Fatalf("RefreshInputGuide() = got error %v, want nil", err)
+	}
+	if !got.Refreshed.After(refreshed) {
+		t.Error("refreshed hasn't changed after refresh")
+	}
+	o := cmpopts.IgnoreFields(storage.InputGuide{}, "Refreshed", "Created", "Updated")
+	if g, err := ts.GetInputGuide(ctx, in.Partner); err != nil {
+		t.Fatalf("GetInputGuide() = got error %v, want nil", err)
+	} else if !cmp.Equal(in, *g, o) {
+		t.Error(cmp.Diff(in, *g, o))
+	}
+
+	d := storage.InputGuideDiff{
+		Partner: in.Partner,
+		Group:   storage.IGIDsGroup,
+		Added:   []string{"DL"},
+		Removed: []string{"PP"},
+		Changed: []string{},
+	}
+	if _, err := ts.CreateInputGuideDiff(ctx, d); err != nil {
+		t.Fatalf("CreateInputGuideDiff() = got error %v, want nil", err)
+	}
+	l, err := ts.ListInputGuideDiff(ctx, in.Partner, 10)
+	if err != nil {
+		t.Fatalf("ListInputGuideDiff() = got error %v, want nil", err)
+	}
+	do := cmpopts.IgnoreFields(storage.InputGuideDiff{}, "ID", "Created")
+	if len(l) != 1 || !cmp.Equal(d, l[0], do) {
+		t.Errorf("ListInputGuideDiff() = got %v, want %v", l, d)
+	}
+}
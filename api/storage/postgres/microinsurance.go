@@ -47,7 +47,9 @@ INSERT INTO micro_insurance_history (
 	error_message, 
 	error_type,
 	error_time,
-	org_id
+	org_id,
+	branch_id,
+	teller_id
 ) VALUES (
 	:coy,
     :dsa_id,
@@ -83,7 +85,9 @@ INSERT INTO micro_insurance_history (
 	:error_message, 
 	:error_type, 
 	:error_time,
-	:org_id
+	:org_id,
+	:branch_id,
+	:teller_id
 )
 RETURNING *;
 `
@@ -185,8 +189,13 @@ func (s *Storage) ListMicroInsuranceHistory(ctx context.Context,
 	ut := ""
 	tr := ""
 	tc := ":total_count_where:"
-	b := NewBuilder(fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM micro_insurance_history %s) 
-			SELECT *, cnt.total FROM micro_insurance_history left join cnt on true`, tc))
+	q := fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM micro_insurance_history %s) 
+			SELECT *, cnt.total FROM micro_insurance_history left join cnt on true`, tc)
+	if f.After != nil {
+		// keyset pages skip the total, counting scans every matching row.
+		q = `SELECT * FROM micro_insurance_history`
+	}
+	b := NewBuilder(q)
 	if !f.From.IsZero() {
 		ft = f.From.Format("2006-01-02")
 	}
@@ -210,6 +219,10 @@ func (s *Storage) ListMicroInsuranceHistory(ctx context.Context,
 	default:
 		scol = ""
 	}
+	if f.After != nil {
+		scol = ""
+		f.Offset = 0
+	}
 
 	if string(f.SortOrder) == "" {
 		f.SortOrder = storage.Desc
@@ -223,7 +236,13 @@ func (s *Storage) ListMicroInsuranceHistory(ctx context.Context,
 		Where("trx_date", ltOrEq, ut, CompareDate()).
 		Where("trx_date", eq, tr, CompareDate()).
 		Where("org_id", eq, f.OrgID).
-		SortByColumn(scol, f.SortOrder).
+		Where("branch_id", eq, f.BranchID).
+		Where("teller_id", eq, f.TellerID).
+		After("created", "id", f.After)
+	if scol == "" {
+		b.SortByKeyset("created", "id")
+	}
+	b.SortByColumn(scol, f.SortOrder).
 		Limit(f.Limit).
 		Offset(f.Offset).AddTotalQuery(tc)
 
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const upsertOtherInfoField = `
+INSERT INTO other_info_field (
+	partner,
+	name,
+	field_type,
+	required,
+	pattern,
+	options,
+	position,
+	updated_by
+) VALUES (
+	:partner,
+	:name,
+	:field_type,
+	:required,
+	:pattern,
+	:options,
+	:position,
+	:updated_by
+) ON CONFLICT (partner, name) DO UPDATE SET
+	field_type = EXCLUDED.field_type,
+	required = EXCLUDED.required,
+	pattern = EXCLUDED.pattern,
+	options = EXCLUDED.options,
+	position = EXCLUDED.position,
+	updated_by = EXCLUDED.updated_by
+RETURNING
+created, updated
+`
+
+// UpsertOtherInfoField adds or replaces an other info field of a partner.
+func (s *Storage) UpsertOtherInfoField(ctx context.Context, r storage.OtherInfoField) (*storage.OtherInfoField, error) {
+	log := logging.FromContext(ctx)
+	log.WithField("partner", r.Partner).WithField("name", r.Name).Trace("storing")
+
+	if r.Options == nil {
+		r.Options = pq.StringArray{}
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, upsertOtherInfoField)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		return nil, fmt.Errorf("executing other info field upsert: %w", err)
+	}
+	return &r, nil
+}
+
+const listOtherInfoField = `
+SELECT *
+FROM other_info_field
+WHERE ($1 = '' OR partner = $1)
+ORDER BY partner, position, name
+`
+
+// ListOtherInfoField lists the other info fields of a partner, or of all
+// partners if partner is empty.
+func (s *Storage) ListOtherInfoField(ctx context.Context, partner string) ([]storage.OtherInfoField, error) {
+	r := []storage.OtherInfoField{}
+	if err := s.db.SelectContext(ctx, &r, listOtherInfoField, partner); err != nil {
+		return nil, fmt.Errorf("executing other info field list: %w", err)
+	}
+	return r, nil
+}
+
+// DeleteOtherInfoField removes an other info field of a partner.
+func (s *Storage) DeleteOtherInfoField(ctx context.Context, partner, name string) error {
+	const deleteOtherInfoField = `DELETE FROM other_info_field WHERE partner = $1 AND name = $2`
+	res, err := s.db.ExecContext(ctx, deleteOtherInfoField, partner, name)
+	if err != nil {
+		return fmt.Errorf("executing other info field delete: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
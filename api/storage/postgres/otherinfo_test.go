@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestOtherInfoField(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+	ptnr := uuid.NewString()[:8]
+
+	in := []storage.OtherInfoField{
+		{
+			Partner:   ptnr,
+			Name:      "purpose",
+			Type:      storage.OtherInfoText,
+			Required:  true,
+			Options:   pq.StringArray{"GIFT", "SAVINGS"},
+			Position:  2,
+			UpdatedBy: "admin",
+		},
+		{
+			Partner:   ptnr,
+			Name:      "birth_date",
+			Type:      storage.OtherInfoDate,
+			Pattern:   `^\d{4}-\d{2}-\d{2}$`,
+			Options:   pq.StringArray{},
+			Position:  1,
+			UpdatedBy: "admin",
+		},
+	}
+	for _, f := range in {
+		if _, err := ts.UpsertOtherInfoField(ctx, f); err != nil {
+			t.Fatalf("UpsertOtherInfoField() = got error %v, want nil", err)
+		}
+	}
+	in[0].Required = false
+	if _, err := ts.UpsertOtherInfoField(ctx, in[0]); err != nil {
+		t.Fatalf("UpsertOtherInfoField() = got error %v, want nil", err)
+	}
+
+	got, err := ts.ListOtherInfoField(ctx, ptnr)
+	if err != nil {
+		t.Fatalf("ListOtherInfoField() = got error %v, want nil", err)
+	}
+	want := []storage.OtherInfoField{in[1], in[0]}
+	o := cmpopts.IgnoreFields(storage.OtherInfoField{}, "Created", "Updated")
+	if !cmp.Equal(want, got, o) {
+		t.Error(cmp.Diff(want, got, o))
+	}
+
+	if err := ts.DeleteOtherInfoField(ctx, ptnr, "purpose"); err != nil {
+		t.Fatalf("DeleteOtherInfoField() = got error %v, want nil", err)
+	}
+	if err := ts.DeleteOtherInfoField(ctx, ptnr, "purpose"); err != storage.ErrNotFound {
+		t.Fatalf("DeleteOtherInfoField() = got error %v, want not found", err)
+	}
+}
@@ -75,7 +75,9 @@ INSERT INTO remit_to_acc_history (
 	error_time,
 	error_type,
 	updated_by,
-	created_by
+	created_by,
+	branch_id,
+	teller_id
 ) VALUES (
 	:org_id,
 	:partner,
@@ -139,7 +141,9 @@ INSERT INTO remit_to_acc_history (
 	:error_time,
 	:error_type,
 	:updated_by,
-	:created_by
+	:created_by,
+	:branch_id,
+	:teller_id
 ) RETURNING *`
 
 func (s *Storage) CreateRTAHistory(ctx context.Context, req storage.RemitToAccountHistory) (*storage.RemitToAccountHistory, error) {
@@ -265,3 +269,67 @@ func (s *Storage) ListRTAHistory(ctx context.Context, f storage.RemitToAccountHi
 	}
 	return r, nil
 }
+
+func (s *Storage) ListRTATrx(ctx context.Context, f storage.RTATrxListFilter) ([]storage.RemitToAccountHistory, error) {
+	tc := ":total_count_where:"
+	q := fmt.Sprintf(`WITH cnt AS (select count(*) as total FROM remit_to_acc_history %s) SELECT *, cnt.total FROM remit_to_acc_history left join cnt on true`, tc)
+	if f.After != nil {
+		q = `SELECT * FROM remit_to_acc_history`
+	}
+	b := NewBuilder(q)
+	ft := ""
+	ut := ""
+	if !f.From.IsZero() {
+		ft = f.From.Format("2006-01-02")
+	}
+	if !f.Until.IsZero() {
+		ut = f.Until.Format("2006-01-02")
+	}
+
+	var scol string
+	switch f.SortByColumn {
+	case storage.ReferenceNumberRTACol:
+		scol = "reference_number"
+	case storage.PartnerRTACol:
+		scol = "partner"
+	case storage.TransactionTimeRTACol:
+		scol = "created"
+	// the amounts are stored as text
+	case storage.TotalAmountRTACol:
+		scol = "cast(NULLIF(total_amount, '') AS numeric)"
+	case storage.ServiceChargeRTACol:
+		scol = "cast(NULLIF(service_charge, '') AS numeric)"
+	}
+	if f.After != nil {
+		scol = ""
+		f.Offset = 0
+	}
+
+	b.Where("reference_number", eq, f.ReferenceNumber).
+		Where("org_id", eq, f.OrgID).
+		Where("partner", eq, f.Partner).
+		Where("txn_status", eq, f.TxnStatus).
+		Where("branch_id", eq, f.BranchID).
+		Where("teller_id", eq, f.TellerID).
+		WhereNotIn("partner", f.ExcludePartners).
+		Where("created", gtOrEq, ft, CompareDate()).
+		Where("created", ltOrEq, ut, CompareDate()).
+		After("created", "id", f.After)
+	if scol == "" {
+		b.SortByKeyset("created", "id")
+	}
+	b.SortByColumn(scol, f.SortOrder).
+		Limit(f.Limit).
+		Offset(f.Offset).AddTotalQuery(tc)
+
+	stmt, err := s.db.PrepareNamed(b.query)
+	if err != nil {
+		return nil, err
+	}
+
+	r := []storage.RemitToAccountHistory{}
+	if err := stmt.Select(&r, b.args); err != nil {
+		return nil, fmt.Errorf("executing RTA trx list: %w", err)
+	}
+	return r, nil
+}
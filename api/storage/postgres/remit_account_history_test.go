@@ -214,3 +214,95 @@ func TestRemitToAccountHistory(t *testing.T) {
 
 	}
 }
+
+func TestListRTATrx(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.Background()
+	oid := uuid.NewString()
+	bid, tid := uuid.NewString(), uuid.NewString()
+	for i, amt := range []string{"900.00", "1500.00", "50.00"} {
+		h := storage.RemitToAccountHistory{
+			OrgID:           oid,
+			Partner:         "UBRTA",
+			ReferenceNumber: "RTA-LIST-" + string(rune('A'+i)),
+			TotalAmount:     amt,
+			ServiceCharge:   "10",
+			TxnStatus:       string(storage.SuccessStatus),
+		}
+		if i == 0 {
+			h.BranchID, h.TellerID = bid, tid
+		}
+		if _, err := ts.CreateRTAHistory(ctx, h); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := ts.CreateRTAHistory(ctx, storage.RemitToAccountHistory{
+		OrgID:           uuid.NewString(),
+		Partner:         "UBRTA",
+		ReferenceNumber: "RTA-LIST-OTHER",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	refs := func(rs []storage.RemitToAccountHistory) []string {
+		var r []string
+		for _, v := range rs {
+			r = append(r, v.ReferenceNumber)
+		}
+		return r
+	}
+
+	got, err := ts.ListRTATrx(ctx, storage.RTATrxListFilter{
+		OrgID:        oid,
+		SortByColumn: storage.TotalAmountRTACol,
+		SortOrder:    storage.Desc,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"RTA-LIST-B", "RTA-LIST-A", "RTA-LIST-C"}; !cmp.Equal(want, refs(got)) {
+		t.Error("(-want +got): ", cmp.Diff(want, refs(got)))
+	}
+	if got[0].Total != 3 {
+		t.Errorf("want total 3, got %d", got[0].Total)
+	}
+
+	got, err = ts.ListRTATrx(ctx, storage.RTATrxListFilter{OrgID: oid, ReferenceNumber: "RTA-LIST-C"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"RTA-LIST-C"}; !cmp.Equal(want, refs(got)) {
+		t.Error("(-want +got): ", cmp.Diff(want, refs(got)))
+	}
+
+	got, err = ts.ListRTATrx(ctx, storage.RTATrxListFilter{OrgID: oid, BranchID: bid, TellerID: tid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"RTA-LIST-A"}; !cmp.Equal(want, refs(got)) {
+		t.Error("(-want +got): ", cmp.Diff(want, refs(got)))
+	}
+	if got[0].BranchID != bid || got[0].TellerID != tid {
+		t.Errorf("want branch %s teller %s, got %s %s", bid, tid, got[0].BranchID, got[0].TellerID)
+	}
+
+	f := storage.RTATrxListFilter{OrgID: oid, Limit: 2}
+	first, err := ts.ListRTATrx(ctx, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := first[len(first)-1]
+	f.After = &storage.Cursor{Created: last.Created, ID: last.ID}
+	next, err := ts.ListRTATrx(ctx, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first) != 2 || len(next) != 1 {
+		t.Fatalf("want pages of 2 and 1, got %d and %d", len(first), len(next))
+	}
+	for _, r := range first {
+		if r.ID == next[0].ID {
+			t.Errorf("%s listed on both pages", r.ReferenceNumber)
+		}
+	}
+}
@@ -30,7 +30,9 @@ INSERT INTO remit_history (
 	error_message,
 	error_time,
 	error_type,
-	transaction_type
+	transaction_type,
+	branch_id,
+	teller_id
 ) VALUES (
 	:remit_id,
 	:dsa_id,
@@ -49,7 +51,9 @@ INSERT INTO remit_history (
 	:error_message,
 	:error_time,
 	:error_type,
-	:transaction_type
+	:transaction_type,
+	:branch_id,
+	:teller_id
 ) RETURNING
 updated
 `
@@ -133,7 +137,12 @@ func (s *Storage) GetRemitHistory(ctx context.Context, txnID string) (*storage.R
 }
 
 func (s *Storage) ListRemitHistory(ctx context.Context, f storage.LRHFilter) ([]storage.RemitHistory, error) {
-	b := NewBuilder("SELECT *, count(*) OVER() AS total FROM remit_history")
+	q := "SELECT *, count(*) OVER() AS total FROM remit_history"
+	if f.After != nil {
+		// keyset pages skip the total, counting scans every matching row.
+		q = "SELECT * FROM remit_history"
+	}
+	b := NewBuilder(q)
 	ft := ""
 	ut := ""
 	if !f.From.IsZero() {
@@ -162,6 +171,10 @@ func (s *Storage) ListRemitHistory(ctx context.Context, f storage.LRHFilter) ([]
 	default:
 		scol = ""
 	}
+	if f.After != nil {
+		scol = ""
+		f.Offset = 0
+	}
 	exPtnrs := stringToSlice(f.ExcludePartner)
 	extyps := stringToSlice(f.ExcludeType)
 	b.Any("remco_control_number", f.ControlNo).
@@ -175,7 +188,13 @@ func (s *Storage) ListRemitHistory(ctx context.Context, f storage.LRHFilter) ([]
 		Where("txn_completed_time", gtOrEq, ft, CompareDate()).
 		Where("txn_completed_time", ltOrEq, ut, CompareDate()).
 		Where("transaction_type", eq, f.Transactiontype).
-		SortByColumn(scol, f.SortOrder).
+		Where("branch_id", eq, f.BranchID).
+		Where("teller_id", eq, f.TellerID).
+		After("created", "remit_id", f.After)
+	if scol == "" {
+		b.SortByKeyset("created", "remit_id")
+	}
+	b.SortByColumn(scol, f.SortOrder).
 		Limit(f.Limit).
 		Offset(f.Offset)
 
@@ -246,3 +265,39 @@ func (s *Storage) GetTransactionReport(ctx context.Context, pf *storage.LRHFilte
 	}
 	return &pfs, nil
 }
+
+const listCustomerRemitHistory = `
+SELECT *
+FROM remit_history
+WHERE dsa_id = $1
+AND (
+	sender_member_id = $2
+	OR receiver_member_id = $2
+	OR remittance->'remitter'->>'remco_member_id' = $2
+	OR remittance->'receiver'->>'remco_member_id' = $2
+	OR remittance->'account'->>'acct_no' = $2
+)
+AND created >= $3
+AND created < $4
+ORDER BY created DESC
+LIMIT $5
+`
+
+// ListCustomerRemitHistory lists the remittances of a customer, matched on the
+// partner member id or account number, newest first.
+func (s *Storage) ListCustomerRemitHistory(ctx context.Context, f storage.CustomerRemitFilter) ([]storage.RemitHistory, error) {
+	if f.DsaOrgID == "" || f.CustomerID == "" {
+		return nil, storage.ErrInvalid
+	}
+	if f.Until.IsZero() {
+		f.Until = time.Now()
+	}
+	if f.Limit <= 0 {
+		f.Limit = 100
+	}
+	r := []storage.RemitHistory{}
+	if err := s.db.SelectContext(ctx, &r, listCustomerRemitHistory, f.DsaOrgID, f.CustomerID, f.From, f.Until, f.Limit); err != nil {
+		return nil, fmt.Errorf("executing customer remit history list: %w", err)
+	}
+	return r, nil
+}
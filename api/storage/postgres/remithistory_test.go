@@ -269,7 +269,7 @@ func TestRemitHistory(t *testing.T) {
 	}
 
 	o := cmp.Options{
-		cmpopts.IgnoreFields(storage.RemitHistory{}, "Updated"),
+		cmpopts.IgnoreFields(storage.RemitHistory{}, "Updated", "Created"),
 	}
 	for _, ptnr := range ptnrs {
 		srcAmt, err := currency.NewMinor(ptnr.totAmount, "PHP")
@@ -873,3 +873,89 @@ func TestRemitHistory(t *testing.T) {
 		})
 	}
 }
+
+func TestRemitHistoryKeyset(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	dsaID := uuid.NewString()
+	for i := 0; i < 3; i++ {
+		if _, err := ts.CreateRemitHistory(ctx, storage.RemitHistory{
+			TxnID:          uuid.NewString(),
+			DsaOrderID:     uuid.NewString(),
+			DsaID:          dsaID,
+			UserID:         "user",
+			RemcoID:        "WU",
+			RemcoControlNo: uuid.NewString(),
+			TxnStatus:      string(storage.SuccessStatus),
+			TxnStep:        string(storage.ConfirmStep),
+		}); err != nil {
+			t.Fatalf("CreateRemitHistory() = got error %v, want nil", err)
+		}
+	}
+
+	f := storage.LRHFilter{DsaOrgID: dsaID, Limit: 2}
+	p1, err := ts.ListRemitHistory(ctx, f)
+	if err != nil {
+		t.Fatalf("ListRemitHistory() = got error %v, want nil", err)
+	}
+	if len(p1) != 2 || p1[0].Total != 3 {
+		t.Fatalf("ListRemitHistory() = got %d rows of %d, want 2 of 3", len(p1), p1[0].Total)
+	}
+
+	last := p1[len(p1)-1]
+	f.After = &storage.Cursor{Created: last.Created, ID: last.TxnID}
+	p2, err := ts.ListRemitHistory(ctx, f)
+	if err != nil {
+		t.Fatalf("ListRemitHistory() = got error %v, want nil", err)
+	}
+	if len(p2) != 1 {
+		t.Fatalf("ListRemitHistory() = got %d rows, want 1", len(p2))
+	}
+	for _, r := range p1 {
+		if r.TxnID == p2[0].TxnID {
+			t.Fatal("ListRemitHistory() = next page repeats a row")
+		}
+	}
+}
+
+func TestListCustomerRemitHistory(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	dsaID := uuid.NewString()
+	card := uuid.NewString()
+	for _, r := range []storage.RemitHistory{
+		{SenderID: card},
+		{Remittance: storage.Remittance{Receiver: storage.Contact{RemcoMemberID: card}}},
+		{SenderID: "someone-else"},
+	} {
+		r.TxnID = uuid.NewString()
+		r.DsaOrderID = uuid.NewString()
+		r.DsaID = dsaID
+		r.RemcoID = "WU"
+		r.RemcoControlNo = uuid.NewString()
+		if _, err := ts.CreateRemitHistory(ctx, r); err != nil {
+			t.Fatalf("CreateRemitHistory() = got error %v, want nil", err)
+		}
+	}
+
+	got, err := ts.ListCustomerRemitHistory(ctx, storage.CustomerRemitFilter{
+		DsaOrgID:   dsaID,
+		CustomerID: card,
+		From:       time.Now().Add(-time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("ListCustomerRemitHistory() = got error %v, want nil", err)
+	}
+	if len(got) != 2 {
+		t.Errorf("ListCustomerRemitHistory() = got %d rows, want 2", len(got))
+	}
+
+	if _, err := ts.ListCustomerRemitHistory(ctx, storage.CustomerRemitFilter{DsaOrgID: dsaID}); err != storage.ErrInvalid {
+		t.Errorf("ListCustomerRemitHistory() = got error %v, want %v", err, storage.ErrInvalid)
+	}
+	if _, err := ts.ListCustomerRemitHistory(ctx, storage.CustomerRemitFilter{CustomerID: card}); err != storage.ErrInvalid {
+		t.Errorf("ListCustomerRemitHistory() = got error %v, want %v", err, storage.ErrInvalid)
+	}
+}
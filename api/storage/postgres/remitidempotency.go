@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const createRemitIdempotency = `
+INSERT INTO remit_idempotency (
+	dsa_id,
+	idempotency_key,
+	method,
+	request_hash
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4
+)
+ON CONFLICT (dsa_id, idempotency_key) DO UPDATE
+SET
+	method = EXCLUDED.method,
+	request_hash = EXCLUDED.request_hash,
+	response = NULL,
+	error = NULL,
+	created = NOW(),
+	updated = NOW()
+WHERE remit_idempotency.created < $5
+RETURNING *
+`
+
+// CreateRemitIdempotency reserves an idempotency key for a request. A key
+// created before the cutoff is considered expired and is taken over by the new
+// request. storage.Conflict is returned when the key is still in use.
+func (s *Storage) CreateRemitIdempotency(ctx context.Context, r storage.RemitIdempotency, cutoff time.Time) (*storage.RemitIdempotency, error) {
+	log := logging.FromContext(ctx)
+	log.WithField("idempotency_key", r.IdemKey).Trace("storing")
+
+	var ri storage.RemitIdempotency
+	if err := s.db.GetContext(ctx, &ri, createRemitIdempotency, r.DsaID, r.IdemKey, r.Method, r.RequestHash, cutoff); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing remit idempotency insert: %w", err)
+	}
+	return &ri, nil
+}
+
+const completeRemitIdempotency = `
+UPDATE remit_idempotency
+SET
+	response = :response,
+	error = :error,
+	updated = NOW()
+WHERE dsa_id = :dsa_id AND idempotency_key = :idempotency_key
+RETURNING updated
+`
+
+// CompleteRemitIdempotency stores the response, or the error of a failed
+// request, for a reserved idempotency key.
+func (s *Storage) CompleteRemitIdempotency(ctx context.Context, r storage.RemitIdempotency) (*storage.RemitIdempotency, error) {
+	stmt, err := s.db.PrepareNamedContext(ctx, completeRemitIdempotency)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing remit idempotency update: %w", err)
+	}
+	return &r, nil
+}
+
+const getRemitIdempotency = `
+SELECT *
+FROM remit_idempotency
+WHERE dsa_id = $1 AND idempotency_key = $2
+`
+
+func (s *Storage) GetRemitIdempotency(ctx context.Context, dsaID, key string) (*storage.RemitIdempotency, error) {
+	var r storage.RemitIdempotency
+	if err := s.db.GetContext(ctx, &r, getRemitIdempotency, dsaID, key); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return &r, nil
+}
+
+const deleteRemitIdempotency = `
+DELETE FROM remit_idempotency
+WHERE dsa_id = $1 AND idempotency_key = $2
+`
+
+// DeleteRemitIdempotency releases an idempotency key so the request can be
+// retried, used when the original request failed.
+func (s *Storage) DeleteRemitIdempotency(ctx context.Context, dsaID, key string) error {
+	if _, err := s.db.ExecContext(ctx, deleteRemitIdempotency, dsaID, key); err != nil {
+		return fmt.Errorf("executing remit idempotency delete: %w", err)
+	}
+	return nil
+}
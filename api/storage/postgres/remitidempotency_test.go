@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"brank.as/petnet/api/storage"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestRemitIdempotency(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	in := storage.RemitIdempotency{
+		DsaID:       uuid.NewString(),
+		IdemKey:     uuid.NewString(),
+		Method:      "ConfirmRemit",
+		RequestHash: "test-request-hash",
+	}
+	r, err := ts.CreateRemitIdempotency(ctx, in, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("CreateRemitIdempotency() = got error %v, want nil", err)
+	}
+	if r.Response != nil {
+		t.Fatal("CreateRemitIdempotency() = want empty response")
+	}
+
+	if _, err := ts.CreateRemitIdempotency(ctx, in, time.Now().Add(-time.Hour)); err != storage.Conflict {
+		t.Fatalf("CreateRemitIdempotency() = got error %v, want %v", err, storage.Conflict)
+	}
+
+	r.Response = []byte("test-response")
+	if _, err := ts.CompleteRemitIdempotency(ctx, *r); err != nil {
+		t.Fatalf("CompleteRemitIdempotency() = got error %v, want nil", err)
+	}
+	got, err := ts.GetRemitIdempotency(ctx, in.DsaID, in.IdemKey)
+	if err != nil {
+		t.Fatalf("GetRemitIdempotency() = got error %v, want nil", err)
+	}
+	if !cmp.Equal(r.Response, got.Response) {
+		t.Fatal(cmp.Diff(r.Response, got.Response))
+	}
+
+	// an expired key is taken over by the new request
+	in.RequestHash = "test-request-hash-updated"
+	ex, err := ts.CreateRemitIdempotency(ctx, in, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("CreateRemitIdempotency() = got error %v, want nil", err)
+	}
+	if ex.RequestHash != in.RequestHash || ex.Response != nil {
+		t.Fatalf("CreateRemitIdempotency() = got %+v, want reset key", ex)
+	}
+
+	if err := ts.DeleteRemitIdempotency(ctx, in.DsaID, in.IdemKey); err != nil {
+		t.Fatalf("DeleteRemitIdempotency() = got error %v, want nil", err)
+	}
+	if _, err := ts.GetRemitIdempotency(ctx, in.DsaID, in.IdemKey); err != storage.ErrNotFound {
+		t.Fatalf("GetRemitIdempotency() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"brank.as/petnet/api/storage"
+)
+
+const upsertRemitOTP = `
+INSERT INTO remit_otp (
+	transaction_id,
+	dsa_id,
+	user_id,
+	remco_id,
+	event_id,
+	expires
+) VALUES (
+	:transaction_id,
+	:dsa_id,
+	:user_id,
+	:remco_id,
+	:event_id,
+	:expires
+)
+ON CONFLICT (transaction_id) DO UPDATE
+SET
+	dsa_id = EXCLUDED.dsa_id,
+	user_id = EXCLUDED.user_id,
+	remco_id = EXCLUDED.remco_id,
+	event_id = EXCLUDED.event_id,
+	expires = EXCLUDED.expires,
+	attempts = 0,
+	verified = NULL
+RETURNING *
+`
+
+// UpsertRemitOTP stores the OTP issued for a transaction, replacing any OTP
+// issued before for it.
+func (s *Storage) UpsertRemitOTP(ctx context.Context, r storage.RemitOTP) (*storage.RemitOTP, error) {
+	stmt, err := s.db.PrepareNamedContext(ctx, upsertRemitOTP)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var o storage.RemitOTP
+	if err := stmt.Get(&o, r); err != nil {
+		return nil, fmt.Errorf("executing remit otp upsert: %w", err)
+	}
+	return &o, nil
+}
+
+func (s *Storage) GetRemitOTP(ctx context.Context, txnID string) (*storage.RemitOTP, error) {
+	const getRemitOTP = `SELECT * FROM remit_otp WHERE transaction_id = $1`
+	var r storage.RemitOTP
+	if err := s.db.GetContext(ctx, &r, getRemitOTP, txnID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing remit otp get: %w", err)
+	}
+	return &r, nil
+}
+
+const attemptRemitOTP = `
+UPDATE remit_otp
+SET attempts = attempts + 1
+WHERE transaction_id = $1
+RETURNING *
+`
+
+// AttemptRemitOTP counts an attempt to validate the OTP of a transaction.
+func (s *Storage) AttemptRemitOTP(ctx context.Context, txnID string) (*storage.RemitOTP, error) {
+	var r storage.RemitOTP
+	if err := s.db.GetContext(ctx, &r, attemptRemitOTP, txnID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing remit otp attempt: %w", err)
+	}
+	return &r, nil
+}
+
+const verifyRemitOTP = `
+UPDATE remit_otp
+SET verified = NOW()
+WHERE transaction_id = $1
+RETURNING *
+`
+
+// VerifyRemitOTP marks the OTP of a transaction as validated.
+func (s *Storage) VerifyRemitOTP(ctx context.Context, txnID string) (*storage.RemitOTP, error) {
+	var r storage.RemitOTP
+	if err := s.db.GetContext(ctx, &r, verifyRemitOTP, txnID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing remit otp verify: %w", err)
+	}
+	return &r, nil
+}
+
+const setRemitHistoryOTPUsed = `
+UPDATE remit_history
+SET otp_used = true
+WHERE remit_id = $1
+`
+
+// SetRemitHistoryOTPUsed records that the transaction was confirmed with an
+// OTP.
+func (s *Storage) SetRemitHistoryOTPUsed(ctx context.Context, txnID string) error {
+	res, err := s.db.ExecContext(ctx, setRemitHistoryOTPUsed, txnID)
+	if err != nil {
+		return fmt.Errorf("executing remit history otp update: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"brank.as/petnet/api/storage"
+	"github.com/google/uuid"
+)
+
+func TestRemitOTP(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	in := storage.RemitOTP{
+		TxnID:   uuid.NewString(),
+		DsaID:   uuid.NewString(),
+		UserID:  uuid.NewString(),
+		RemcoID: "WU",
+		EventID: uuid.NewString(),
+		Expires: time.Now().Add(time.Minute),
+	}
+	if _, err := ts.UpsertRemitOTP(ctx, in); err != nil {
+		t.Fatalf("UpsertRemitOTP() = got error %v, want nil", err)
+	}
+	r, err := ts.AttemptRemitOTP(ctx, in.TxnID)
+	if err != nil {
+		t.Fatalf("AttemptRemitOTP() = got error %v, want nil", err)
+	}
+	if r.Attempts != 1 {
+		t.Fatalf("AttemptRemitOTP() = got %d attempts, want 1", r.Attempts)
+	}
+	if _, err := ts.VerifyRemitOTP(ctx, in.TxnID); err != nil {
+		t.Fatalf("VerifyRemitOTP() = got error %v, want nil", err)
+	}
+	got, err := ts.GetRemitOTP(ctx, in.TxnID)
+	if err != nil {
+		t.Fatalf("GetRemitOTP() = got error %v, want nil", err)
+	}
+	if !got.Verified.Valid || got.EventID != in.EventID {
+		t.Fatalf("GetRemitOTP() = got %+v, want verified otp", got)
+	}
+
+	// issuing a new otp resets the attempts
+	in.EventID = uuid.NewString()
+	re, err := ts.UpsertRemitOTP(ctx, in)
+	if err != nil {
+		t.Fatalf("UpsertRemitOTP() = got error %v, want nil", err)
+	}
+	if re.Attempts != 0 || re.Verified.Valid || re.EventID != in.EventID {
+		t.Fatalf("UpsertRemitOTP() = got %+v, want reset otp", re)
+	}
+
+	if _, err := ts.GetRemitOTP(ctx, uuid.NewString()); err != storage.ErrNotFound {
+		t.Fatalf("GetRemitOTP() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+	if err := ts.SetRemitHistoryOTPUsed(ctx, uuid.NewString()); err != storage.ErrNotFound {
+		t.Fatalf("SetRemitHistoryOTPUsed() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+}
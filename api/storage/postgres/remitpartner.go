@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const createRemitPartner = `
+INSERT INTO remit_partner (
+	code,
+	name,
+	countries,
+	send_types,
+	disburse_types,
+	enabled,
+	updated_by
+) VALUES (
+	:code,
+	:name,
+	:countries,
+	:send_types,
+	:disburse_types,
+	:enabled,
+	:updated_by
+) RETURNING
+created, updated
+`
+
+// CreateRemitPartner adds a partner to the partner registry.
+// storage.Conflict is returned if the partner code is already registered.
+func (s *Storage) CreateRemitPartner(ctx context.Context, r storage.RemitPartner) (*storage.RemitPartner, error) {
+	log := logging.FromContext(ctx)
+	log.WithField("remit_partner", r.Code).Trace("storing")
+
+	if r.SendTypes == nil {
+		r.SendTypes = []byte("{}")
+	}
+	if r.DisburseTypes == nil {
+		r.DisburseTypes = []byte("{}")
+	}
+	if r.Countries == nil {
+		r.Countries = pq.StringArray{}
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, createRemitPartner)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		if pErr, ok := err.(*pq.Error); ok && pErr.Code == pqUnique {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing remit partner insert: %w", err)
+	}
+	return &r, nil
+}
+
+const updateRemitPartner = `
+UPDATE remit_partner SET
+	name = :name,
+	countries = :countries,
+	send_types = :send_types,
+	disburse_types = :disburse_types,
+	enabled = :enabled,
+	updated_by = :updated_by,
+	updated = now()
+WHERE code = :code
+RETURNING
+created, updated
+`
+
+// UpdateRemitPartner updates a registered partner.
+func (s *Storage) UpdateRemitPartner(ctx context.Context, r storage.RemitPartner) (*storage.RemitPartner, error) {
+	log := logging.FromContext(ctx)
+	log.WithField("remit_partner", r.Code).Trace("updating")
+
+	if r.SendTypes == nil {
+		r.SendTypes = []byte("{}")
+	}
+	if r.DisburseTypes == nil {
+		r.DisburseTypes = []byte("{}")
+	}
+	if r.Countries == nil {
+		r.Countries = pq.StringArray{}
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, updateRemitPartner)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing remit partner update: %w", err)
+	}
+	return &r, nil
+}
+
+// GetRemitPartner returns a registered partner by code.
+func (s *Storage) GetRemitPartner(ctx context.Context, code string) (*storage.RemitPartner, error) {
+	const getRemitPartner = `SELECT * FROM remit_partner WHERE code = $1`
+	var r storage.RemitPartner
+	if err := s.db.GetContext(ctx, &r, getRemitPartner, code); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("executing remit partner get: %w", err)
+	}
+	return &r, nil
+}
+
+const listRemitPartner = `
+SELECT *
+FROM remit_partner
+WHERE ($1 = '' OR $1 = ANY(countries))
+	AND (NOT $2 OR enabled)
+ORDER BY code
+`
+
+// ListRemitPartner lists the registered partners, optionally only the enabled
+// partners of a country.
+func (s *Storage) ListRemitPartner(ctx context.Context, f storage.RemitPartnerFilter) ([]storage.RemitPartner, error) {
+	r := []storage.RemitPartner{}
+	if err := s.db.SelectContext(ctx, &r, listRemitPartner, f.Country, f.EnabledOnly); err != nil {
+		return nil, fmt.Errorf("executing remit partner list: %w", err)
+	}
+	return r, nil
+}
+
+// DeleteRemitPartner removes a partner from the partner registry.
+func (s *Storage) DeleteRemitPartner(ctx context.Context, code string) error {
+	const deleteRemitPartner = `DELETE FROM remit_partner WHERE code = $1`
+	res, err := s.db.ExecContext(ctx, deleteRemitPartner, code)
+	if err != nil {
+		return fmt.Errorf("executing remit partner delete: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestRemitPartner(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+	code := uuid.NewString()[:8]
+
+	in := storage.RemitPartner{
+		Code:          code,
+		Name:          "Test Partner",
+		Countries:     pq.StringArray{"PH", "SG"},
+		SendTypes:     []byte(`{"Send": {"code": "SO", "description": "Send money transaction.", "receiver": true}}`),
+		DisburseTypes: []byte(`{"Payout": {"code": "PO", "description": "Payout a transaction."}}`),
+		Enabled:       true,
+		UpdatedBy:     "admin",
+	}
+	if _, err := ts.CreateRemitPartner(ctx, in); err != nil {
+		t.Fatalf("CreateRemitPartner() = got error %v, want nil", err)
+	}
+	if _, err := ts.CreateRemitPartner(ctx, in); err != storage.Conflict {
+		t.Fatalf("CreateRemitPartner() = got error %v, want conflict", err)
+	}
+
+	o := cmp.Options{
+		cmpopts.IgnoreFields(storage.RemitPartner{}, "Created", "Updated", "SendTypes", "DisburseTypes"),
+	}
+	got, err := ts.GetRemitPartner(ctx, code)
+	if err != nil {
+		t.Fatalf("GetRemitPartner() = got error %v, want nil", err)
+	}
+	if !cmp.Equal(in, *got, o) {
+		t.Error(cmp.Diff(in, *got, o))
+	}
+
+	in.Enabled = false
+	in.Countries = pq.StringArray{"SG"}
+	if _, err := ts.UpdateRemitPartner(ctx, in); err != nil {
+		t.Fatalf("UpdateRemitPartner() = got error %v, want nil", err)
+	}
+	listed := func(f storage.RemitPartnerFilter) bool {
+		l, err := ts.ListRemitPartner(ctx, f)
+		if err != nil {
+			t.Fatalf("ListRemitPartner() = got error %v, want nil", err)
+		}
+		for _, p := range l {
+			if p.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+	if !listed(storage.RemitPartnerFilter{Country: "SG"}) {
+		t.Error("ListRemitPartner() = want partner listed in SG")
+	}
+	if listed(storage.RemitPartnerFilter{Country: "PH"}) {
+		t.Error("ListRemitPartner() = partner listed in PH")
+	}
+	if listed(storage.RemitPartnerFilter{EnabledOnly: true}) {
+		t.Error("ListRemitPartner() = disabled partner listed")
+	}
+
+	if err := ts.DeleteRemitPartner(ctx, code); err != nil {
+		t.Fatalf("DeleteRemitPartner() = got error %v, want nil", err)
+	}
+	if _, err := ts.GetRemitPartner(ctx, code); err != storage.ErrNotFound {
+		t.Fatalf("GetRemitPartner() = got error %v, want not found", err)
+	}
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"brank.as/petnet/api/storage"
+)
+
+const listPayoutPending = `
+SELECT *
+FROM remit_history
+WHERE remco_id = $1
+AND remit_type = 'SEND'
+AND txn_step = 'CONFIRM'
+AND txn_status = 'SUCCESS'
+AND remco_control_number <> ''
+AND payout_status NOT IN ('PAID', 'CANCELLED')
+AND txn_completed_time >= $2
+AND txn_completed_time < $3
+AND (payout_checked IS NULL OR payout_checked < $4)
+ORDER BY payout_checked NULLS FIRST, txn_completed_time
+LIMIT $5
+`
+
+// ListPayoutPending lists the sent remittances of a partner still waiting to
+// be picked up, least recently checked first.
+func (s *Storage) ListPayoutPending(ctx context.Context, f storage.PayoutFilter) ([]storage.RemitHistory, error) {
+	if f.Partner == "" {
+		return nil, storage.ErrInvalid
+	}
+	if f.Limit <= 0 {
+		f.Limit = 100
+	}
+	r := []storage.RemitHistory{}
+	if err := s.db.SelectContext(ctx, &r, listPayoutPending, f.Partner, f.From, f.Until, f.CheckedBefore, f.Limit); err != nil {
+		return nil, fmt.Errorf("executing payout pending list: %w", err)
+	}
+	return r, nil
+}
+
+const updateRemitPayoutStatus = `
+UPDATE remit_history
+SET
+	payout_status = $2,
+	payout_checked = NOW(),
+	paid_out_time = COALESCE(paid_out_time, $3)
+WHERE remit_id = $1
+`
+
+// UpdateRemitPayoutStatus records the payout status of a sent remittance. The
+// paid out time is kept once set.
+func (s *Storage) UpdateRemitPayoutStatus(ctx context.Context, txnID, status string, paidOut sql.NullTime) error {
+	if txnID == "" {
+		return storage.ErrInvalid
+	}
+	res, err := s.db.ExecContext(ctx, updateRemitPayoutStatus, txnID, status, paidOut)
+	if err != nil {
+		return fmt.Errorf("executing remit payout status update: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
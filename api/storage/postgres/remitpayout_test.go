@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestRemitPayout(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+	now := time.Now()
+
+	partner := "WU" + uuid.NewString()[:8]
+	ids := []string{}
+	for _, r := range []storage.RemitHistory{
+		{RemType: string(storage.SendType), TxnStatus: string(storage.SuccessStatus), TxnStep: string(storage.ConfirmStep)},
+		{RemType: string(storage.SendType), TxnStatus: string(storage.SuccessStatus), TxnStep: string(storage.ConfirmStep)},
+		{RemType: string(storage.DisburseType), TxnStatus: string(storage.SuccessStatus), TxnStep: string(storage.ConfirmStep)},
+		{RemType: string(storage.SendType), TxnStatus: string(storage.FailStatus), TxnStep: string(storage.ConfirmStep)},
+	} {
+		r.TxnID = uuid.NewString()
+		r.DsaOrderID = uuid.NewString()
+		r.DsaID = uuid.NewString()
+		r.RemcoID = partner
+		r.RemcoControlNo = uuid.NewString()
+		if _, err := ts.CreateRemitHistory(ctx, r); err != nil {
+			t.Fatalf("CreateRemitHistory() = got error %v, want nil", err)
+		}
+		if err := ts.UpdateRemitHistoryDate(ctx, r.TxnID, now); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, r.TxnID)
+	}
+
+	f := storage.PayoutFilter{
+		Partner:       partner,
+		From:          now.Add(-time.Hour),
+		Until:         now.Add(time.Hour),
+		CheckedBefore: now.Add(-time.Minute),
+	}
+	l, err := ts.ListPayoutPending(ctx, f)
+	if err != nil {
+		t.Fatalf("ListPayoutPending() = got error %v, want nil", err)
+	}
+	if len(l) != 2 {
+		t.Fatalf("ListPayoutPending() = got %d rows, want the 2 confirmed sends", len(l))
+	}
+
+	paid := sql.NullTime{Valid: true, Time: now.Truncate(time.Second)}
+	if err := ts.UpdateRemitPayoutStatus(ctx, ids[0], "PAID", paid); err != nil {
+		t.Fatalf("UpdateRemitPayoutStatus() = got error %v, want nil", err)
+	}
+	if err := ts.UpdateRemitPayoutStatus(ctx, ids[1], "AVAILABLE", sql.NullTime{}); err != nil {
+		t.Fatalf("UpdateRemitPayoutStatus() = got error %v, want nil", err)
+	}
+	if err := ts.UpdateRemitPayoutStatus(ctx, uuid.NewString(), "PAID", paid); err != storage.ErrNotFound {
+		t.Errorf("UpdateRemitPayoutStatus() = got error %v, want %v", err, storage.ErrNotFound)
+	}
+
+	// the paid one is done, the available one was just checked
+	if l, err = ts.ListPayoutPending(ctx, f); err != nil || len(l) != 0 {
+		t.Fatalf("ListPayoutPending() = got %d rows, error %v, want none", len(l), err)
+	}
+	f.CheckedBefore = now.Add(time.Hour)
+	if l, err = ts.ListPayoutPending(ctx, f); err != nil || len(l) != 1 || l[0].TxnID != ids[1] {
+		t.Fatalf("ListPayoutPending() = got %v, error %v, want the available send", l, err)
+	}
+
+	r, err := ts.GetRemitHistory(ctx, ids[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.PayoutStatus != "PAID" || !r.PaidOutTime.Valid || !r.PayoutChecked.Valid {
+		t.Errorf("GetRemitHistory() = got payout %q paid %v checked %v", r.PayoutStatus, r.PaidOutTime, r.PayoutChecked)
+	}
+}
@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"brank.as/petnet/api/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+const listReconcileRemit = `
+SELECT rh.*
+FROM remit_history rh
+LEFT JOIN remit_reconcile_attempt ra ON ra.remit_id = rh.remit_id
+WHERE (
+	(rh.txn_step = 'STAGE' AND rh.txn_status = 'SUCCESS')
+	OR (rh.txn_step = 'CONFIRM' AND rh.txn_status = 'FAIL')
+)
+AND rh.updated >= $1
+AND rh.updated < $2
+AND (ra.attempted IS NULL OR ra.attempted < $3)
+AND NOT EXISTS (SELECT 1 FROM remit_reconcile rr WHERE rr.remit_id = rh.remit_id)
+ORDER BY ra.attempted NULLS FIRST, rh.updated
+LIMIT $4
+`
+
+// ListReconcileRemit lists the transactions that were staged but never
+// confirmed or that failed on confirm and have not been reconciled yet, least
+// recently attempted first.
+func (s *Storage) ListReconcileRemit(ctx context.Context, f storage.ReconcileFilter) ([]storage.RemitHistory, error) {
+	if f.Limit <= 0 {
+		f.Limit = 100
+	}
+	if f.AttemptedBefore.IsZero() {
+		f.AttemptedBefore = time.Now()
+	}
+	r := []storage.RemitHistory{}
+	if err := s.db.SelectContext(ctx, &r, listReconcileRemit, f.From, f.Until, f.AttemptedBefore, f.Limit); err != nil {
+		return nil, fmt.Errorf("executing remit reconcile list: %w", err)
+	}
+	return r, nil
+}
+
+const recordReconcileAttempt = `
+INSERT INTO remit_reconcile_attempt (remit_id, attempts, attempted)
+VALUES ($1, 1, NOW())
+ON CONFLICT (remit_id) DO UPDATE SET
+	attempts = remit_reconcile_attempt.attempts + 1,
+	attempted = NOW()
+`
+
+// RecordReconcileAttempt records a reconciliation attempt that got no answer
+// from the partner, so the transaction is tried again after the others.
+func (s *Storage) RecordReconcileAttempt(ctx context.Context, txnID string) error {
+	if txnID == "" {
+		return storage.ErrInvalid
+	}
+	if _, err := s.db.ExecContext(ctx, recordReconcileAttempt, txnID); err != nil {
+		return fmt.Errorf("executing remit reconcile attempt upsert: %w", err)
+	}
+	return nil
+}
+
+const createRemitReconcile = `
+INSERT INTO remit_reconcile (
+	remit_id,
+	remco_id,
+	remit_type,
+	remco_control_number,
+	from_status,
+	from_step,
+	to_status,
+	to_step,
+	partner_status,
+	message
+) VALUES (
+	:remit_id,
+	:remco_id,
+	:remit_type,
+	:remco_control_number,
+	:from_status,
+	:from_step,
+	:to_status,
+	:to_step,
+	:partner_status,
+	:message
+) RETURNING
+id, created
+`
+
+const reconcileRemitHistory = `
+UPDATE remit_history
+SET
+	txn_status = :to_status,
+	txn_step = :to_step,
+	txn_completed_time = COALESCE(txn_completed_time, NOW())
+WHERE remit_id = :remit_id
+`
+
+const reconcileRemitCache = `
+UPDATE remit_cache
+SET
+	status = $2
+WHERE transaction_id = $1
+`
+
+// ReconcileRemit records the reconciliation result in the audit trail and
+// updates the remit history and, if step is set, the remit cache status.
+func (s *Storage) ReconcileRemit(ctx context.Context, r storage.RemitReconcile, step storage.TxnStep) (*storage.RemitReconcile, error) {
+	log := logging.FromContext(ctx)
+	log.WithField("remit_reconcile", r).Trace("storing")
+
+	tx, err := s.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelReadCommitted})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			logging.WithError(err, log).Error("remit reconcile rollback")
+		}
+	}()
+
+	stmt, err := tx.PrepareNamedContext(ctx, createRemitReconcile)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&r, r); err != nil {
+		return nil, fmt.Errorf("executing remit reconcile insert: %w", err)
+	}
+	if _, err := tx.NamedExecContext(ctx, reconcileRemitHistory, r); err != nil {
+		return nil, fmt.Errorf("executing remit reconcile history update: %w", err)
+	}
+	if step != "" {
+		if _, err := tx.ExecContext(ctx, reconcileRemitCache, r.TxnID, step); err != nil {
+			return nil, fmt.Errorf("executing remit reconcile cache update: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing remit reconcile: %w", err)
+	}
+	return &r, nil
+}
+
+const listRemitReconcile = `
+SELECT *
+FROM remit_reconcile
+WHERE remit_id = $1
+ORDER BY created
+`
+
+// ListRemitReconcile returns the reconciliation audit trail of a transaction.
+func (s *Storage) ListRemitReconcile(ctx context.Context, txnID string) ([]storage.RemitReconcile, error) {
+	r := []storage.RemitReconcile{}
+	if err := s.db.SelectContext(ctx, &r, listRemitReconcile, txnID); err != nil {
+		return nil, fmt.Errorf("executing remit reconcile audit list: %w", err)
+	}
+	return r, nil
+}
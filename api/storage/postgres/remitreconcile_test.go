@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestRemitReconcile(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+
+	dsaID := uuid.NewString()
+	newHistory := func(sts storage.TxnStatus, step storage.TxnStep) storage.RemitHistory {
+		h, err := ts.CreateRemitHistory(ctx, storage.RemitHistory{
+			DsaOrderID:     uuid.NewString(),
+			TxnID:          uuid.NewString(),
+			DsaID:          dsaID,
+			UserID:         "user",
+			RemcoID:        "WU",
+			RemType:        string(storage.DisburseType),
+			RemcoControlNo: uuid.NewString(),
+			TxnStagedTime:  sql.NullTime{Time: time.Now(), Valid: true},
+			TxnStatus:      string(sts),
+			TxnStep:        string(step),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *h
+	}
+	staged := newHistory(storage.SuccessStatus, storage.StageStep)
+	failed := newHistory(storage.FailStatus, storage.ConfirmStep)
+	newHistory(storage.SuccessStatus, storage.ConfirmStep)
+
+	f := storage.ReconcileFilter{
+		From:  time.Now().Add(-time.Hour),
+		Until: time.Now().Add(time.Hour),
+	}
+	got, err := ts.ListReconcileRemit(ctx, f)
+	if err != nil {
+		t.Fatalf("ListReconcileRemit() = got error %v, want nil", err)
+	}
+	ids := map[string]bool{}
+	for _, h := range got {
+		if h.DsaID == dsaID {
+			ids[h.TxnID] = true
+		}
+	}
+	want := map[string]bool{staged.TxnID: true, failed.TxnID: true}
+	if !cmp.Equal(want, ids) {
+		t.Fatal(cmp.Diff(want, ids))
+	}
+
+	if err := ts.RecordReconcileAttempt(ctx, ""); err != storage.ErrInvalid {
+		t.Fatalf("RecordReconcileAttempt() = got error %v, want %v", err, storage.ErrInvalid)
+	}
+	if err := ts.RecordReconcileAttempt(ctx, failed.TxnID); err != nil {
+		t.Fatalf("RecordReconcileAttempt() = got error %v, want nil", err)
+	}
+	// the attempted transaction waits out the retry, then comes last
+	f.AttemptedBefore = time.Now().Add(-time.Minute)
+	got, err = ts.ListReconcileRemit(ctx, f)
+	if err != nil {
+		t.Fatalf("ListReconcileRemit() = got error %v, want nil", err)
+	}
+	for _, h := range got {
+		if h.TxnID == failed.TxnID {
+			t.Fatal("ListReconcileRemit() = attempted transaction listed before the retry")
+		}
+	}
+	f.AttemptedBefore = time.Now().Add(time.Minute)
+	got, err = ts.ListReconcileRemit(ctx, f)
+	if err != nil {
+		t.Fatalf("ListReconcileRemit() = got error %v, want nil", err)
+	}
+	var order []string
+	for _, h := range got {
+		if h.DsaID == dsaID {
+			order = append(order, h.TxnID)
+		}
+	}
+	if wo := []string{staged.TxnID, failed.TxnID}; !cmp.Equal(wo, order) {
+		t.Fatal(cmp.Diff(wo, order))
+	}
+	f.AttemptedBefore = time.Time{}
+
+	in := storage.RemitReconcile{
+		TxnID:          staged.TxnID,
+		RemcoID:        staged.RemcoID,
+		RemType:        staged.RemType,
+		RemcoControlNo: staged.RemcoControlNo,
+		FromStatus:     staged.TxnStatus,
+		FromStep:       staged.TxnStep,
+		ToStatus:       string(storage.SuccessStatus),
+		ToStep:         string(storage.ConfirmStep),
+		PartnerStatus:  "PAID",
+		Message:        "paid out by partner",
+	}
+	rr, err := ts.ReconcileRemit(ctx, in, storage.ConfirmStep)
+	if err != nil {
+		t.Fatalf("ReconcileRemit() = got error %v, want nil", err)
+	}
+	if rr.ID == "" {
+		t.Fatal("ReconcileRemit() = want id")
+	}
+
+	h, err := ts.GetRemitHistory(ctx, staged.TxnID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.TxnStatus != in.ToStatus || h.TxnStep != in.ToStep {
+		t.Fatalf("GetRemitHistory() = got %s/%s, want %s/%s", h.TxnStatus, h.TxnStep, in.ToStatus, in.ToStep)
+	}
+
+	audit, err := ts.ListRemitReconcile(ctx, staged.TxnID)
+	if err != nil {
+		t.Fatalf("ListRemitReconcile() = got error %v, want nil", err)
+	}
+	o := cmpopts.IgnoreFields(storage.RemitReconcile{}, "ID", "Created")
+	if !cmp.Equal([]storage.RemitReconcile{in}, audit, o) {
+		t.Fatal(cmp.Diff([]storage.RemitReconcile{in}, audit, o))
+	}
+
+	got, err = ts.ListReconcileRemit(ctx, f)
+	if err != nil {
+		t.Fatalf("ListReconcileRemit() = got error %v, want nil", err)
+	}
+	for _, h := range got {
+		if h.TxnID == staged.TxnID {
+			t.Fatal("ListReconcileRemit() = reconciled transaction listed again")
+		}
+	}
+}
@@ -26,7 +26,9 @@ INSERT INTO remittance_history (
 	remarks,
 	txn_created_time,
 	txn_updated_time,
-	txn_confirm_time
+	txn_confirm_time,
+	branch_id,
+	teller_id
 ) VALUES (
 	:dsa_id,
 	:user_id,
@@ -43,7 +45,9 @@ INSERT INTO remittance_history (
 	:remarks,
 	:txn_created_time,
 	:txn_updated_time,
-	:txn_confirm_time
+	:txn_confirm_time,
+	:branch_id,
+	:teller_id
 ) RETURNING *`
 
 func (s *Storage) CreateRemittanceHistory(ctx context.Context, r storage.PerahubRemittanceHistory) (*storage.PerahubRemittanceHistory, error) {
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"brank.as/petnet/api/storage"
+)
+
+// remittance and bills payment amounts are stored as minor units json, cash in
+// cash out and insurance amounts as plain PHP numbers and eload amounts as
+// minor units.
+const listServiceTransactionReport = `
+SELECT
+	'REMITTANCE' AS service,
+	remco_id AS partner,
+	CASE remit_type WHEN 'SEND' THEN 'OUTBOUND' WHEN 'DISBURSE' THEN 'INBOUND' ELSE '' END AS bound_type,
+	COUNT(*) AS txn_count,
+	COALESCE(SUM(NULLIF(remittance->'source_amt'->>'amount', '')::numeric), 0) / 100 AS total_amount,
+	COALESCE(SUM(NULLIF(remittance->'charge'->>'amount', '')::numeric), 0) / 100 AS total_charges
+FROM remit_history
+WHERE dsa_id = $1
+AND txn_status = 'SUCCESS'
+AND txn_step = 'CONFIRM'
+AND txn_completed_time >= $2
+AND txn_completed_time < $3
+GROUP BY remco_id, remit_type
+UNION ALL
+SELECT
+	'BILLSPAYMENT' AS service,
+	partner_id AS partner,
+	'' AS bound_type,
+	COUNT(*) AS txn_count,
+	COALESCE(SUM(NULLIF(NULLIF(amount, '')::jsonb->>'amount', '')::numeric), 0) / 100 AS total_amount,
+	COALESCE(SUM(NULLIF(partner_charge, '')::numeric), 0) AS total_charges
+FROM bill_payment
+WHERE org_id = $1
+AND bill_payment_status = 'SUCCESS'
+AND trx_date >= $2
+AND trx_date < $3
+GROUP BY partner_id
+UNION ALL
+SELECT
+	'CASHINCASHOUT' AS service,
+	partner_code AS partner,
+	'' AS bound_type,
+	COUNT(*) AS txn_count,
+	COALESCE(SUM(NULLIF(principal_amount, '')::numeric), 0) AS total_amount,
+	COALESCE(SUM(NULLIF(charges, '')::numeric), 0) AS total_charges
+FROM cico_history
+WHERE org_id::text = $1
+AND txn_status = 'SUCCESS'
+AND trx_date >= $2
+AND trx_date < $3
+GROUP BY partner_code
+UNION ALL
+SELECT
+	'MICROINSURANCE' AS service,
+	'RuralNet' AS partner,
+	'' AS bound_type,
+	COUNT(*) AS txn_count,
+	COALESCE(SUM(NULLIF(amount, '')::numeric), 0) AS total_amount,
+	0 AS total_charges
+FROM micro_insurance_history
+WHERE (org_id = $1 OR dsa_id = $1)
+AND error_code = ''
+AND trx_status NOT IN ('', 'Failed', 'FAIL')
+AND trx_date >= $2
+AND trx_date < $3
+UNION ALL
+SELECT
+	'ELOAD' AS service,
+	partner,
+	'' AS bound_type,
+	COUNT(*) AS txn_count,
+	COALESCE(SUM(amount), 0) / 100 AS total_amount,
+	0 AS total_charges
+FROM eload_history
+WHERE org_id = $1
+AND txn_status = 'SUCCESS'
+AND trx_date >= $2
+AND trx_date < $3
+GROUP BY partner
+`
+
+// ListServiceTransactionReport aggregates the successful remittance, bills
+// payment, cash in cash out, micro insurance and eload transactions of a DSA
+// per service, partner and bound type.
+func (s *Storage) ListServiceTransactionReport(ctx context.Context, f storage.ServiceReportFilter) ([]storage.ServiceTransactionReport, error) {
+	switch {
+	case f.OrgID == "":
+		return nil, fmt.Errorf("org id cannot be empty")
+	case f.From.IsZero(), f.Until.IsZero():
+		return nil, fmt.Errorf("report period cannot be empty")
+	}
+	r := []storage.ServiceTransactionReport{}
+	if err := s.db.SelectContext(ctx, &r, listServiceTransactionReport, f.OrgID, f.From, f.Until); err != nil {
+		return nil, fmt.Errorf("executing service transaction report: %w", err)
+	}
+	res := r[:0]
+	for _, v := range r {
+		if v.Count > 0 {
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
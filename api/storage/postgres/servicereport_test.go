@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/api/storage"
+)
+
+func TestListServiceTransactionReport(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.TODO()
+	oid := uuid.NewString()
+	now := time.Now()
+
+	for _, c := range []storage.CashInCashOutHistory{
+		{PartnerCode: "GCASH", TxnStatus: "SUCCESS", PrincipalAmount: 100, Charges: 10, TotalAmount: 110},
+		{PartnerCode: "GCASH", TxnStatus: "SUCCESS", PrincipalAmount: 200, Charges: 20, TotalAmount: 220},
+		{PartnerCode: "GCASH", TxnStatus: "FAIL", PrincipalAmount: 300, Charges: 30, TotalAmount: 330},
+	} {
+		c.OrgID = oid
+		c.PetnetTrackingNo = uuid.NewString()
+		c.TrxDate = now
+		if _, err := ts.CreateCICOHistory(ctx, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sts := range []string{"Success", "Failed"} {
+		if _, err := ts.CreateMicroInsuranceHistory(ctx, storage.MicroInsuranceHistory{
+			DsaID:            oid,
+			OrgID:            oid,
+			TrxDate:          now,
+			Amount:           "500",
+			Birthdate:        now,
+			Beneficiaries:    []byte("[]"),
+			Dependents:       []byte("[]"),
+			InsuranceDetails: []byte("{}"),
+			TrxStatus:        sts,
+			TraceNumber:      sql.NullString{Valid: true, String: uuid.NewString()},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, sts := range []storage.TxnStatus{storage.SuccessStatus, storage.FailStatus} {
+		if _, err := ts.CreateELoadHistory(ctx, storage.ELoadHistory{
+			OrgID:     oid,
+			Partner:   "ELOAD",
+			Amount:    5000,
+			Currency:  "PHP",
+			TxnStatus: string(sts),
+			TrxDate:   now,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := ts.ListServiceTransactionReport(ctx, storage.ServiceReportFilter{
+		OrgID: oid,
+		From:  now.Add(-time.Hour),
+		Until: now.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("ListServiceTransactionReport() = got error %v, want nil", err)
+	}
+	want := map[storage.ServiceType]int{
+		storage.CashInCashOutService:  2,
+		storage.MicroInsuranceService: 1,
+		storage.ELoadService:          1,
+	}
+	cnt := map[storage.ServiceType]int{}
+	for _, r := range got {
+		cnt[r.Service] += r.Count
+	}
+	if !cmp.Equal(want, cnt) {
+		t.Error(cmp.Diff(want, cnt))
+	}
+
+	if _, err := ts.ListServiceTransactionReport(ctx, storage.ServiceReportFilter{OrgID: oid}); err == nil {
+		t.Error("ListServiceTransactionReport() = want error for empty period")
+	}
+}
@@ -38,11 +38,22 @@ const (
 const (
 	SuccessStatus TxnStatus = "SUCCESS"
 	FailStatus    TxnStatus = "FAIL"
+	// ReviewStatus is set by reconciliation when the partner status of a
+	// transaction cannot be determined and needs to be checked manually.
+	ReviewStatus TxnStatus = "NEEDS_REVIEW"
+	// VoidStatus is set when a bill payment is voided with the biller.
+	VoidStatus TxnStatus = "VOID"
+	// PendingStatus is set while a transaction is being resent to the
+	// partner and its outcome is not known yet.
+	PendingStatus TxnStatus = "PENDING"
 )
 
 const (
 	StageStep   TxnStep = "STAGE"
 	ConfirmStep TxnStep = "CONFIRM"
+	// FailStep is set on the remit cache by reconciliation when the
+	// transaction failed or was cancelled with the partner.
+	FailStep TxnStep = "FAIL"
 )
 
 const (
@@ -117,6 +128,178 @@ type RemitCache struct {
 	Created           time.Time      `db:"created"`
 }
 
+// RemitOTP is the one time password required to confirm a staged
+// remittance. EventID is the MFA event the code was issued for.
+type RemitOTP struct {
+	TxnID    string       `db:"transaction_id"`
+	DsaID    string       `db:"dsa_id"`
+	UserID   string       `db:"user_id"`
+	RemcoID  string       `db:"remco_id"`
+	EventID  string       `db:"event_id"`
+	Attempts int          `db:"attempts"`
+	Expires  time.Time    `db:"expires"`
+	Verified sql.NullTime `db:"verified"`
+	Created  time.Time    `db:"created"`
+	Updated  time.Time    `db:"updated"`
+}
+
+// RemitIdempotency records the outcome of a terminal request made with an
+// idempotency key. Response is empty while the request is still in flight,
+// Error holds the status of a request that failed after reaching the partner.
+type RemitIdempotency struct {
+	DsaID       string    `db:"dsa_id"`
+	IdemKey     string    `db:"idempotency_key"`
+	Method      string    `db:"method"`
+	RequestHash string    `db:"request_hash"`
+	Response    []byte    `db:"response"`
+	Error       []byte    `db:"error"`
+	Created     time.Time `db:"created"`
+	Updated     time.Time `db:"updated"`
+}
+
+// RemitReconcile is an audit entry for a transaction status changed by the
+// reconciliation job.
+type RemitReconcile struct {
+	ID             string    `db:"id"`
+	TxnID          string    `db:"remit_id"`
+	RemcoID        string    `db:"remco_id"`
+	RemType        string    `db:"remit_type"`
+	RemcoControlNo string    `db:"remco_control_number"`
+	FromStatus     string    `db:"from_status"`
+	FromStep       string    `db:"from_step"`
+	ToStatus       string    `db:"to_status"`
+	ToStep         string    `db:"to_step"`
+	PartnerStatus  string    `db:"partner_status"`
+	Message        string    `db:"message"`
+	Created        time.Time `db:"created"`
+}
+
+// ReconcileFilter selects the transactions to be reconciled. Transactions last
+// attempted at or after AttemptedBefore are left out.
+type ReconcileFilter struct {
+	From            time.Time
+	Until           time.Time
+	AttemptedBefore time.Time
+	Limit           int
+}
+
+// ServiceType is the service a transaction report is aggregated for, named
+// after the revenue sharing remit types.
+type ServiceType string
+
+const (
+	RemittanceService     ServiceType = "REMITTANCE"
+	BillsPaymentService   ServiceType = "BILLSPAYMENT"
+	CashInCashOutService  ServiceType = "CASHINCASHOUT"
+	MicroInsuranceService ServiceType = "MICROINSURANCE"
+	ELoadService          ServiceType = "ELOAD"
+)
+
+// ServiceTransactionReport is the aggregate of the successful transactions of
+// a DSA for one service, partner and bound type. Amounts are in PHP.
+type ServiceTransactionReport struct {
+	Service   ServiceType `db:"service"`
+	Partner   string      `db:"partner"`
+	BoundType string      `db:"bound_type"`
+	Count     int         `db:"txn_count"`
+	Amount    string      `db:"total_amount"`
+	Charges   string      `db:"total_charges"`
+}
+
+// ServiceReportFilter selects the transactions of a DSA completed in the
+// period [From, Until).
+type ServiceReportFilter struct {
+	OrgID string
+	From  time.Time
+	Until time.Time
+}
+
+// CommissionLedger is the commission earned on a completed transaction by the
+// DSA and PETNET. Amounts are in PHP.
+type CommissionLedger struct {
+	ID               string      `db:"id"`
+	OrgID            string      `db:"org_id"`
+	Service          ServiceType `db:"service"`
+	TxnID            string      `db:"transaction_id"`
+	Partner          string      `db:"partner"`
+	BoundType        string      `db:"bound_type"`
+	Amount           string      `db:"principal_amount"`
+	Charges          string      `db:"charges"`
+	Revenue          string      `db:"revenue"`
+	DSACommission    string      `db:"dsa_commission"`
+	PETNETCommission string      `db:"petnet_commission"`
+	PartnerTierType  string      `db:"partner_tier_type"`
+	PartnerRate      string      `db:"partner_rate"`
+	SharingTierType  string      `db:"sharing_tier_type"`
+	SharePercent     string      `db:"share_percent"`
+	TxnCompletedTime time.Time   `db:"txn_completed_time"`
+	Created          time.Time   `db:"created"`
+}
+
+// CommissionFilter selects commission ledger entries or transactions completed
+// in the period [From, Until).
+type CommissionFilter struct {
+	OrgID   string
+	Service ServiceType
+	From    time.Time
+	Until   time.Time
+	Limit   int
+}
+
+// RemitPartner is a remittance partner in the partner registry. Send and
+// disburse types are stored as a JSON map of type name to RemitType.
+type RemitPartner struct {
+	Code          string         `db:"code"`
+	Name          string         `db:"name"`
+	Countries     pq.StringArray `db:"countries"`
+	SendTypes     types.JSONText `db:"send_types"`
+	DisburseTypes types.JSONText `db:"disburse_types"`
+	Enabled       bool           `db:"enabled"`
+	UpdatedBy     string         `db:"updated_by"`
+	Created       time.Time      `db:"created"`
+	Updated       time.Time      `db:"updated"`
+}
+
+// RemitType is a send or disburse type supported by a remittance partner.
+type RemitType struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	Receiver    bool   `json:"receiver,omitempty"`
+	BankAccount bool   `json:"bank_account,omitempty"`
+	Business    bool   `json:"business,omitempty"`
+}
+
+// Other info field types.
+const (
+	OtherInfoText   = "text"
+	OtherInfoNumber = "number"
+	OtherInfoDate   = "date"
+	OtherInfoEmail  = "email"
+)
+
+// OtherInfoField is an additional field of a partner's remittance requests,
+// listed in the partner's input guide.
+type OtherInfoField struct {
+	Partner  string `db:"partner"`
+	Name     string `db:"name"`
+	Type     string `db:"field_type"`
+	Required bool   `db:"required"`
+	// Pattern is a regular expression the value must match.
+	Pattern string `db:"pattern"`
+	// Options restricts the value to one of the options.
+	Options   pq.StringArray `db:"options"`
+	Position  int            `db:"position"`
+	UpdatedBy string         `db:"updated_by"`
+	Created   time.Time      `db:"created"`
+	Updated   time.Time      `db:"updated"`
+}
+
+type RemitPartnerFilter struct {
+	Country string
+	// EnabledOnly excludes the disabled partners.
+	EnabledOnly bool
+}
+
 type Taxes struct {
 	Currency  string `json:"currency,omitempty"`
 	State     string `json:"state,omitempty"`
@@ -154,6 +337,13 @@ type RemitHistory struct {
 	Updated               time.Time      `db:"updated"`
 	TransactionType       sql.NullString `db:"transaction_type"`
 	RemitTransactionCount int            `db:"remit_transaction_count"`
+	OTPUsed               bool           `db:"otp_used"`
+	PayoutStatus          string         `db:"payout_status"`
+	PayoutChecked         sql.NullTime   `db:"payout_checked"`
+	PaidOutTime           sql.NullTime   `db:"paid_out_time"`
+	BranchID              string         `db:"branch_id"`
+	TellerID              string         `db:"teller_id"`
+	Created               time.Time      `db:"created"`
 	Total                 int
 }
 
@@ -172,6 +362,7 @@ type Remittance struct {
 	Tax               currency.Minor            `json:"tax,omitempty"`
 	Charges           map[string]currency.Minor `json:"charges,omitempty"`
 	Charge            currency.Minor            `json:"charge,omitempty"`
+	OtherInfo         map[string]string         `json:"other_info,omitempty"`
 }
 
 type GrossTotal struct {
@@ -303,10 +494,23 @@ type ISOCty struct {
 type InputGuideData map[string][]Input
 
 type InputGuide struct {
+	Partner   string         `db:"partner"`
+	Data      InputGuideData `db:"inputguide"`
+	Refreshed time.Time      `db:"refreshed"`
+	Created   time.Time      `db:"created"`
+	Updated   time.Time      `db:"updated"`
+}
+
+// InputGuideDiff records the codes of an input guide group that changed when
+// the input guide was refreshed.
+type InputGuideDiff struct {
+	ID      string         `db:"id"`
 	Partner string         `db:"partner"`
-	Data    InputGuideData `db:"inputguide"`
+	Group   string         `db:"group_name"`
+	Added   pq.StringArray `db:"added"`
+	Removed pq.StringArray `db:"removed"`
+	Changed pq.StringArray `db:"changed"`
 	Created time.Time      `db:"created"`
-	Updated time.Time      `db:"updated"`
 }
 
 type Input struct {
@@ -420,6 +624,32 @@ type LRHFilter struct {
 	Until           time.Time
 	Transactiontype string
 	DsaID           string
+	BranchID        string
+	TellerID        string
+	// After lists the page after the cursor in keyset order, Offset is
+	// ignored.
+	After *Cursor
+}
+
+// PayoutFilter selects the confirmed sends of a partner completed in
+// [From, Until) that are not paid out or cancelled yet and were last checked
+// before CheckedBefore.
+type PayoutFilter struct {
+	Partner       string
+	From          time.Time
+	Until         time.Time
+	CheckedBefore time.Time
+	Limit         int
+}
+
+// CustomerRemitFilter selects the remittances of a customer created in
+// [From, Until). DsaOrgID is required.
+type CustomerRemitFilter struct {
+	DsaOrgID   string
+	CustomerID string
+	From       time.Time
+	Until      time.Time
+	Limit      int
 }
 
 type BillDetails struct {
@@ -489,6 +719,8 @@ type BillPayment struct {
 	Type                    string         `db:"type"`
 	TxnID                   string         `db:"txnid"`
 	OrgID                   string         `db:"org_id"`
+	BranchID                string         `db:"branch_id"`
+	TellerID                string         `db:"teller_id"`
 }
 
 type BillsOtherInfo struct {
@@ -532,6 +764,82 @@ type BillPaymentFilter struct {
 	OrgID             string
 	ReferenceNumber   string
 	ExcludePartners   []string
+	BranchID          string
+	TellerID          string
+	After             *Cursor
+}
+
+// BillerWalletBalance is a sample of a biller prefund wallet balance. Amounts
+// are in minor units. Alerted is set once the low balance alert was delivered
+// for the sample, or for an earlier sample of the same low balance.
+type BillerWalletBalance struct {
+	ID        string    `db:"id"`
+	Partner   string    `db:"partner"`
+	Balance   int64     `db:"balance"`
+	Currency  string    `db:"currency"`
+	Threshold int64     `db:"threshold"`
+	Low       bool      `db:"low"`
+	Alerted   bool      `db:"alerted"`
+	Error     string    `db:"error"`
+	Created   time.Time `db:"created"`
+}
+
+type BillerWalletBalanceFilter struct {
+	Partner string
+	From    time.Time
+	Until   time.Time
+	Limit   int
+}
+
+// ELoadHistory is a prepaid load sold through PeraHub. Amount is in minor
+// units. A sale is recorded as pending before it is sent to PeraHub and keeps
+// that status until PeraHub answers.
+type ELoadHistory struct {
+	ID              string    `db:"id"`
+	OrgID           string    `db:"org_id"`
+	UserID          string    `db:"user_id"`
+	Partner         string    `db:"partner"`
+	ProductCode     string    `db:"product_code"`
+	ProductType     string    `db:"product_type"`
+	Provider        string    `db:"provider"`
+	TargetMobileNo  string    `db:"target_mobile_no"`
+	Amount          int64     `db:"amount"`
+	Currency        string    `db:"currency"`
+	ReferenceNumber string    `db:"reference_number"`
+	TraceID         string    `db:"trace_id"`
+	TxnStatus       string    `db:"txn_status"`
+	ErrorCode       string    `db:"error_code"`
+	ErrorMessage    string    `db:"error_message"`
+	IdempotencyKey  string    `db:"idempotency_key"`
+	BranchID        string    `db:"branch_id"`
+	TellerID        string    `db:"teller_id"`
+	TrxDate         time.Time `db:"trx_date"`
+	Created         time.Time `db:"created"`
+}
+
+// enrollment statuses of a partner customer
+const (
+	EnrollmentPending    = "PENDING"
+	EnrollmentConfirming = "CONFIRMING"
+	EnrollmentEnrolled   = "ENROLLED"
+)
+
+// CustomerEnrollment is a customer enrolled with a partner loyalty program
+// through the platform. Details holds the customer KYC given at enrollment,
+// Registration the encrypted partner request sent once the OTP is confirmed.
+type CustomerEnrollment struct {
+	ID           string         `db:"id"`
+	OrgID        string         `db:"org_id"`
+	UserID       string         `db:"user_id"`
+	Partner      string         `db:"partner"`
+	Mobile       string         `db:"mobile"`
+	CustomerCode string         `db:"customer_code"`
+	WUCardNo     string         `db:"wu_card_no"`
+	Status       string         `db:"status"`
+	Details      types.JSONText `db:"details"`
+	Registration types.JSONText `db:"registration"`
+	Created      time.Time      `db:"created"`
+	Updated      time.Time      `db:"updated"`
 }
 
 type (
@@ -573,6 +881,8 @@ type PerahubRemittanceHistory struct {
 	TxnCreatedTime                time.Time         `db:"txn_created_time"`
 	TxnUpdatedTime                time.Time         `db:"txn_updated_time"`
 	TxnConfirmTime                time.Time         `db:"txn_confirm_time"`
+	BranchID                      string            `db:"branch_id"`
+	TellerID                      string            `db:"teller_id"`
 	Total                         int               `db:"total"`
 	PayHisErr                     error
 }
@@ -660,6 +970,8 @@ type CashInCashOutHistory struct {
 	ErrorType          string         `db:"error_type"`
 	CreatedBy          string         `db:"created_by"`
 	UpdatedBy          string         `db:"updated_by"`
+	BranchID           string         `db:"branch_id"`
+	TellerID           string         `db:"teller_id"`
 	Created            time.Time      `db:"created"`
 	Updated            time.Time      `db:"updated"`
 	Count              int
@@ -721,6 +1033,8 @@ type CashInCashOutTrxListFilter struct {
 	TxnStatus          string
 	PetnetTrackingNo   string
 	ProviderTrackingNo string
+	BranchID           string
+	TellerID           string
 	SortByColumn       CICOHistoryColumn
 	SortOrder          SortOrder
 	Limit              int
@@ -728,6 +1042,7 @@ type CashInCashOutTrxListFilter struct {
 	From               time.Time
 	Until              time.Time
 	Total              int
+	After              *Cursor
 }
 
 type RemitToAccountHistoryDetails struct {
@@ -750,11 +1065,33 @@ type (
 )
 
 const (
-	RTAHistoryIDCol RTAHistoryColumn = "RTAHistoryIDCol"
-	OrgIDRTACol     RTAHistoryColumn = "OrgIDRTACol"
-	TrxTypeRTACol   RTAHistoryColumn = "TrxTypeRTACol"
+	RTAHistoryIDCol       RTAHistoryColumn = "RTAHistoryIDCol"
+	OrgIDRTACol           RTAHistoryColumn = "OrgIDRTACol"
+	TrxTypeRTACol         RTAHistoryColumn = "TrxTypeRTACol"
+	ReferenceNumberRTACol RTAHistoryColumn = "ReferenceNumber"
+	TotalAmountRTACol     RTAHistoryColumn = "TotalAmount"
+	TransactionTimeRTACol RTAHistoryColumn = "TransactionCompletedTime"
+	PartnerRTACol         RTAHistoryColumn = "Partner"
+	ServiceChargeRTACol   RTAHistoryColumn = "ServiceCharge"
 )
 
+type RTATrxListFilter struct {
+	ReferenceNumber string
+	ExcludePartners []string
+	OrgID           string
+	Partner         string
+	TxnStatus       string
+	BranchID        string
+	TellerID        string
+	SortByColumn    RTAHistoryColumn
+	SortOrder       SortOrder
+	Limit           int
+	Offset          int
+	From            time.Time
+	Until           time.Time
+	After           *Cursor
+}
+
 type RemitToAccountHistoryRes struct {
 	Code     int                          `json:"code"`
 	Message  string                       `json:"message"`
@@ -828,6 +1165,8 @@ type RemitToAccountHistory struct {
 	ErrorType                   string         `db:"error_type"`
 	CreatedBy                   string         `db:"created_by"`
 	UpdatedBy                   string         `db:"updated_by"`
+	BranchID                    string         `db:"branch_id"`
+	TellerID                    string         `db:"teller_id"`
 	Created                     time.Time      `db:"created"`
 	Updated                     time.Time      `db:"updated"`
 	SortByColumn                RTAHistoryColumn
@@ -0,0 +1,18 @@
+package util
+
+import (
+	"context"
+	"time"
+)
+
+type detached struct{ context.Context }
+
+func (detached) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detached) Done() <-chan struct{}       { return nil }
+func (detached) Err() error                  { return nil }
+
+// Detach returns a context with the values of ctx that is never canceled, for
+// bookkeeping that has to run after the request timed out or was canceled.
+func Detach(ctx context.Context) context.Context {
+	return detached{ctx}
+}
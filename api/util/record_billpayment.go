@@ -136,6 +136,8 @@ func RecordBillPayment(ctx context.Context, st *postgres.Storage, req *bpa.BPTra
 		TxnID:                   req.GetTxnid(),
 		PartnerID:               req.GetPartner(),
 		OrgID:                   phmw.GetDSAOrgID(ctx),
+		BranchID:                phmw.GetBranchID(ctx),
+		TellerID:                phmw.GetTellerID(ctx),
 	}
 
 	rs, err := st.CreateBillPayment(ctx, bp)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
 	"brank.as/petnet/api/storage"
 	"brank.as/petnet/api/storage/postgres"
 	"brank.as/petnet/serviceutil/logging"
@@ -65,6 +66,8 @@ func RecordCiCo(ctx context.Context, st *postgres.Storage, req storage.CashInCas
 		req.ErrorTime = time.Now().String()
 	}
 	req.TxnStatus = string(bps)
+	req.BranchID = phmw.GetBranchID(ctx)
+	req.TellerID = phmw.GetTellerID(ctx)
 	rs, err := st.CreateCICOHistory(ctx, req)
 	if err != nil {
 		logging.WithError(err, log).Error("creating cash in cash out transaction")
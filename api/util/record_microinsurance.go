@@ -95,6 +95,8 @@ func RecordMicroInsurance(ctx context.Context,
 		ErrorType:        errType,
 		ErrorTime:        errTime,
 		OrgID:            phmw.GetDSAOrgID(ctx),
+		BranchID:         phmw.GetBranchID(ctx),
+		TellerID:         phmw.GetTellerID(ctx),
 	})
 	if err != nil {
 		logging.WithError(err, log).Error("creating microinsurance history")
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
 	"brank.as/petnet/api/storage"
 	"brank.as/petnet/api/storage/postgres"
 	"brank.as/petnet/serviceutil/logging"
@@ -43,6 +44,9 @@ func RecordRemittanceHistory(ctx context.Context, st *postgres.Storage, ph stora
 		}
 	}
 
+	ph.BranchID = phmw.GetBranchID(ctx)
+	ph.TellerID = phmw.GetTellerID(ctx)
+
 	switch {
 	case ph.DsaID == "":
 		log.Error("DsaID cannot be empty")
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"brank.as/petnet/api/integration/perahub"
+	phmw "brank.as/petnet/api/perahub-middleware"
 	"brank.as/petnet/api/storage"
 	"brank.as/petnet/api/storage/postgres"
 	rta "brank.as/petnet/gunk/drp/v1/remittoaccount"
@@ -58,6 +59,8 @@ func RecordRTA(ctx context.Context, orgID string, st *postgres.Storage, req *rta
 	}
 	crtaHistory := storage.RemitToAccountHistory{
 		OrgID:                       orgID,
+		BranchID:                    phmw.GetBranchID(ctx),
+		TellerID:                    phmw.GetTellerID(ctx),
 		Partner:                     req.GetPartner(),
 		ReferenceNumber:             req.GetReferenceNumber(),
 		TrxDate:                     trxdt,
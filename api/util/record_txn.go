@@ -125,6 +125,8 @@ func RecordStageTxn(ctx context.Context, st *postgres.Storage, rmt core.Remittan
 	req := storage.RemitHistory{
 		TxnID:          o.TxnID,
 		DsaID:          rmt.DsaID,
+		BranchID:       phmw.GetBranchID(ctx),
+		TellerID:       phmw.GetTellerID(ctx),
 		DsaOrderID:     rmt.DsaOrderID,
 		UserID:         rmt.UserID,
 		RemcoID:        rmt.RemitPartner,
@@ -190,6 +192,7 @@ func RecordStageTxn(ctx context.Context, st *postgres.Storage, rmt core.Remittan
 			DestAmt:    rmt.DestAmount,
 			Tax:        rmt.Tax,
 			Charge:     rmt.Charge,
+			OtherInfo:  rmt.OtherInfo,
 		},
 	}
 	if o.TxnErr != nil {
@@ -332,6 +335,7 @@ func RecordConfirmTxn(ctx context.Context, st *postgres.Storage, rmt core.Remitt
 			DestAmt:    rmt.DestAmount,
 			Tax:        rmt.Tax,
 			Charge:     rmt.Charge,
+			OtherInfo:  rmt.OtherInfo,
 		},
 	}
 	if o.TxnErr != nil {
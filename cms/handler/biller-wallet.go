@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/bojanz/currency"
+
+	bppb "brank.as/petnet/gunk/drp/v1/bills-payment"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// BillerWalletBalance is a biller prefund wallet balance shown on the dashboard.
+type BillerWalletBalance struct {
+	Partner   string
+	Balance   string
+	Threshold string
+	Low       bool
+	Checked   string
+}
+
+// billerWalletBalances returns the latest biller wallet balances. Errors are
+// logged and hide the widget.
+func (s *Server) billerWalletBalances(ctx context.Context) []BillerWalletBalance {
+	log := logging.FromContext(ctx)
+	res, err := s.resolveDRP().BPWalletBalances(ctx, &bppb.BPWalletBalancesRequest{Limit: 1})
+	if err != nil {
+		logging.WithError(err, log).Error("getting biller wallet balances")
+		return nil
+	}
+	bs := make([]BillerWalletBalance, 0, len(res.GetBalances()))
+	for _, b := range res.GetBalances() {
+		bs = append(bs, BillerWalletBalance{
+			Partner:   b.GetPartner(),
+			Balance:   majorAmount(b.GetBalance()),
+			Threshold: majorAmount(b.GetThreshold()),
+			Low:       b.GetLow(),
+			Checked:   b.GetChecked().AsTime().Local().Format("Jan 02, 2006 03:04 PM"),
+		})
+	}
+	return bs
+}
+
+func majorAmount(a *bppb.Amount) string {
+	m, err := currency.NewMinor(a.GetAmount(), a.GetCurrency())
+	if err != nil {
+		return a.GetAmount()
+	}
+	return m.ToAmount().Number() + " " + a.GetCurrency()
+}
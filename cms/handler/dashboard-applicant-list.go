@@ -1,14 +1,18 @@
 package handler
 
 import (
+	"context"
+	"fmt"
 	"html/template"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"brank.as/petnet/cms/paginator"
+	rat "brank.as/petnet/gunk/dsa/v1/riskassesment"
 	fpb "brank.as/petnet/gunk/dsa/v2/file"
 	ppb "brank.as/petnet/gunk/dsa/v2/profile"
 	"brank.as/petnet/serviceutil/logging"
@@ -24,6 +28,8 @@ type (
 		CompanyName          string
 		DateApplied          time.Time
 		RiskScore            string
+		RiskPercent          float64
+		RiskExplanation      []string
 		Status               string
 		ReminderSent         bool
 		IsDocumentsSubmitted bool
@@ -38,6 +44,7 @@ type (
 		SearchTerm       string
 		PresetPermission map[string]map[string]bool
 		ServiceRequest   bool
+		WalletBalances   []BillerWalletBalance
 	}
 )
 
@@ -170,6 +177,7 @@ func (s *Server) getDSAApplicantList(w http.ResponseWriter, r *http.Request) {
 		DSAApplicants:    []DSAApplicant{},
 		UserInfo:         &usrInfo.UserInfo,
 		SearchTerm:       searchTerm,
+		WalletBalances:   s.billerWalletBalances(ctx),
 	}
 	if err != nil {
 		logging.WithError(err, log).Info("getting profile")
@@ -184,6 +192,7 @@ func (s *Server) getDSAApplicantList(w http.ResponseWriter, r *http.Request) {
 	if currentPage == 0 {
 		currentPage = 1
 	}
+	riskScores := s.applicantRiskScores(r.Context(), pf.GetProfiles())
 	var dsaAplicantList []DSAApplicant
 	for _, applicant := range pf.Profiles {
 		u, err := s.rbac.GetUser(r.Context(), &rbupb.GetUserRequest{ID: applicant.UserID})
@@ -241,6 +250,11 @@ func (s *Server) getDSAApplicantList(w http.ResponseWriter, r *http.Request) {
 				IsDocumentsSubmitted: docSubmitted,
 				User:                 userData(u.GetUser()),
 			})
+			if rs, ok := riskScores[applicant.GetOrgID()]; ok {
+				dsaAplicantList[len(dsaAplicantList)-1].RiskScore = rs.GetRiskScore().String()
+				dsaAplicantList[len(dsaAplicantList)-1].RiskPercent = rs.GetPercent()
+				dsaAplicantList[len(dsaAplicantList)-1].RiskExplanation = riskExplanation(rs)
+			}
 		}
 	}
 
@@ -264,3 +278,43 @@ func (s *Server) getDSAApplicantList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// applicantRiskScores returns the computed risk scores of the applicants by
+// org ID. The list still shows without them if they can't be loaded.
+func (s *Server) applicantRiskScores(ctx context.Context, pfs []*ppb.OrgProfile) map[string]*rat.OrgRiskScore {
+	var ids []string
+	for _, p := range pfs {
+		ids = append(ids, p.GetOrgID())
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	res, err := s.pf.ListRiskScores(ctx, &rat.ListRiskScoresRequest{OrgIDs: ids})
+	if err != nil {
+		logging.WithError(err, logging.FromContext(ctx)).Info("listing risk scores")
+		return nil
+	}
+	rss := make(map[string]*rat.OrgRiskScore, len(res.GetRiskScores()))
+	for _, rs := range res.GetRiskScores() {
+		rss[rs.GetOrgID()] = rs
+	}
+	return rss
+}
+
+// riskExplanation lists how each question counted towards the risk score,
+// the questions weighing most first.
+func riskExplanation(rs *rat.OrgRiskScore) []string {
+	fs := append([]*rat.RiskFactor(nil), rs.GetFactors()...)
+	sort.SliceStable(fs, func(i, j int) bool { return fs[i].GetPoints() > fs[j].GetPoints() })
+	ex := make([]string, 0, len(fs)+1)
+	ex = append(ex, fmt.Sprintf("%.2f%% of the maximum points, model %s", rs.GetPercent(), rs.GetModelVersion()))
+	for _, f := range fs {
+		e := fmt.Sprintf("Question %s: %g/%g points (weight %g, impact %g, customers %g, staff %g)",
+			f.GetQID(), f.GetPoints(), f.GetMaxPoints(), f.GetWeight(), f.GetImpactScore(), f.GetCustomersTotal(), f.GetHrTotal())
+		if f.GetNote() != "" {
+			e += ", " + f.GetNote()
+		}
+		ex = append(ex, e)
+	}
+	return ex
+}
@@ -41,6 +41,7 @@ type (
 		TerminalIdOtc              string
 		TerminalIdDigital          string
 		TransactionTypesForDSACode TransactionTypesForDSACode
+		Onboarding                 *OnboardingTimeline
 	}
 
 	TransactionTypesForDSACode struct {
@@ -341,6 +342,10 @@ func (s *Server) getDashboardDetails(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		logging.WithError(err, log).Info("getting services")
 	}
+	onb, err := s.onboardingTimeline(ctx, oid)
+	if err != nil {
+		logging.WithError(err, log).Info("getting onboarding timeline")
+	}
 	usrInfo := s.GetUserInfoFromCookie(w, r, false)
 
 	etd := s.getEnforceTemplateData(ctx)
@@ -353,6 +358,7 @@ func (s *Server) getDashboardDetails(w http.ResponseWriter, r *http.Request) {
 	details.CSRFField = csrf.TemplateField(r)
 	details.PresetPermission = etd.PresetPermission
 	details.ServiceRequest = etd.ServiceRequests
+	details.Onboarding = onb
 
 	uid := mw.GetUserID(ctx)
 	gp, err := s.pf.GetUserProfile(ctx, &ppf.GetUserProfileRequest{
@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	"brank.as/petnet/serviceutil/logging"
+
+	onbpb "brank.as/petnet/gunk/dsa/v2/onboarding"
+	rbupb "brank.as/rbac/gunk/v1/user"
+)
+
+type (
+	OnboardingTimeline struct {
+		State      string
+		NextStates []OnboardingState
+		History    []OnboardingEvent
+	}
+
+	OnboardingState struct {
+		Value int32
+		Label string
+	}
+
+	OnboardingEvent struct {
+		From    string
+		To      string
+		Actor   string
+		Remarks string
+		Created time.Time
+	}
+
+	OnboardingTransitionForm struct {
+		OrgID   string
+		State   string
+		Remarks string
+	}
+)
+
+var onboardingStateLabels = map[onbpb.State]string{
+	onbpb.State_Draft:            "Draft",
+	onbpb.State_Submitted:        "Submitted",
+	onbpb.State_DocumentsPending: "Documents Pending",
+	onbpb.State_UnderReview:      "Under Review",
+	onbpb.State_Approved:         "Approved",
+	onbpb.State_Rejected:         "Rejected",
+	onbpb.State_Live:             "Live",
+}
+
+// onboardingTimeline loads the onboarding state and history of the org for the
+// details page, resolving who made each transition to a name where possible.
+func (s *Server) onboardingTimeline(ctx context.Context, oid string) (*OnboardingTimeline, error) {
+	res, err := s.pf.GetOnboarding(ctx, &onbpb.GetOnboardingRequest{OrgID: oid})
+	if err != nil {
+		return nil, err
+	}
+	t := &OnboardingTimeline{
+		State:   onboardingStateLabels[res.GetState()],
+		History: make([]OnboardingEvent, len(res.GetHistory())),
+	}
+	for _, n := range res.GetNextStates() {
+		t.NextStates = append(t.NextStates, OnboardingState{
+			Value: int32(n),
+			Label: onboardingStateLabels[n],
+		})
+	}
+	actors := map[string]string{}
+	for i, h := range res.GetHistory() {
+		a, ok := actors[h.GetActorID()]
+		if !ok {
+			a = h.GetActorID()
+			if u, err := s.rbac.GetUser(ctx, &rbupb.GetUserRequest{ID: a}); err == nil {
+				a = u.GetUser().GetFirstName() + " " + u.GetUser().GetLastName()
+			}
+			actors[h.GetActorID()] = a
+		}
+		t.History[i] = OnboardingEvent{
+			From:    onboardingStateLabels[h.GetFrom()],
+			To:      onboardingStateLabels[h.GetTo()],
+			Actor:   a,
+			Remarks: h.GetRemarks(),
+			Created: h.GetCreated().AsTime(),
+		}
+	}
+	return t, nil
+}
+
+func (s *Server) postDashboardOnboardingTransition(w http.ResponseWriter, r *http.Request) {
+	log := logging.FromContext(r.Context())
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		errMsg := "parsing form"
+		log.WithError(err).Error(errMsg)
+		http.Error(w, errMsg, http.StatusBadRequest)
+		return
+	}
+
+	var f OnboardingTransitionForm
+	if err := s.decoder.Decode(&f, r.PostForm); err != nil {
+		logging.WithError(err, log).Error("decoding form")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+
+	if err := validation.ValidateStruct(&f,
+		validation.Field(&f.OrgID, validation.Required, is.UUIDv4),
+		validation.Field(&f.State, validation.Required, is.Digit),
+		validation.Field(&f.Remarks, validation.Length(0, 1000)),
+	); err != nil {
+		logging.WithError(err, log).Error("invalid request")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+
+	st, err := strconv.Atoi(f.State)
+	if err != nil {
+		logging.WithError(err, log).Error("convert string to int")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+
+	if _, err := s.pf.TransitionOnboarding(ctx, &onbpb.TransitionOnboardingRequest{
+		OrgID:   f.OrgID,
+		State:   onbpb.State(st),
+		Remarks: f.Remarks,
+	}); err != nil {
+		logging.WithError(err, log).Error("transition onboarding")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	http.Redirect(w, r, fmt.Sprintf("/dashboard/dsa-applicant-list/%s", f.OrgID), http.StatusSeeOther)
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"html/template"
@@ -9,9 +10,12 @@ import (
 	"strings"
 
 	"brank.as/petnet/cms/storage"
+	bppb "brank.as/petnet/gunk/drp/v1/bills-payment"
 	"brank.as/petnet/gunk/drp/v1/dsa"
+	pnpb "brank.as/petnet/gunk/drp/v1/partner"
 	revcom "brank.as/petnet/gunk/drp/v1/revenue-commission"
 	epb "brank.as/petnet/gunk/dsa/v1/email"
+	onbpb "brank.as/petnet/gunk/dsa/v2/onboarding"
 	ppb "brank.as/petnet/gunk/dsa/v2/profile"
 	tpb "brank.as/petnet/gunk/dsa/v2/temp"
 	"brank.as/petnet/serviceutil/logging"
@@ -19,6 +23,7 @@ import (
 	rbupb "brank.as/rbac/gunk/v1/user"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -36,6 +41,8 @@ type (
 type iDRP interface {
 	revcom.RevenueCommissionServiceClient
 	dsa.DSAServiceClient
+	pnpb.RemitPartnerServiceClient
+	bppb.BillspaymentServiceClient
 }
 
 func (s *Server) resolveDRP() iDRP {
@@ -200,7 +207,7 @@ func (s *Server) postDashboardChangeStatus(w http.ResponseWriter, r *http.Reques
 			http.Redirect(w, r, errorPath, http.StatusSeeOther)
 			return
 		}
-		if _, err := s.pf.UpsertProfile(ctx, pf); err != nil {
+		if err := s.changeOrgStatus(ctx, pf); err != nil {
 			logging.WithError(err, log).Error("updating status")
 			http.Redirect(w, r, errorPath, http.StatusSeeOther)
 			return
@@ -230,6 +237,45 @@ func (s *Server) postDashboardChangeStatus(w http.ResponseWriter, r *http.Reques
 	http.Redirect(w, r, fmt.Sprintf("/dashboard/dsa-applicant-list/%s?show_otp=true", form.OrgID), http.StatusSeeOther)
 }
 
+// statusOnboardingStates is the onboarding state an org moves to when its
+// status is changed on the dashboard.
+var statusOnboardingStates = map[ppb.Status]onbpb.State{
+	ppb.Status_Pending:          onbpb.State_Submitted,
+	ppb.Status_PendingDocuments: onbpb.State_DocumentsPending,
+	ppb.Status_Completed:        onbpb.State_UnderReview,
+	ppb.Status_Accepted:         onbpb.State_Approved,
+	ppb.Status_Rejected:         onbpb.State_Rejected,
+}
+
+// changeOrgStatus moves the org's onboarding to the state of the requested
+// status, which also sets the org profile status, and stores the other profile
+// changes. The status can only change along the onboarding transitions.
+func (s *Server) changeOrgStatus(ctx context.Context, pf *ppb.UpsertProfileRequest) error {
+	p := pf.GetProfile()
+	to, ok := statusOnboardingStates[p.GetStatus()]
+	if !ok {
+		return fmt.Errorf("no onboarding state for status %s", p.GetStatus())
+	}
+	o, err := s.pf.GetOnboarding(ctx, &onbpb.GetOnboardingRequest{OrgID: p.GetOrgID()})
+	if err != nil {
+		return err
+	}
+	// a live org is still accepted
+	if cur := o.GetState(); cur != to && (cur != onbpb.State_Live || to != onbpb.State_Approved) {
+		if _, err := s.pf.TransitionOnboarding(ctx, &onbpb.TransitionOnboardingRequest{
+			OrgID: p.GetOrgID(),
+			State: to,
+		}); err != nil {
+			return err
+		}
+	}
+
+	up := proto.Clone(pf).(*ppb.UpsertProfileRequest)
+	up.Profile.Status = ppb.Status_UnknownStatus
+	_, err = s.pf.UpsertProfile(ctx, up)
+	return err
+}
+
 func (s *Server) getProfileByDsaCode(w http.ResponseWriter, r *http.Request) {
 	log := logging.FromContext(r.Context())
 	ctx := r.Context()
@@ -15,7 +15,9 @@ import (
 	cicopb "brank.as/petnet/gunk/drp/v1/cashincashout"
 	"brank.as/petnet/gunk/drp/v1/dsa"
 	mipb "brank.as/petnet/gunk/drp/v1/microinsurance"
+	pnpb "brank.as/petnet/gunk/drp/v1/partner"
 	rmpb "brank.as/petnet/gunk/drp/v1/remittance"
+	rtapb "brank.as/petnet/gunk/drp/v1/remittoaccount"
 	revcom "brank.as/petnet/gunk/drp/v1/revenue-commission"
 	tpb "brank.as/petnet/gunk/drp/v1/terminal"
 	epb "brank.as/petnet/gunk/dsa/v1/email"
@@ -25,6 +27,7 @@ import (
 	cspbl "brank.as/petnet/gunk/dsa/v2/cicopartnerlist"
 	fpb "brank.as/petnet/gunk/dsa/v2/fees"
 	fipb "brank.as/petnet/gunk/dsa/v2/file"
+	onbpb "brank.as/petnet/gunk/dsa/v2/onboarding"
 	spb "brank.as/petnet/gunk/dsa/v2/partner"
 	ptnrcom "brank.as/petnet/gunk/dsa/v2/partnercommission"
 	spbl "brank.as/petnet/gunk/dsa/v2/partnerlist"
@@ -125,6 +128,7 @@ type profile interface {
 	ptnrcom.PartnerCommissionServiceClient
 	cspbl.CICOPartnerListServiceClient
 	revsrng.RevenueSharingServiceClient
+	onbpb.OnboardingServiceClient
 }
 
 type drp interface {
@@ -135,6 +139,8 @@ type drp interface {
 	bppb.BillspaymentServiceClient
 	cicopb.CashInCashOutServiceClient
 	mipb.MicroInsuranceServiceClient
+	pnpb.RemitPartnerServiceClient
+	rtapb.RemitToAccountServiceClient
 }
 
 func NewConns(log *logrus.Entry, c *viper.Viper) *Conns {
@@ -270,6 +276,7 @@ func NewSvcClients(cs *Conns) Cl {
 			ptnrcom.PartnerCommissionServiceClient
 			cspbl.CICOPartnerListServiceClient
 			revsrng.RevenueSharingServiceClient
+			onbpb.OnboardingServiceClient
 		}{
 			OrgProfileServiceClient:        pfpb.NewOrgProfileServiceClient(cs.pfInt),
 			TransactionTypeServiceClient:   ttpb.NewTransactionTypeServiceClient(cs.pfInt),
@@ -289,6 +296,7 @@ func NewSvcClients(cs *Conns) Cl {
 			PartnerCommissionServiceClient: ptnrcom.NewPartnerCommissionServiceClient(cs.pfInt),
 			CICOPartnerListServiceClient:   cspbl.NewCICOPartnerListServiceClient(cs.pfInt),
 			RevenueSharingServiceClient:    revsrng.NewRevenueSharingServiceClient(cs.pfInt),
+			OnboardingServiceClient:        onbpb.NewOnboardingServiceClient(cs.pfInt),
 		},
 		rbacUserAuth: struct { // All required RBAC clients
 			rbupb.UserServiceClient
@@ -328,6 +336,8 @@ func NewSvcClients(cs *Conns) Cl {
 			bppb.BillspaymentServiceClient
 			cicopb.CashInCashOutServiceClient
 			mipb.MicroInsuranceServiceClient
+			pnpb.RemitPartnerServiceClient
+			rtapb.RemitToAccountServiceClient
 		}{
 			TerminalServiceClient:          tpb.NewTerminalServiceClient(cs.drpSBIntFwd),
 			RevenueCommissionServiceClient: revcom.NewRevenueCommissionServiceClient(cs.drpSBIntFwd),
@@ -336,6 +346,8 @@ func NewSvcClients(cs *Conns) Cl {
 			BillspaymentServiceClient:      bppb.NewBillspaymentServiceClient(cs.drpSBIntFwd),
 			CashInCashOutServiceClient:     cicopb.NewCashInCashOutServiceClient(cs.drpSBIntFwd),
 			MicroInsuranceServiceClient:    mipb.NewMicroInsuranceServiceClient(cs.drpSBIntFwd),
+			RemitPartnerServiceClient:      pnpb.NewRemitPartnerServiceClient(cs.drpSBIntFwd),
+			RemitToAccountServiceClient:    rtapb.NewRemitToAccountServiceClient(cs.drpSBIntFwd),
 		},
 		drpLV: struct {
 			// All required drp clients
@@ -346,6 +358,8 @@ func NewSvcClients(cs *Conns) Cl {
 			bppb.BillspaymentServiceClient
 			cicopb.CashInCashOutServiceClient
 			mipb.MicroInsuranceServiceClient
+			pnpb.RemitPartnerServiceClient
+			rtapb.RemitToAccountServiceClient
 		}{
 			TerminalServiceClient:          tpb.NewTerminalServiceClient(cs.drpLVIntFwd),
 			RevenueCommissionServiceClient: revcom.NewRevenueCommissionServiceClient(cs.drpLVIntFwd),
@@ -354,6 +368,8 @@ func NewSvcClients(cs *Conns) Cl {
 			BillspaymentServiceClient:      bppb.NewBillspaymentServiceClient(cs.drpLVIntFwd),
 			CashInCashOutServiceClient:     cicopb.NewCashInCashOutServiceClient(cs.drpLVIntFwd),
 			MicroInsuranceServiceClient:    mipb.NewMicroInsuranceServiceClient(cs.drpLVIntFwd),
+			RemitPartnerServiceClient:      pnpb.NewRemitPartnerServiceClient(cs.drpLVIntFwd),
+			RemitToAccountServiceClient:    rtapb.NewRemitToAccountServiceClient(cs.drpLVIntFwd),
 		},
 	}
 }
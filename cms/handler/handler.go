@@ -86,6 +86,7 @@ const (
 	dashboardChangeStatusPath = "/dashboard/change-status"
 	dashboardSendReminderPath = "/dashboard/send-reminder"
 	dashboardCheckDSACodePath = "/dashboard/profile-dsa-code"
+	dashboardOnboardingPath   = "/dashboard/onboarding-transition"
 
 	// signup
 	preliminaryScreenPath = "/registration/preliminary-screen"
@@ -146,8 +147,10 @@ const (
 	transactionListBPGetPath         = "/dashboard/transactionslistbp/:id"
 	transactionListMIGetPath         = "/dashboard/transactionslistmi/:id"
 	transactionListCICOGetPath       = "/dashboard/transactionslistcico/:id"
+	transactionListRTAGetPath        = "/dashboard/transactionslistrta/:id"
 	transactiondetailPath            = "/dashboard/transactions/:id/:oid"
 	transactiondisbursedetailPath    = "/dashboard/transactions-disburse/:id/:oid"
+	transactionRTADetailPath         = "/dashboard/transactions-rta/:id/:oid"
 	dsaTransactionListGetPath        = "/transactions"
 	dsaTransactionDetailPath         = "/transactions/:id"
 	dsaTransactionDisburseDetailPath = "/transactions-disburse/:id"
@@ -177,8 +180,11 @@ const (
 
 	commissionRemoveFeePath   = "/dashboard/commission-mgt-remove/:id"
 	partnerListPathCreate     = "/dashboard/partner-list-create"
+	partnerRegistryPath       = "/dashboard/partner-registry"
+	partnerRegistryDeletePath = "/dashboard/partner-registry/delete/:code"
 	revenueShareMgtPath       = "/dashboard/revenue-sharing-mgt/:id"
 	revenueShareMgtRemovePath = "/dashboard/revenue-sharing-mgt-remove/:oid/:id"
+	revenueShareStatementPath = "/dashboard/revenue-sharing-mgt/:id/statement"
 
 	// Services
 	partnerServicesPath = "/dashboard/partner-services"
@@ -392,6 +398,12 @@ func NewServer(mux *goji.Mux,
 		n.Handle(goji.Get(d(transactionListCICOGetPath)), v(
 			s.getTransactionListCICOSandbox, pm.TransactionRes, pm.ReadAct),
 		)
+		n.Handle(goji.Get(d(transactionListRTAGetPath)), v(
+			s.getRTATransactionList, pm.TransactionRes, pm.ReadAct),
+		)
+		n.Handle(goji.Get(d(transactionRTADetailPath)), v(
+			s.getRTATransactionDetails, pm.TransactionRes, pm.ReadAct),
+		)
 		n.Handle(goji.Get(d(transactiondetailPath)), v(
 			s.getTransactionDetails, pm.TransactionRes, pm.ReadAct),
 		)
@@ -406,6 +418,9 @@ func NewServer(mux *goji.Mux,
 		n.HandleFunc(goji.Post(d(dashboardSendReminderPath)), s.postDashboardSendReminder)
 		n.HandleFunc(goji.Post(d(dashboardChangeStatusPath)), s.postDashboardChangeStatus)
 		n.HandleFunc(goji.Post(d(dashboardCheckDSACodePath)), s.getProfileByDsaCode)
+		n.Handle(goji.Post(d(dashboardOnboardingPath)), v(
+			s.postDashboardOnboardingTransition, pm.DSAListDetailRes, pm.UpdateAct),
+		)
 
 		// manage members
 		n.HandleFunc(goji.Get(d(manageUserListPath)), s.getManageUserList)
@@ -428,6 +443,9 @@ func NewServer(mux *goji.Mux,
 		n.HandleFunc(goji.Post(d(disclosurePath)), s.postSpecificFieldData)
 		n.HandleFunc(goji.Get(d(partnerListPath)), s.getPartnerLists)
 		n.HandleFunc(goji.Post(d(partnerListPathCreate)), s.postPartnerLists)
+		n.HandleFunc(goji.Get(d(partnerRegistryPath)), s.getPartnerRegistry)
+		n.HandleFunc(goji.Post(d(partnerRegistryPath)), s.postPartnerRegistry)
+		n.HandleFunc(goji.Post(d(partnerRegistryDeletePath)), s.deletePartnerRegistry)
 
 		// Commission Fee
 		n.HandleFunc(goji.Get(d(commissionFeePath)), s.doGetCommissionMgt)
@@ -468,6 +486,7 @@ func NewServer(mux *goji.Mux,
 		n.HandleFunc(goji.Get(d(revenueShareMgtPath)), s.getRevenueSharingMgt)
 		n.HandleFunc(goji.Post(d(revenueShareMgtPath)), s.postRevenueSharingMgt)
 		n.HandleFunc(goji.Delete(d(revenueShareMgtRemovePath)), s.removeRevenueSharingMgt)
+		n.HandleFunc(goji.Get(d(revenueShareStatementPath)), s.getRevenueSharingStatement)
 	}
 
 	// profile
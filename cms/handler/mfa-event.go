@@ -160,7 +160,7 @@ func (s *Server) postConfirmMFAEvent(w http.ResponseWriter, r *http.Request) {
 				http.Redirect(w, r, "/dashboard/dsa-applicant-list/"+pf.Profile.OrgID+"?otp_failed", http.StatusSeeOther)
 				return
 			}
-			if _, err := s.pf.UpsertProfile(ctx, pf); err != nil {
+			if err := s.changeOrgStatus(ctx, pf); err != nil {
 				logging.WithError(err, log).Error("updating status")
 				http.Redirect(w, r, errorPath, http.StatusSeeOther)
 				return
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	fpb "brank.as/petnet/gunk/dsa/v2/file"
+	onbpb "brank.as/petnet/gunk/dsa/v2/onboarding"
 	ppb "brank.as/petnet/gunk/dsa/v2/profile"
 )
 
@@ -154,6 +155,13 @@ func (s *Server) postDRPInfo(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, errorPath, http.StatusSeeOther)
 		return
 	}
+	if _, err := s.pf.TransitionOnboarding(ctx, &onbpb.TransitionOnboardingRequest{
+		OrgID: oid,
+		State: onbpb.State_Submitted,
+	}); err != nil {
+		// the application itself is saved, don't fail the submission over the timeline
+		logging.WithError(err, log).Error("recording onboarding submission")
+	}
 
 	// todo(robin): if we decide to add another bucket make sure the bucket name is saved
 	if _, err := s.pf.UpsertFiles(ctx, &fpb.UpsertFilesRequest{
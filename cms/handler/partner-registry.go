@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/csrf"
+	"github.com/kenshaw/goji"
+
+	pnpb "brank.as/petnet/gunk/drp/v1/partner"
+	"brank.as/petnet/serviceutil/logging"
+	"brank.as/petnet/svcutil/mw"
+)
+
+type (
+	RegistryPartnerForm struct {
+		PartnerCode   string
+		PartnerName   string
+		Countries     string
+		SendTypes     string
+		DisburseTypes string
+		Enabled       bool
+		New           bool
+	}
+
+	partnerRegistryTemplateData struct {
+		Partners         []RegistryPartnerForm
+		UserInfo         *User
+		PresetPermission map[string]map[string]bool
+		ServiceRequest   bool
+		CSRFField        template.HTML
+		Error            string
+	}
+)
+
+func (s *Server) getPartnerRegistry(w http.ResponseWriter, r *http.Request) {
+	log := logging.FromContext(r.Context())
+	template := s.templates.Lookup("partner-registry.html")
+	if template == nil {
+		log.Error("unable to load template")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+
+	res, err := s.resolveDRP().ListRegistryPartners(r.Context(), &pnpb.ListRegistryPartnersRequest{})
+	if err != nil {
+		logging.WithError(err, log).Error("listing registry partners")
+	}
+	ps := make([]RegistryPartnerForm, 0, len(res.GetPartners()))
+	for _, p := range res.GetPartners() {
+		st, _ := json.MarshalIndent(p.GetSendTypes(), "", "  ")
+		dt, _ := json.MarshalIndent(p.GetDisburseTypes(), "", "  ")
+		ps = append(ps, RegistryPartnerForm{
+			PartnerCode:   p.GetPartnerCode(),
+			PartnerName:   p.GetPartnerName(),
+			Countries:     strings.Join(p.GetCountries(), ","),
+			SendTypes:     string(st),
+			DisburseTypes: string(dt),
+			Enabled:       p.GetEnabled(),
+		})
+	}
+
+	usrInfo := s.GetUserInfoFromCookie(w, r, false)
+	etd := s.getEnforceTemplateData(r.Context())
+	templateData := partnerRegistryTemplateData{
+		Partners:         ps,
+		UserInfo:         &usrInfo.UserInfo,
+		PresetPermission: etd.PresetPermission,
+		ServiceRequest:   etd.ServiceRequests,
+		CSRFField:        csrf.TemplateField(r),
+		Error:            r.URL.Query().Get("error"),
+	}
+	templateData.UserInfo.ProfileImage = usrInfo.ProfileImage
+	if err := template.Execute(w, templateData); err != nil {
+		logging.WithError(err, log).Error("error with template execution")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+}
+
+func (s *Server) postPartnerRegistry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logging.FromContext(ctx)
+	if err := r.ParseForm(); err != nil {
+		logging.WithError(err, log).Error("parsing form")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	var form RegistryPartnerForm
+	if err := s.decoder.Decode(&form, r.PostForm); err != nil {
+		logging.WithError(err, log).Error("decoding form")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+
+	fail := func(msg string) {
+		http.Redirect(w, r, partnerRegistryPath+"?error="+url.QueryEscape(msg), http.StatusSeeOther)
+	}
+	p := &pnpb.RegistryPartner{
+		PartnerCode: strings.TrimSpace(form.PartnerCode),
+		PartnerName: strings.TrimSpace(form.PartnerName),
+		Enabled:     form.Enabled,
+		UpdatedBy:   mw.GetUserID(ctx),
+	}
+	for _, c := range strings.Split(form.Countries, ",") {
+		if c = strings.ToUpper(strings.TrimSpace(c)); c != "" {
+			p.Countries = append(p.Countries, c)
+		}
+	}
+	for _, t := range []struct {
+		in  string
+		out *map[string]*pnpb.RegistryRemitType
+	}{
+		{in: form.SendTypes, out: &p.SendTypes},
+		{in: form.DisburseTypes, out: &p.DisburseTypes},
+	} {
+		if strings.TrimSpace(t.in) == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(t.in), t.out); err != nil {
+			logging.WithError(err, log).Error("decoding remit types")
+			fail("invalid remit types")
+			return
+		}
+	}
+
+	var err error
+	if form.New {
+		_, err = s.resolveDRP().CreateRegistryPartner(ctx, &pnpb.CreateRegistryPartnerRequest{Partner: p})
+	} else {
+		_, err = s.resolveDRP().UpdateRegistryPartner(ctx, &pnpb.UpdateRegistryPartnerRequest{Partner: p})
+	}
+	if err != nil {
+		logging.WithError(err, log).Error("saving registry partner")
+		fail("unable to save partner " + p.GetPartnerCode())
+		return
+	}
+	http.Redirect(w, r, partnerRegistryPath, http.StatusSeeOther)
+}
+
+func (s *Server) deletePartnerRegistry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logging.FromContext(ctx)
+	code := goji.Param(r, "code")
+	if _, err := s.resolveDRP().DeleteRegistryPartner(ctx, &pnpb.DeleteRegistryPartnerRequest{
+		PartnerCode: code,
+	}); err != nil {
+		logging.WithError(err, log).Error("deleting registry partner")
+		http.Redirect(w, r, partnerRegistryPath+"?error="+url.QueryEscape("unable to delete partner "+code), http.StatusSeeOther)
+		return
+	}
+	http.Redirect(w, r, partnerRegistryPath, http.StatusSeeOther)
+}
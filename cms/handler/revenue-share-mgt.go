@@ -9,7 +9,9 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
+	rcpb "brank.as/petnet/gunk/drp/v1/revenue-commission"
 	fpb "brank.as/petnet/gunk/dsa/v2/file"
 	ptnrcom "brank.as/petnet/gunk/dsa/v2/partnercommission"
 	spbl "brank.as/petnet/gunk/dsa/v2/partnerlist"
@@ -309,6 +311,42 @@ func (s *Server) getRevenueSharingMgt(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (s *Server) getRevenueSharingStatement(w http.ResponseWriter, r *http.Request) {
+	log := logging.FromContext(r.Context())
+	oid := goji.Param(r, "id")
+	if oid == "" {
+		log.Error("org id shouldn't empty")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	ym := r.URL.Query().Get("year_month")
+	if ym == "" {
+		ym = time.Now().AddDate(0, -1, 0).Format("200601")
+	}
+	// the month picker submits YYYY-MM
+	ym = strings.ReplaceAll(ym, "-", "")
+	format := rcpb.StatementFormat_StatementFormatCSV
+	if strings.EqualFold(r.URL.Query().Get("format"), "pdf") {
+		format = rcpb.StatementFormat_StatementFormatPDF
+	}
+	res, err := s.resolveDRP().GenerateCommissionStatement(r.Context(), &rcpb.GenerateCommissionStatementRequest{
+		OrgID:     oid,
+		YearMonth: ym,
+		Format:    format,
+	})
+	if err != nil {
+		logging.WithError(err, log).Error("generating commission statement")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	w.Header().Set("Content-Type", res.GetContentType())
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.GetFileName()))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(res.GetContent()); err != nil {
+		logging.WithError(err, log).Error("writing commission statement")
+	}
+}
+
 func (s *Server) removeRevenueSharingMgt(w http.ResponseWriter, r *http.Request) {
 	log := logging.FromContext(r.Context())
 	ctx := r.Context()
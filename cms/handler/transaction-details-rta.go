@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+
+	rtapb "brank.as/petnet/gunk/drp/v1/remittoaccount"
+	"brank.as/petnet/serviceutil/logging"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/kenshaw/goji"
+)
+
+type transactionRTADetailsTempData struct {
+	RTADetails       *rtapb.RTATransact
+	UserInfo         *User
+	OrgID            string
+	PresetPermission map[string]map[string]bool
+	ServiceRequest   bool
+}
+
+func (s *Server) getRTATransactionDetails(w http.ResponseWriter, r *http.Request) {
+	log := logging.FromContext(r.Context())
+	ctx := r.Context()
+	id := goji.Param(r, "id")
+	if id == "" {
+		log.Error("missing id query param")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	oid := goji.Param(r, "oid")
+	if oid == "" {
+		log.Error("missing org id query param")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	template := s.templates.Lookup("transaction-detail-rta.html")
+	if template == nil {
+		log.Error("unable to load template")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	md := metautils.ExtractIncoming(ctx)
+	ctx = md.Add("x-forward-dsaorgid", oid).ToOutgoing(ctx)
+	lr := &rtapb.RTATransactListRequest{
+		OrgID:           oid,
+		ReferenceNumber: id,
+		Limit:           1,
+	}
+	apiEnvVal, _ := url.PathUnescape(r.URL.Query().Get(apiEnv))
+	var res *rtapb.RTATransactListResponse
+	var err error
+	if apiEnvVal == "production" {
+		res, err = s.drpLV.RTATransactList(ctx, lr)
+	} else {
+		res, err = s.drpSB.RTATransactList(ctx, lr)
+	}
+	if err != nil {
+		logging.WithError(err, log).Error("unable to connect api")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	if len(res.GetRTATransacts()) == 0 {
+		log.Error("don't have any transaction")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+
+	usrInfo := s.GetUserInfoFromCookie(w, r, false)
+	etd := s.getEnforceTemplateData(ctx)
+	details := transactionRTADetailsTempData{
+		RTADetails:       res.GetRTATransacts()[0],
+		UserInfo:         &usrInfo.UserInfo,
+		OrgID:            oid,
+		PresetPermission: etd.PresetPermission,
+		ServiceRequest:   etd.ServiceRequests,
+	}
+	if err := template.Execute(w, details); err != nil {
+		logging.WithError(err, log).Error("error with template execution")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+}
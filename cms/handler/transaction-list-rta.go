@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"brank.as/petnet/cms/paginator"
+	rtapb "brank.as/petnet/gunk/drp/v1/remittoaccount"
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/logging"
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"github.com/kenshaw/goji"
+)
+
+type transactionRTATempData struct {
+	RTATransacts     []*rtapb.RTATransact
+	UserInfo         *User
+	CompanyName      string
+	PaginationData   paginator.Paginator
+	SearchTerms      string
+	OrgId            string
+	Environment      string
+	HasLiveAccess    bool
+	PresetPermission map[string]map[string]bool
+	ServiceRequest   bool
+}
+
+func (s *Server) getRTATransactionList(w http.ResponseWriter, r *http.Request) {
+	log := logging.FromContext(r.Context())
+	ctx := r.Context()
+	md := metautils.ExtractIncoming(ctx)
+	oid := goji.Param(r, "id")
+
+	if oid == "" {
+		log.Error("missing id query param")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	template := s.templates.Lookup("transaction-list-rta.html")
+	if template == nil {
+		log.Error("unable to load template")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+	queryParams := r.URL.Query()
+	pageNumber, err := url.PathUnescape(queryParams.Get("page"))
+	if err != nil {
+		logging.WithError(err, log).Error("unable to decode url type param")
+	}
+	apiEnvVal, _ := url.PathUnescape(queryParams.Get(apiEnv))
+	apiEnvType := "sandbox"
+	if apiEnvVal == "production" {
+		apiEnvType = "production"
+	}
+	var offset int32 = 0
+	convertedPageNumber, _ := strconv.Atoi(pageNumber)
+	if convertedPageNumber <= 0 {
+		convertedPageNumber = 1
+	} else {
+		offset = limitPerPage*int32(convertedPageNumber) - limitPerPage
+	}
+
+	searchTerms, err := url.PathUnescape(queryParams.Get("search-term"))
+	if err != nil {
+		logging.WithError(err, log).Error("unable to decode url type param")
+	}
+
+	sb, err := url.PathUnescape(queryParams.Get("sort"))
+	if err != nil {
+		logging.WithError(err, log).Error("unable to decode url type param")
+	}
+
+	sbv := rtapb.SortOrder_DESC
+	if sb == "asc" {
+		sbv = rtapb.SortOrder_ASC
+	}
+
+	sbc, err := url.PathUnescape(queryParams.Get("sort_column"))
+	if err != nil {
+		logging.WithError(err, log).Error("unable to decode url type param")
+	}
+
+	currentPage, _ := strconv.Atoi(queryParams.Get("page"))
+	if currentPage == 0 {
+		currentPage = 1
+	}
+	sbcv := rtapb.SortByColumn_TransactionCompletedTime
+	switch sbc {
+	case "partner":
+		sbcv = rtapb.SortByColumn_Partner
+	case "dateprocessed":
+		sbcv = rtapb.SortByColumn_TransactionCompletedTime
+	case "rtaamount":
+		sbcv = rtapb.SortByColumn_TotalAmount
+	case "servicecharge":
+		sbcv = rtapb.SortByColumn_ServiceCharge
+	}
+	prfl, err := s.pf.GetProfile(ctx, &ppb.GetProfileRequest{OrgID: oid})
+	if err != nil {
+		logging.WithError(err, log).Info("getting profile")
+	}
+	etd := s.getEnforceTemplateData(ctx)
+	profile := prfl.GetProfile()
+	ctx = md.Add("x-forward-dsaorgid", oid).ToOutgoing(ctx)
+	lr := &rtapb.RTATransactListRequest{
+		Limit:           limitPerPage,
+		Offset:          offset,
+		SortOrder:       sbv,
+		SortByColumn:    sbcv,
+		OrgID:           oid,
+		ReferenceNumber: searchTerms,
+	}
+	var res *rtapb.RTATransactListResponse
+	if apiEnvType == "production" {
+		res, err = s.drpLV.RTATransactList(ctx, lr)
+	} else {
+		res, err = s.drpSB.RTATransactList(ctx, lr)
+	}
+	if err != nil {
+		logging.WithError(err, log).Error("listing transactions rta")
+	}
+
+	usrInfo := s.GetUserInfoFromCookie(w, r, false)
+	var total int32
+	tempData := transactionRTATempData{
+		RTATransacts:     []*rtapb.RTATransact{},
+		UserInfo:         &usrInfo.UserInfo,
+		SearchTerms:      searchTerms,
+		OrgId:            oid,
+		Environment:      apiEnvType,
+		HasLiveAccess:    s.hasLiveAccess(r.Context(), oid),
+		CompanyName:      profile.GetBusinessInfo().GetCompanyName(),
+		PresetPermission: etd.PresetPermission,
+		ServiceRequest:   etd.ServiceRequests,
+	}
+	if res != nil && res.RTATransacts != nil {
+		tempData.RTATransacts = res.GetRTATransacts()
+		total = res.Total
+	}
+
+	if len(tempData.RTATransacts) > 0 {
+		tempData.PaginationData = paginator.NewPaginator(int32(currentPage), limitPerPage, total, r)
+	}
+
+	tempData.UserInfo.ProfileImage = usrInfo.ProfileImage
+	if err := template.Execute(w, tempData); err != nil {
+		logging.WithError(err, log).Error("error with template execution")
+		http.Redirect(w, r, errorPath, http.StatusSeeOther)
+		return
+	}
+}
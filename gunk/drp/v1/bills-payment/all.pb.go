@@ -2015,6 +2015,14 @@ type BillsPaymentTransactListRequest struct {
 	ReferenceNumber string       `protobuf:"bytes,7,opt,name=ReferenceNumber,json=reference_number,proto3" json:"reference_number,omitempty"`
 	ExcludePartners []string     `protobuf:"bytes,8,rep,name=ExcludePartners,json=exclude_partner,proto3" json:"exclude_partner,omitempty"`
 	OrgID           string       `protobuf:"bytes,9,opt,name=OrgID,json=org_id,proto3" json:"org_id,omitempty"`
+	// PageToken lists the page after the one that returned it as
+	// next_page_token. Offset and sort_by_column are ignored and total is not
+	// counted when it is set.
+	PageToken string `protobuf:"bytes,10,opt,name=PageToken,json=page_token,proto3" json:"page_token,omitempty"`
+	// BranchID and TellerID list only the transactions attributed to the
+	// branch or teller.
+	BranchID string `protobuf:"bytes,11,opt,name=BranchID,json=branch_id,proto3" json:"branch_id,omitempty"`
+	TellerID string `protobuf:"bytes,12,opt,name=TellerID,json=teller_id,proto3" json:"teller_id,omitempty"`
 }
 
 func (x *BillsPaymentTransactListRequest) Reset() {
@@ -2112,6 +2120,27 @@ func (x *BillsPaymentTransactListRequest) GetOrgID() string {
 	return ""
 }
 
+func (x *BillsPaymentTransactListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *BillsPaymentTransactListRequest) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *BillsPaymentTransactListRequest) GetTellerID() string {
+	if x != nil {
+		return x.TellerID
+	}
+	return ""
+}
+
 type BillsPaymentTransactListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2120,6 +2149,9 @@ type BillsPaymentTransactListResponse struct {
 	Next          int32           `protobuf:"varint,1,opt,name=Next,json=next,proto3" json:"next,omitempty"`
 	BillsPayments []*BillsPayment `protobuf:"bytes,2,rep,name=BillsPayments,json=bills_payments,proto3" json:"bills_payments,omitempty"`
 	Total         int32           `protobuf:"varint,3,opt,name=Total,json=total,proto3" json:"total,omitempty"`
+	// NextPageToken is set when sort_by_column is omitted and more rows
+	// may follow.
+	NextPageToken string `protobuf:"bytes,4,opt,name=NextPageToken,json=next_page_token,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *BillsPaymentTransactListResponse) Reset() {
@@ -2175,6 +2207,13 @@ func (x *BillsPaymentTransactListResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *BillsPaymentTransactListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Amount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2243,6 +2282,8 @@ type BillsPayment struct {
 	TransactFee              *Amount                `protobuf:"bytes,4,opt,name=TransactFee,json=transact_fee,proto3" json:"transact_fee,omitempty"`
 	TransactCommission       *Amount                `protobuf:"bytes,5,opt,name=TransactCommission,json=transact_commission,proto3" json:"transact_commission,omitempty"`
 	TransactionCompletedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=TransactionCompletedTime,json=transaction_completed_time,proto3" json:"transaction_completed_time,omitempty"`
+	BranchID                 string                 `protobuf:"bytes,7,opt,name=BranchID,json=branch_id,proto3" json:"branch_id,omitempty"`
+	TellerID                 string                 `protobuf:"bytes,8,opt,name=TellerID,json=teller_id,proto3" json:"teller_id,omitempty"`
 }
 
 func (x *BillsPayment) Reset() {
@@ -2319,6 +2360,505 @@ func (x *BillsPayment) GetTransactionCompletedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BillsPayment) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *BillsPayment) GetTellerID() string {
+	if x != nil {
+		return x.TellerID
+	}
+	return ""
+}
+
+type BPRetryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillPaymentID string `protobuf:"bytes,1,opt,name=BillPaymentID,json=bill_payment_id,proto3" json:"bill_payment_id,omitempty"`
+	// ID is the perahub transaction id of the payment to retry.
+	ID int32 `protobuf:"varint,2,opt,name=ID,json=id,proto3" json:"id,omitempty"`
+	// Partner is the biller the payment was made through.
+	Partner string `protobuf:"bytes,3,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+}
+
+func (x *BPRetryRequest) Reset() {
+	*x = BPRetryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPRetryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPRetryRequest) ProtoMessage() {}
+
+func (x *BPRetryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPRetryRequest.ProtoReflect.Descriptor instead.
+func (*BPRetryRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BPRetryRequest) GetBillPaymentID() string {
+	if x != nil {
+		return x.BillPaymentID
+	}
+	return ""
+}
+
+func (x *BPRetryRequest) GetID() int32 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *BPRetryRequest) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+type BPVoidRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillPaymentID string `protobuf:"bytes,1,opt,name=BillPaymentID,json=bill_payment_id,proto3" json:"bill_payment_id,omitempty"`
+	// Partner is the biller the payment was made through.
+	Partner string `protobuf:"bytes,2,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+}
+
+func (x *BPVoidRequest) Reset() {
+	*x = BPVoidRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPVoidRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPVoidRequest) ProtoMessage() {}
+
+func (x *BPVoidRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPVoidRequest.ProtoReflect.Descriptor instead.
+func (*BPVoidRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BPVoidRequest) GetBillPaymentID() string {
+	if x != nil {
+		return x.BillPaymentID
+	}
+	return ""
+}
+
+func (x *BPVoidRequest) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+type BPSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillPaymentID string `protobuf:"bytes,1,opt,name=BillPaymentID,json=bill_payment_id,proto3" json:"bill_payment_id,omitempty"`
+	// Partner is the biller the payment was made through.
+	Partner string `protobuf:"bytes,2,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+}
+
+func (x *BPSearchRequest) Reset() {
+	*x = BPSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPSearchRequest) ProtoMessage() {}
+
+func (x *BPSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPSearchRequest.ProtoReflect.Descriptor instead.
+func (*BPSearchRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BPSearchRequest) GetBillPaymentID() string {
+	if x != nil {
+		return x.BillPaymentID
+	}
+	return ""
+}
+
+func (x *BPSearchRequest) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+// BPTransactionStatus is the bill payment history row after a retry, void or
+// search.
+type BPTransactionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BillPaymentID   string `protobuf:"bytes,1,opt,name=BillPaymentID,json=bill_payment_id,proto3" json:"bill_payment_id,omitempty"`
+	Partner         string `protobuf:"bytes,2,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+	ReferenceNumber string `protobuf:"bytes,3,opt,name=ReferenceNumber,json=reference_number,proto3" json:"reference_number,omitempty"`
+	// Status is the recorded bill payment status.
+	Status string `protobuf:"bytes,4,opt,name=Status,json=status,proto3" json:"status,omitempty"`
+	// PartnerStatus is the status reported by the biller.
+	PartnerStatus string `protobuf:"bytes,5,opt,name=PartnerStatus,json=partner_status,proto3" json:"partner_status,omitempty"`
+	Message       string `protobuf:"bytes,6,opt,name=Message,json=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BPTransactionStatus) Reset() {
+	*x = BPTransactionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPTransactionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPTransactionStatus) ProtoMessage() {}
+
+func (x *BPTransactionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPTransactionStatus.ProtoReflect.Descriptor instead.
+func (*BPTransactionStatus) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BPTransactionStatus) GetBillPaymentID() string {
+	if x != nil {
+		return x.BillPaymentID
+	}
+	return ""
+}
+
+func (x *BPTransactionStatus) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+func (x *BPTransactionStatus) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *BPTransactionStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BPTransactionStatus) GetPartnerStatus() string {
+	if x != nil {
+		return x.PartnerStatus
+	}
+	return ""
+}
+
+func (x *BPTransactionStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BPWalletBalancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Partner limits the history to one biller.
+	Partner string                 `protobuf:"bytes,1,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+	From    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=From,json=from,proto3" json:"from,omitempty"`
+	Until   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=Until,json=until,proto3" json:"until,omitempty"`
+	Limit   int32                  `protobuf:"varint,4,opt,name=Limit,json=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *BPWalletBalancesRequest) Reset() {
+	*x = BPWalletBalancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPWalletBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPWalletBalancesRequest) ProtoMessage() {}
+
+func (x *BPWalletBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPWalletBalancesRequest.ProtoReflect.Descriptor instead.
+func (*BPWalletBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BPWalletBalancesRequest) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+func (x *BPWalletBalancesRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *BPWalletBalancesRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *BPWalletBalancesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// BPWalletBalance is a biller prefund wallet balance sample.
+type BPWalletBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partner   string                 `protobuf:"bytes,1,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+	Balance   *Amount                `protobuf:"bytes,2,opt,name=Balance,json=balance,proto3" json:"balance,omitempty"`
+	Threshold *Amount                `protobuf:"bytes,3,opt,name=Threshold,json=threshold,proto3" json:"threshold,omitempty"`
+	Low       bool                   `protobuf:"varint,4,opt,name=Low,json=low,proto3" json:"low,omitempty"`
+	Error     string                 `protobuf:"bytes,5,opt,name=Error,json=error,proto3" json:"error,omitempty"`
+	Checked   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=Checked,json=checked,proto3" json:"checked,omitempty"`
+}
+
+func (x *BPWalletBalance) Reset() {
+	*x = BPWalletBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPWalletBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPWalletBalance) ProtoMessage() {}
+
+func (x *BPWalletBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPWalletBalance.ProtoReflect.Descriptor instead.
+func (*BPWalletBalance) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BPWalletBalance) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+func (x *BPWalletBalance) GetBalance() *Amount {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *BPWalletBalance) GetThreshold() *Amount {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+func (x *BPWalletBalance) GetLow() bool {
+	if x != nil {
+		return x.Low
+	}
+	return false
+}
+
+func (x *BPWalletBalance) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BPWalletBalance) GetChecked() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Checked
+	}
+	return nil
+}
+
+type BPWalletBalancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Balances is the latest balance of each biller.
+	Balances []*BPWalletBalance `protobuf:"bytes,1,rep,name=Balances,json=balances,proto3" json:"balances,omitempty"`
+	// History is the balance samples in the requested range, newest first.
+	History []*BPWalletBalance `protobuf:"bytes,2,rep,name=History,json=history,proto3" json:"history,omitempty"`
+}
+
+func (x *BPWalletBalancesResponse) Reset() {
+	*x = BPWalletBalancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BPWalletBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BPWalletBalancesResponse) ProtoMessage() {}
+
+func (x *BPWalletBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BPWalletBalancesResponse.ProtoReflect.Descriptor instead.
+func (*BPWalletBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BPWalletBalancesResponse) GetBalances() []*BPWalletBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+func (x *BPWalletBalancesResponse) GetHistory() []*BPWalletBalance {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
 var File_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto protoreflect.FileDescriptor
 
 var file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDesc = []byte{
@@ -2812,7 +3352,7 @@ var file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDesc = []byte{
 	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x63, 0x6f,
 	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
 	0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x63, 0x6f, 0x5f, 0x69, 0x64, 0x3a, 0x06,
-	0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xe3, 0x03, 0x0a, 0x1f, 0x42, 0x69, 0x6c, 0x6c, 0x73,
+	0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xe0, 0x04, 0x0a, 0x1f, 0x42, 0x69, 0x6c, 0x6c, 0x73,
 	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c,
 	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x46, 0x72,
 	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
@@ -2841,217 +3381,432 @@ var file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDesc = []byte{
 	0x50, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x61, 0x72, 0x74,
 	0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x05, 0x4f, 0x72, 0x67, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06,
-	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x3a, 0x14, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41,
-	0x0b, 0x0a, 0x09, 0xd2, 0x01, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xbc, 0x01, 0x0a,
-	0x20, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x78,
-	0x74, 0x12, 0x4e, 0x0a, 0x0d, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73,
-	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
-	0x00, 0x52, 0x0e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x20, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0x75, 0x0a, 0x06, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
-	0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
-	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x3a, 0x1f, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x22, 0xb9, 0x03, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
-	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x61,
-	0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
-	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72,
-	0x12, 0x44, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00,
-	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69,
-	0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x52, 0x0a, 0x12,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73,
-	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
-	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x13, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x64, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x27, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x44, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
+	0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x54, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x3a, 0x14, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x0b, 0x0a, 0x09,
+	0xd2, 0x01, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xf0, 0x01, 0x0a, 0x20, 0x42, 0x69,
+	0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x04, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x4e,
+	0x0a, 0x0d, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0e,
+	0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x32, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
+	0x00, 0x50, 0x00, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0x75, 0x0a, 0x06,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x3a, 0x1f, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2,
+	0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x22, 0x8b, 0x04, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x07, 0x50,
+	0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65,
+	0x72, 0x12, 0x44, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x52, 0x0a,
+	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c,
+	0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x13, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x64, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x1a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09,
+	0x74, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18,
+	0x00, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x42, 0x50, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0d, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
+	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
+	0x00, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x3a, 0x2c, 0x08, 0x00, 0x10, 0x00,
+	0x18, 0x00, 0x92, 0x41, 0x23, 0x0a, 0x21, 0xd2, 0x01, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x02, 0x69, 0x64, 0xd2, 0x01,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x0d, 0x42, 0x50, 0x56,
+	0x6f, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0d, 0x42, 0x69,
+	0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0f, 0x62,
+	0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x24,
+	0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x3a, 0x27, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x1e, 0x0a,
+	0x1c, 0xd2, 0x01, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0xd2, 0x01, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x22, 0x94, 0x01,
+	0x0a, 0x0f, 0x42, 0x50, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x32, 0x0a, 0x0d, 0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x3a, 0x27, 0x08, 0x00, 0x10,
+	0x00, 0x18, 0x00, 0x92, 0x41, 0x1e, 0x0a, 0x1c, 0xd2, 0x01, 0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x5f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x07, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x22, 0xab, 0x02, 0x0a, 0x13, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x32, 0x0a, 0x0d,
+	0x42, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
+	0x0f, 0x62, 0x69, 0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x12, 0x24, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70,
+	0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x31, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
+	0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00,
+	0x18, 0x00, 0x22, 0xe3, 0x01, 0x0a, 0x17, 0x42, 0x50, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a,
-	0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x1a, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x2a, 0x2a,
-	0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x04, 0x44,
-	0x45, 0x53, 0x43, 0x10, 0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x41, 0x53, 0x43,
-	0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x2a, 0xae, 0x01, 0x0a, 0x0c, 0x53,
-	0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x10, 0x4f,
-	0x6d, 0x69, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x10,
-	0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x17, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x13,
-	0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x02, 0x1a,
-	0x02, 0x08, 0x00, 0x12, 0x20, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x10,
-	0x03, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0f, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72,
-	0x10, 0x04, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x10, 0x05, 0x1a,
-	0x02, 0x08, 0x00, 0x12, 0x12, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x10, 0x06, 0x1a, 0x02, 0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x32, 0xd9, 0x11, 0x0a, 0x13,
-	0x42, 0x69, 0x6c, 0x6c, 0x73, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x95, 0x03, 0x0a, 0x0a, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00,
-	0x92, 0x41, 0x8e, 0x02, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x1a, 0x16, 0x42, 0x69, 0x6c, 0x6c,
-	0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5a, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x53, 0x0a, 0x1e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x31, 0x0a,
-	0x2f, 0x1a, 0x2d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x4a, 0x60, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x3c, 0x0a, 0x05, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a, 0x08, 0x00, 0x18,
+	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x20,
+	0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xbf, 0x02, 0x0a, 0x0f, 0x42, 0x50, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x07,
+	0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e,
+	0x65, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x3f, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x1c, 0x0a, 0x03, 0x4c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x20,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x40, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x65, 0x64, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x42,
+	0x50, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x69, 0x6c, 0x6c,
+	0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x44, 0x0a, 0x07, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x42, 0x50, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x2a, 0x2a, 0x0a,
+	0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x04, 0x44, 0x45,
+	0x53, 0x43, 0x10, 0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10,
+	0x01, 0x1a, 0x02, 0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x2a, 0xae, 0x01, 0x0a, 0x0c, 0x53, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x10, 0x4f, 0x6d,
+	0x69, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x10, 0x00,
+	0x1a, 0x02, 0x08, 0x00, 0x12, 0x17, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x13, 0x0a,
+	0x0b, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x02, 0x1a, 0x02,
+	0x08, 0x00, 0x12, 0x20, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x03,
+	0x1a, 0x02, 0x08, 0x00, 0x12, 0x0f, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x10,
+	0x04, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x10, 0x05, 0x1a, 0x02,
+	0x08, 0x00, 0x12, 0x12, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x10, 0x06, 0x1a, 0x02, 0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x32, 0xdc, 0x1e, 0x0a, 0x13, 0x42,
+	0x69, 0x6c, 0x6c, 0x73, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x95, 0x03, 0x0a, 0x0a, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbd, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92,
+	0x41, 0x8e, 0x02, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x1a, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x73,
+	0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x4a, 0x5a, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x53, 0x0a, 0x1e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x31, 0x0a, 0x2f,
+	0x1a, 0x2d, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a,
+	0x60, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x2e, 0x12, 0x3d, 0x0a, 0x3b, 0x4a, 0x39, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20,
+	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x28, 0x00, 0x30, 0x00, 0x12, 0xd0, 0x03, 0x0a, 0x11, 0x42,
+	0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x12, 0x27, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x6c, 0x6c,
+	0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xe3, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xad, 0x02,
+	0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x1a, 0x1e,
+	0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x4a, 0x61, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x5a, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x38, 0x0a, 0x36, 0x1a, 0x34, 0x23,
+	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c,
+	0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4a, 0x68, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x61, 0x0a, 0x18, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20,
+	0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x45, 0x0a, 0x43, 0x4a, 0x41, 0x7b, 0x20, 0x22, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x49, 0x6e, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c,
+	0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0x95, 0x03,
+	0x0a, 0x0a, 0x42, 0x50, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42,
+	0x50, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xbd, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x8e, 0x02, 0x0a, 0x0c,
+	0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x42, 0x69,
+	0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5a,
+	0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x53, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x31, 0x0a, 0x2f, 0x1a, 0x2d, 0x23, 0x2f, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x60, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x3d, 0x0a,
+	0x3b, 0x4a, 0x39, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30,
+	0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69,
+	0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73,
+	0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0xaf, 0x03, 0x0a, 0x0c, 0x42, 0x50, 0x42, 0x69, 0x6c, 0x6c,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x6c,
+	0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x42, 0x69, 0x6c,
+	0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xd1, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x99, 0x02, 0x0a, 0x0c, 0x42, 0x69,
+	0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x42, 0x69, 0x6c, 0x6c,
+	0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72,
+	0x20, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x19, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x4c, 0x69, 0x73, 0x74,
+	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x4a, 0x5c, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x55, 0x0a, 0x1e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x33, 0x0a, 0x31, 0x1a,
+	0x2f, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62,
+	0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x42, 0x69,
+	0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4a, 0x63, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x5c, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72,
 	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
-	0x6e, 0x64, 0x2e, 0x12, 0x3d, 0x0a, 0x3b, 0x4a, 0x39, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65,
+	0x6e, 0x64, 0x2e, 0x12, 0x40, 0x0a, 0x3e, 0x4a, 0x3c, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65,
 	0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x28, 0x00, 0x30, 0x00, 0x12, 0xd0, 0x03, 0x0a, 0x11,
-	0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x12, 0x27, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x6c,
-	0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe3, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xad,
-	0x02, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x1e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x1a,
-	0x1e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x3a,
-	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
-	0x6e, 0x4a, 0x61, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x5a, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x38, 0x0a, 0x36, 0x1a, 0x34,
-	0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69,
-	0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x68, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x61, 0x0a, 0x18, 0x52,
+	0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x62,
+	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b, 0x50, 0x61, 0x72, 0x74, 0x6e,
+	0x65, 0x72, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x87, 0x04, 0x0a, 0x18, 0x42, 0x69, 0x6c, 0x6c,
+	0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x03, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41,
+	0xd7, 0x02, 0x0a, 0x21, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x4c, 0x69, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x47, 0x65, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20, 0x66,
+	0x6f, 0x72, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x1a, 0x26, 0x47, 0x65, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x73,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x68, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x6c, 0x79, 0x2e, 0x12, 0x3f, 0x0a, 0x3d, 0x1a, 0x3b, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x63, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x5c, 0x0a, 0x18, 0x52,
 	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74,
-	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x45, 0x0a, 0x43, 0x4a, 0x41, 0x7b, 0x20, 0x22,
+	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x40, 0x0a, 0x3e, 0x4a, 0x3c, 0x7b, 0x20, 0x22,
 	0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x49, 0x6e,
-	0x71, 0x75, 0x69, 0x72, 0x65, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c,
-	0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0x95,
-	0x03, 0x0a, 0x0a, 0x42, 0x50, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x20, 0x2e,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x4c, 0x69, 0x73, 0x74,
+	0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12,
+	0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x28, 0x00, 0x30,
+	0x00, 0x12, 0x85, 0x03, 0x0a, 0x07, 0x42, 0x50, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1d, 0x2e,
 	0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x42, 0x50, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xbd, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x8e, 0x02, 0x0a,
-	0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x42,
-	0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a,
-	0x5a, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x53, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x31, 0x0a, 0x2f, 0x1a, 0x2d, 0x23, 0x2f,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c,
-	0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x60, 0x0a, 0x03, 0x34,
-	0x30, 0x30, 0x12, 0x59, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77,
-	0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x3d,
-	0x0a, 0x3b, 0x4a, 0x39, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30,
-	0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42,
-	0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c,
-	0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0xaf, 0x03, 0x0a, 0x0c, 0x42, 0x50, 0x42, 0x69, 0x6c,
-	0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f,
-	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x69,
-	0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x42, 0x69,
-	0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xd1, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x99, 0x02, 0x0a, 0x0c, 0x42,
-	0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x42, 0x69, 0x6c,
-	0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65,
-	0x72, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x19, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x4c, 0x69, 0x73,
-	0x74, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
-	0x73, 0x6f, 0x6e, 0x4a, 0x5c, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x55, 0x0a, 0x1e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x33, 0x0a, 0x31,
-	0x1a, 0x2f, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x42,
-	0x69, 0x6c, 0x6c, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4a, 0x63, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x5c, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f,
-	0x75, 0x6e, 0x64, 0x2e, 0x12, 0x40, 0x0a, 0x3e, 0x4a, 0x3c, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64,
-	0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x12, 0x26, 0x2f, 0x76,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62,
+	0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xb2, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x86, 0x02, 0x0a, 0x0c, 0x42,
+	0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x42, 0x69, 0x6c,
+	0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x1a, 0x13, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54,
+	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e,
+	0x12, 0x32, 0x0a, 0x30, 0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x4a, 0x5d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x56, 0x0a, 0x18, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x3a, 0x0a, 0x38, 0x4a, 0x36, 0x7b, 0x20, 0x22,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76,
 	0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x62, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b, 0x50, 0x61, 0x72, 0x74,
-	0x6e, 0x65, 0x72, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x87, 0x04, 0x0a, 0x18, 0x42, 0x69, 0x6c,
-	0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x03, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92,
-	0x41, 0xd7, 0x02, 0x0a, 0x21, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x47, 0x65, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20,
-	0x66, 0x6f, 0x72, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x1a, 0x26, 0x47, 0x65, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x42, 0x69, 0x6c, 0x6c,
-	0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x68, 0x0a, 0x03, 0x32,
-	0x30, 0x30, 0x12, 0x61, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x28, 0x00, 0x30, 0x00, 0x12, 0xff, 0x02, 0x0a, 0x06, 0x42, 0x50,
+	0x56, 0x6f, 0x69, 0x64, 0x12, 0x1c, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xae, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92,
+	0x41, 0x83, 0x02, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x12, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x6f, 0x69, 0x64, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32,
+	0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78,
 	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75,
-	0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x3f, 0x0a, 0x3d, 0x1a, 0x3b, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69,
+	0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a, 0x30, 0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69,
 	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x63, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x5c, 0x0a, 0x18,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f,
-	0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x40, 0x0a, 0x3e, 0x4a, 0x3c, 0x7b, 0x20,
-	0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x42, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x20, 0x4c, 0x69, 0x73,
-	0x74, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e,
-	0x12, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x28, 0x00,
-	0x30, 0x00, 0x1a, 0x03, 0x88, 0x02, 0x00, 0x42, 0x52, 0x48, 0x01, 0x50, 0x00, 0x5a, 0x37, 0x62,
-	0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67,
-	0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73,
-	0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3b, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01, 0x00, 0xb8,
-	0x01, 0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4a, 0x5c, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12,
+	0x55, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x39, 0x0a, 0x37, 0x4a,
+	0x35, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20,
+	0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c,
+	0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x56, 0x6f, 0x69, 0x64, 0x20, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22,
+	0x16, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x76, 0x6f, 0x69, 0x64, 0x28, 0x00, 0x30, 0x00, 0x12, 0x8b, 0x03, 0x0a, 0x08,
+	0x42, 0x50, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1e, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb6, 0x02, 0x88,
+	0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x89, 0x02, 0x0a, 0x0c, 0x42, 0x69, 0x6c, 0x6c, 0x73,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x1a, 0x14, 0x42,
+	0x69, 0x6c, 0x6c, 0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
+	0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32,
+	0x0a, 0x30, 0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x50, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4a, 0x5e, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x57, 0x0a, 0x18, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x3b, 0x0a, 0x39, 0x4a, 0x37, 0x7b, 0x20, 0x22, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x20, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x28, 0x00, 0x30, 0x00, 0x12, 0xe8, 0x03, 0x0a, 0x10, 0x42, 0x50,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26,
+	0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42,
+	0x50, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x50, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xfe, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xcb, 0x02, 0x0a, 0x0c, 0x42, 0x69,
+	0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x42, 0x69, 0x6c, 0x6c,
+	0x73, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x20, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x3f, 0x42, 0x69, 0x6c, 0x6c, 0x65,
+	0x72, 0x20, 0x70, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x20, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x20, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x60, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x37, 0x0a, 0x35, 0x1a, 0x33, 0x23, 0x2f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x5f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x50, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x67,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x60, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x2e, 0x12, 0x44, 0x0a, 0x42, 0x4a, 0x40, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a,
+	0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x42, 0x69, 0x6c, 0x6c, 0x73, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x20, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x20, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x28, 0x00, 0x30, 0x00, 0x1a, 0x03, 0x88, 0x02, 0x00, 0x42, 0x52, 0x48, 0x01, 0x50, 0x00, 0x5a,
+	0x37, 0x62, 0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74,
+	0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x69, 0x6c,
+	0x6c, 0x73, 0x2d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3b, 0x62, 0x69, 0x6c, 0x6c, 0x73,
+	0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01,
+	0x00, 0xb8, 0x01, 0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3068,7 +3823,7 @@ func file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDescGZIP() []by
 
 var (
 	file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-	file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes  = make([]protoimpl.MessageInfo, 21)
+	file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes  = make([]protoimpl.MessageInfo, 28)
 	file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_goTypes   = []interface{}{
 		(SortOrder)(0),                           // 0: bills_payment.SortOrder
 		(SortByColumn)(0),                        // 1: bills_payment.SortByColumn
@@ -3093,7 +3848,14 @@ var (
 		(*BillsPaymentTransactListResponse)(nil), // 20: bills_payment.BillsPaymentTransactListResponse
 		(*Amount)(nil),                           // 21: bills_payment.Amount
 		(*BillsPayment)(nil),                     // 22: bills_payment.BillsPayment
-		(*timestamppb.Timestamp)(nil),            // 23: google.protobuf.Timestamp
+		(*BPRetryRequest)(nil),                   // 23: bills_payment.BPRetryRequest
+		(*BPVoidRequest)(nil),                    // 24: bills_payment.BPVoidRequest
+		(*BPSearchRequest)(nil),                  // 25: bills_payment.BPSearchRequest
+		(*BPTransactionStatus)(nil),              // 26: bills_payment.BPTransactionStatus
+		(*BPWalletBalancesRequest)(nil),          // 27: bills_payment.BPWalletBalancesRequest
+		(*BPWalletBalance)(nil),                  // 28: bills_payment.BPWalletBalance
+		(*BPWalletBalancesResponse)(nil),         // 29: bills_payment.BPWalletBalancesResponse
+		(*timestamppb.Timestamp)(nil),            // 30: google.protobuf.Timestamp
 	}
 )
 
@@ -3117,22 +3879,37 @@ var file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_depIdxs = []int32{
 	21, // 16: bills_payment.BillsPayment.TotalAmount:type_name -> bills_payment.Amount
 	21, // 17: bills_payment.BillsPayment.TransactFee:type_name -> bills_payment.Amount
 	21, // 18: bills_payment.BillsPayment.TransactCommission:type_name -> bills_payment.Amount
-	23, // 19: bills_payment.BillsPayment.TransactionCompletedTime:type_name -> google.protobuf.Timestamp
-	2,  // 20: bills_payment.BillspaymentService.BPTransact:input_type -> bills_payment.BPTransactRequest
-	7,  // 21: bills_payment.BillspaymentService.BPTransactInquire:input_type -> bills_payment.BPTransactInquireRequest
-	11, // 22: bills_payment.BillspaymentService.BPValidate:input_type -> bills_payment.BPValidateRequest
-	14, // 23: bills_payment.BillspaymentService.BPBillerList:input_type -> bills_payment.BPBillerListRequest
-	19, // 24: bills_payment.BillspaymentService.BillsPaymentTransactList:input_type -> bills_payment.BillsPaymentTransactListRequest
-	4,  // 25: bills_payment.BillspaymentService.BPTransact:output_type -> bills_payment.BPTransactResponse
-	8,  // 26: bills_payment.BillspaymentService.BPTransactInquire:output_type -> bills_payment.BPTransactInquireResponse
-	12, // 27: bills_payment.BillspaymentService.BPValidate:output_type -> bills_payment.BPValidateResponse
-	18, // 28: bills_payment.BillspaymentService.BPBillerList:output_type -> bills_payment.BPBillerListResponse
-	20, // 29: bills_payment.BillspaymentService.BillsPaymentTransactList:output_type -> bills_payment.BillsPaymentTransactListResponse
-	25, // [25:30] is the sub-list for method output_type
-	20, // [20:25] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	30, // 19: bills_payment.BillsPayment.TransactionCompletedTime:type_name -> google.protobuf.Timestamp
+	30, // 20: bills_payment.BPWalletBalancesRequest.From:type_name -> google.protobuf.Timestamp
+	30, // 21: bills_payment.BPWalletBalancesRequest.Until:type_name -> google.protobuf.Timestamp
+	21, // 22: bills_payment.BPWalletBalance.Balance:type_name -> bills_payment.Amount
+	21, // 23: bills_payment.BPWalletBalance.Threshold:type_name -> bills_payment.Amount
+	30, // 24: bills_payment.BPWalletBalance.Checked:type_name -> google.protobuf.Timestamp
+	28, // 25: bills_payment.BPWalletBalancesResponse.Balances:type_name -> bills_payment.BPWalletBalance
+	28, // 26: bills_payment.BPWalletBalancesResponse.History:type_name -> bills_payment.BPWalletBalance
+	2,  // 27: bills_payment.BillspaymentService.BPTransact:input_type -> bills_payment.BPTransactRequest
+	7,  // 28: bills_payment.BillspaymentService.BPTransactInquire:input_type -> bills_payment.BPTransactInquireRequest
+	11, // 29: bills_payment.BillspaymentService.BPValidate:input_type -> bills_payment.BPValidateRequest
+	14, // 30: bills_payment.BillspaymentService.BPBillerList:input_type -> bills_payment.BPBillerListRequest
+	19, // 31: bills_payment.BillspaymentService.BillsPaymentTransactList:input_type -> bills_payment.BillsPaymentTransactListRequest
+	23, // 32: bills_payment.BillspaymentService.BPRetry:input_type -> bills_payment.BPRetryRequest
+	24, // 33: bills_payment.BillspaymentService.BPVoid:input_type -> bills_payment.BPVoidRequest
+	25, // 34: bills_payment.BillspaymentService.BPSearch:input_type -> bills_payment.BPSearchRequest
+	27, // 35: bills_payment.BillspaymentService.BPWalletBalances:input_type -> bills_payment.BPWalletBalancesRequest
+	4,  // 36: bills_payment.BillspaymentService.BPTransact:output_type -> bills_payment.BPTransactResponse
+	8,  // 37: bills_payment.BillspaymentService.BPTransactInquire:output_type -> bills_payment.BPTransactInquireResponse
+	12, // 38: bills_payment.BillspaymentService.BPValidate:output_type -> bills_payment.BPValidateResponse
+	18, // 39: bills_payment.BillspaymentService.BPBillerList:output_type -> bills_payment.BPBillerListResponse
+	20, // 40: bills_payment.BillspaymentService.BillsPaymentTransactList:output_type -> bills_payment.BillsPaymentTransactListResponse
+	26, // 41: bills_payment.BillspaymentService.BPRetry:output_type -> bills_payment.BPTransactionStatus
+	26, // 42: bills_payment.BillspaymentService.BPVoid:output_type -> bills_payment.BPTransactionStatus
+	26, // 43: bills_payment.BillspaymentService.BPSearch:output_type -> bills_payment.BPTransactionStatus
+	29, // 44: bills_payment.BillspaymentService.BPWalletBalances:output_type -> bills_payment.BPWalletBalancesResponse
+	36, // [36:45] is the sub-list for method output_type
+	27, // [27:36] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_init() }
@@ -3393,6 +4170,90 @@ func file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_init() {
 				return nil
 			}
 		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPRetryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPVoidRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPTransactionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPWalletBalancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPWalletBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BPWalletBalancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3400,7 +4261,7 @@ func file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_brank_as_petnet_gunk_drp_v1_bills_payment_all_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   21,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,14 @@ type BillspaymentServiceClient interface {
 	BPBillerList(ctx context.Context, in *BPBillerListRequest, opts ...grpc.CallOption) (*BPBillerListResponse, error)
 	// Get Transaction List for BillsPayment
 	BillsPaymentTransactList(ctx context.Context, in *BillsPaymentTransactListRequest, opts ...grpc.CallOption) (*BillsPaymentTransactListResponse, error)
+	// billspayment retry.
+	BPRetry(ctx context.Context, in *BPRetryRequest, opts ...grpc.CallOption) (*BPTransactionStatus, error)
+	// billspayment void.
+	BPVoid(ctx context.Context, in *BPVoidRequest, opts ...grpc.CallOption) (*BPTransactionStatus, error)
+	// billspayment search.
+	BPSearch(ctx context.Context, in *BPSearchRequest, opts ...grpc.CallOption) (*BPTransactionStatus, error)
+	// billspayment biller wallet balances.
+	BPWalletBalances(ctx context.Context, in *BPWalletBalancesRequest, opts ...grpc.CallOption) (*BPWalletBalancesResponse, error)
 }
 
 type billspaymentServiceClient struct {
@@ -84,6 +92,42 @@ func (c *billspaymentServiceClient) BillsPaymentTransactList(ctx context.Context
 	return out, nil
 }
 
+func (c *billspaymentServiceClient) BPRetry(ctx context.Context, in *BPRetryRequest, opts ...grpc.CallOption) (*BPTransactionStatus, error) {
+	out := new(BPTransactionStatus)
+	err := c.cc.Invoke(ctx, "/bills_payment.BillspaymentService/BPRetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billspaymentServiceClient) BPVoid(ctx context.Context, in *BPVoidRequest, opts ...grpc.CallOption) (*BPTransactionStatus, error) {
+	out := new(BPTransactionStatus)
+	err := c.cc.Invoke(ctx, "/bills_payment.BillspaymentService/BPVoid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billspaymentServiceClient) BPSearch(ctx context.Context, in *BPSearchRequest, opts ...grpc.CallOption) (*BPTransactionStatus, error) {
+	out := new(BPTransactionStatus)
+	err := c.cc.Invoke(ctx, "/bills_payment.BillspaymentService/BPSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *billspaymentServiceClient) BPWalletBalances(ctx context.Context, in *BPWalletBalancesRequest, opts ...grpc.CallOption) (*BPWalletBalancesResponse, error) {
+	out := new(BPWalletBalancesResponse)
+	err := c.cc.Invoke(ctx, "/bills_payment.BillspaymentService/BPWalletBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BillspaymentServiceServer is the server API for BillspaymentService service.
 // All implementations must embed UnimplementedBillspaymentServiceServer
 // for forward compatibility
@@ -98,6 +142,14 @@ type BillspaymentServiceServer interface {
 	BPBillerList(context.Context, *BPBillerListRequest) (*BPBillerListResponse, error)
 	// Get Transaction List for BillsPayment
 	BillsPaymentTransactList(context.Context, *BillsPaymentTransactListRequest) (*BillsPaymentTransactListResponse, error)
+	// billspayment retry.
+	BPRetry(context.Context, *BPRetryRequest) (*BPTransactionStatus, error)
+	// billspayment void.
+	BPVoid(context.Context, *BPVoidRequest) (*BPTransactionStatus, error)
+	// billspayment search.
+	BPSearch(context.Context, *BPSearchRequest) (*BPTransactionStatus, error)
+	// billspayment biller wallet balances.
+	BPWalletBalances(context.Context, *BPWalletBalancesRequest) (*BPWalletBalancesResponse, error)
 	mustEmbedUnimplementedBillspaymentServiceServer()
 }
 
@@ -123,6 +175,22 @@ func (UnimplementedBillspaymentServiceServer) BPBillerList(context.Context, *BPB
 func (UnimplementedBillspaymentServiceServer) BillsPaymentTransactList(context.Context, *BillsPaymentTransactListRequest) (*BillsPaymentTransactListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BillsPaymentTransactList not implemented")
 }
+
+func (UnimplementedBillspaymentServiceServer) BPRetry(context.Context, *BPRetryRequest) (*BPTransactionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BPRetry not implemented")
+}
+
+func (UnimplementedBillspaymentServiceServer) BPVoid(context.Context, *BPVoidRequest) (*BPTransactionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BPVoid not implemented")
+}
+
+func (UnimplementedBillspaymentServiceServer) BPSearch(context.Context, *BPSearchRequest) (*BPTransactionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BPSearch not implemented")
+}
+
+func (UnimplementedBillspaymentServiceServer) BPWalletBalances(context.Context, *BPWalletBalancesRequest) (*BPWalletBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BPWalletBalances not implemented")
+}
 func (UnimplementedBillspaymentServiceServer) mustEmbedUnimplementedBillspaymentServiceServer() {}
 
 // UnsafeBillspaymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +294,78 @@ func _BillspaymentService_BillsPaymentTransactList_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BillspaymentService_BPRetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BPRetryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillspaymentServiceServer).BPRetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bills_payment.BillspaymentService/BPRetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillspaymentServiceServer).BPRetry(ctx, req.(*BPRetryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillspaymentService_BPVoid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BPVoidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillspaymentServiceServer).BPVoid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bills_payment.BillspaymentService/BPVoid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillspaymentServiceServer).BPVoid(ctx, req.(*BPVoidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillspaymentService_BPSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BPSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillspaymentServiceServer).BPSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bills_payment.BillspaymentService/BPSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillspaymentServiceServer).BPSearch(ctx, req.(*BPSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BillspaymentService_BPWalletBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BPWalletBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BillspaymentServiceServer).BPWalletBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bills_payment.BillspaymentService/BPWalletBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BillspaymentServiceServer).BPWalletBalances(ctx, req.(*BPWalletBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BillspaymentService_ServiceDesc is the grpc.ServiceDesc for BillspaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,6 +393,22 @@ var BillspaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BillsPaymentTransactList",
 			Handler:    _BillspaymentService_BillsPaymentTransactList_Handler,
 		},
+		{
+			MethodName: "BPRetry",
+			Handler:    _BillspaymentService_BPRetry_Handler,
+		},
+		{
+			MethodName: "BPVoid",
+			Handler:    _BillspaymentService_BPVoid_Handler,
+		},
+		{
+			MethodName: "BPSearch",
+			Handler:    _BillspaymentService_BPSearch_Handler,
+		},
+		{
+			MethodName: "BPWalletBalances",
+			Handler:    _BillspaymentService_BPWalletBalances_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/bills-payment/all.proto",
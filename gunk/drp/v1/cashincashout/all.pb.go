@@ -1767,6 +1767,14 @@ type CICOTransactListRequest struct {
 	ReferenceNumber  string       `protobuf:"bytes,7,opt,name=ReferenceNumber,json=reference_number,proto3" json:"reference_number,omitempty"`
 	ExcludeProviders []string     `protobuf:"bytes,8,rep,name=ExcludeProviders,json=exclude_provider,proto3" json:"exclude_provider,omitempty"`
 	OrgID            string       `protobuf:"bytes,9,opt,name=OrgID,json=org_id,proto3" json:"org_id,omitempty"`
+	// PageToken lists the page after the one that returned it as
+	// next_page_token. Offset and sort_by_column are ignored and total is not
+	// counted when it is set.
+	PageToken string `protobuf:"bytes,10,opt,name=PageToken,json=page_token,proto3" json:"page_token,omitempty"`
+	// BranchID and TellerID list only the transactions attributed to the
+	// branch or teller.
+	BranchID string `protobuf:"bytes,11,opt,name=BranchID,json=branch_id,proto3" json:"branch_id,omitempty"`
+	TellerID string `protobuf:"bytes,12,opt,name=TellerID,json=teller_id,proto3" json:"teller_id,omitempty"`
 }
 
 func (x *CICOTransactListRequest) Reset() {
@@ -1864,6 +1872,27 @@ func (x *CICOTransactListRequest) GetOrgID() string {
 	return ""
 }
 
+func (x *CICOTransactListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *CICOTransactListRequest) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *CICOTransactListRequest) GetTellerID() string {
+	if x != nil {
+		return x.TellerID
+	}
+	return ""
+}
+
 type CICOTransactListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1872,6 +1901,9 @@ type CICOTransactListResponse struct {
 	Next          int32           `protobuf:"varint,1,opt,name=Next,json=next,proto3" json:"next,omitempty"`
 	CICOTransacts []*CICOTransact `protobuf:"bytes,2,rep,name=CICOTransacts,json=cico_transact,proto3" json:"cico_transact,omitempty"`
 	Total         int32           `protobuf:"varint,3,opt,name=Total,json=total,proto3" json:"total,omitempty"`
+	// NextPageToken is set when sort_by_column is omitted and more rows
+	// may follow.
+	NextPageToken string `protobuf:"bytes,4,opt,name=NextPageToken,json=next_page_token,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *CICOTransactListResponse) Reset() {
@@ -1927,6 +1959,13 @@ func (x *CICOTransactListResponse) GetTotal() int32 {
 	return 0
 }
 
+func (x *CICOTransactListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Amount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1995,6 +2034,8 @@ type CICOTransact struct {
 	TransactFee              *Amount                `protobuf:"bytes,4,opt,name=TransactFee,json=transact_fee,proto3" json:"transact_fee,omitempty"`
 	TransactCommission       *Amount                `protobuf:"bytes,5,opt,name=TransactCommission,json=transact_commission,proto3" json:"transact_commission,omitempty"`
 	TransactionCompletedTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=TransactionCompletedTime,json=transaction_completed_time,proto3" json:"transaction_completed_time,omitempty"`
+	BranchID                 string                 `protobuf:"bytes,7,opt,name=BranchID,json=branch_id,proto3" json:"branch_id,omitempty"`
+	TellerID                 string                 `protobuf:"bytes,8,opt,name=TellerID,json=teller_id,proto3" json:"teller_id,omitempty"`
 }
 
 func (x *CICOTransact) Reset() {
@@ -2071,6 +2112,20 @@ func (x *CICOTransact) GetTransactionCompletedTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CICOTransact) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *CICOTransact) GetTellerID() string {
+	if x != nil {
+		return x.TellerID
+	}
+	return ""
+}
+
 var File_brank_as_petnet_gunk_drp_v1_cashincashout_all_proto protoreflect.FileDescriptor
 
 var file_brank_as_petnet_gunk_drp_v1_cashincashout_all_proto_rawDesc = []byte{
@@ -2505,7 +2560,7 @@ var file_brank_as_petnet_gunk_drp_v1_cashincashout_all_proto_rawDesc = []byte{
 	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69,
 	0x63, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xdd, 0x03, 0x0a,
+	0x73, 0x75, 0x6c, 0x74, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xda, 0x04, 0x0a,
 	0x17, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
@@ -2534,255 +2589,272 @@ var file_brank_as_petnet_gunk_drp_v1_cashincashout_all_proto_rawDesc = []byte{
 	0x00, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69,
 	0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x05, 0x4f, 0x72, 0x67, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06,
-	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x3a, 0x14, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41,
-	0x0b, 0x0a, 0x09, 0xd2, 0x01, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xb3, 0x01, 0x0a,
-	0x18, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x65, 0x78,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
-	0x00, 0x50, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x4d, 0x0a, 0x0d, 0x43, 0x49, 0x43,
-	0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74,
-	0x2e, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x0a, 0x08,
-	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0d, 0x63, 0x69, 0x63, 0x6f, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
-	0x00, 0x50, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00,
-	0x18, 0x00, 0x22, 0x75, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x06,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
-	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x26, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x3a, 0x1f, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00,
-	0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01,
-	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xbb, 0x03, 0x0a, 0x0c, 0x43, 0x49,
-	0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x27, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x44, 0x18, 0x0b, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
-	0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x54, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x3a, 0x14, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x0b, 0x0a, 0x09,
+	0xd2, 0x01, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x18, 0x43, 0x49,
+	0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x4d, 0x0a, 0x0d, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x49,
+	0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0d, 0x63, 0x69, 0x63, 0x6f, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x06, 0x08, 0x00, 0x10,
+	0x00, 0x18, 0x00, 0x22, 0x75, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
-	0x00, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x44, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x46, 0x65, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73,
-	0x68, 0x6f, 0x75, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18,
-	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75,
-	0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
-	0x30, 0x00, 0x50, 0x00, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x18, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
-	0x00, 0x50, 0x00, 0x52, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x3a,
-	0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x2a, 0x2a, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x1a, 0x02,
-	0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x1a,
-	0x02, 0x18, 0x00, 0x2a, 0xaf, 0x01, 0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f,
-	0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x10, 0x4f, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x72, 0x74,
-	0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x10, 0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x17,
-	0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x13, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x02, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x20, 0x0a, 0x18,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x03, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x10,
-	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x10, 0x04, 0x1a, 0x02, 0x08, 0x00,
-	0x12, 0x0b, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x10, 0x05, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x12, 0x0a,
-	0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x1a, 0x02, 0x08,
-	0x00, 0x1a, 0x02, 0x18, 0x00, 0x32, 0xcb, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e,
-	0x43, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xa0,
-	0x03, 0x0a, 0x0b, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x21,
-	0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43,
-	0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75,
-	0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41,
-	0x97, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x43, 0x61, 0x73, 0x68, 0x4f, 0x75,
-	0x74, 0x12, 0x13, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x20,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a, 0x30, 0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e,
-	0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x6d, 0x0a, 0x03, 0x34, 0x30,
-	0x34, 0x12, 0x66, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
-	0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x3e, 0x0a, 0x3c, 0x4a, 0x3a, 0x7b,
-	0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x34, 0x2c, 0x20, 0x22, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x61, 0x6e, 0xe2, 0x80, 0x99, 0x74, 0x20, 0x62, 0x65,
-	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a,
-	0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61,
-	0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x00, 0x30,
-	0x00, 0x12, 0x87, 0x04, 0x0a, 0x0b, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x3a, 0x1f, 0x08, 0x00, 0x10, 0x00, 0x18,
+	0x00, 0x92, 0x41, 0x16, 0x0a, 0x14, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2,
+	0x01, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x8d, 0x04, 0x0a, 0x0c, 0x43,
+	0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x0b, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x44, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x46, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61,
+	0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f,
+	0x75, 0x74, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x13, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x64, 0x0a, 0x18, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x1a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x27, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09,
+	0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x54, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
+	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x74, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x2a, 0x2a, 0x0a, 0x09, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10,
+	0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x01, 0x1a, 0x02,
+	0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x2a, 0xaf, 0x01, 0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18, 0x0a, 0x10, 0x4f, 0x6d, 0x69, 0x74, 0x53,
+	0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x10, 0x00, 0x1a, 0x02, 0x08,
+	0x00, 0x12, 0x17, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x13, 0x0a, 0x0b, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x02, 0x1a, 0x02, 0x08, 0x00, 0x12,
+	0x20, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x10, 0x03, 0x1a, 0x02, 0x08,
+	0x00, 0x12, 0x10, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x10, 0x04, 0x1a,
+	0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x10, 0x05, 0x1a, 0x02, 0x08, 0x00,
+	0x12, 0x12, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x06,
+	0x1a, 0x02, 0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x32, 0xcb, 0x16, 0x0a, 0x14, 0x43, 0x61, 0x73,
+	0x68, 0x49, 0x6e, 0x43, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0xa0, 0x03, 0x0a, 0x0b, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72,
 	0x65, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75,
-	0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73,
-	0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x03, 0x88, 0x02, 0x00, 0x90, 0x02,
-	0x00, 0x92, 0x41, 0xfe, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x43, 0x61, 0x73,
-	0x68, 0x4f, 0x75, 0x74, 0x12, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x20, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x10,
+	0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc5, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02,
+	0x00, 0x92, 0x41, 0x97, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x43, 0x61, 0x73,
+	0x68, 0x4f, 0x75, 0x74, 0x12, 0x13, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x20, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x49, 0x6e, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x10,
 	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
 	0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a, 0x30, 0x1a, 0x2e, 0x23,
 	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x65, 0x0a,
-	0x03, 0x34, 0x32, 0x32, 0x12, 0x5e, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43, 0x6f, 0x49, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x6d, 0x0a,
+	0x03, 0x34, 0x30, 0x34, 0x12, 0x66, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
 	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x36, 0x0a, 0x34,
-	0x4a, 0x32, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x32, 0x2c,
-	0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43,
-	0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x20, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x22, 0x20, 0x7d, 0x4a, 0x6d, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x66, 0x0a, 0x24, 0x52,
-	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x2e, 0x12, 0x3e, 0x0a, 0x3c, 0x4a, 0x3a, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65,
-	0x22, 0x3a, 0x20, 0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58, 0x45, 0x43, 0x55,
-	0x54, 0x45, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x20, 0x2d, 0x20, 0x47, 0x43, 0x41, 0x53, 0x48,
-	0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76,
-	0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0xf9, 0x03, 0x0a, 0x09,
-	0x43, 0x69, 0x43, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x73, 0x68,
-	0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x52,
-	0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa4, 0x03, 0x88,
-	0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xf8, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49,
-	0x6e, 0x43, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x20,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x52, 0x65, 0x74,
-	0x72, 0x79, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x4a, 0x59, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x52, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x30, 0x0a, 0x2e, 0x1a, 0x2c, 0x23,
-	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43, 0x6f, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x65, 0x0a, 0x03, 0x34,
-	0x32, 0x32, 0x12, 0x5e, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77,
-	0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x36, 0x0a, 0x34, 0x4a, 0x32,
-	0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x32, 0x2c, 0x20, 0x22,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20,
-	0x2d, 0x20, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x22,
-	0x20, 0x7d, 0x4a, 0x6d, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x66, 0x0a, 0x24, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x2e, 0x12, 0x3e, 0x0a, 0x3c, 0x4a, 0x3a, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a,
-	0x20, 0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a,
-	0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45,
-	0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x20, 0x2d, 0x20, 0x47, 0x43, 0x41, 0x53, 0x48, 0x22, 0x20,
-	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x72, 0x65,
-	0x74, 0x72, 0x79, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa4, 0x03, 0x0a, 0x0e, 0x43, 0x69, 0x43, 0x6f,
-	0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x4f,
-	0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x25, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74,
-	0x2e, 0x43, 0x69, 0x43, 0x6f, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00,
-	0x92, 0x41, 0x96, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x43, 0x61, 0x73, 0x68,
-	0x4f, 0x75, 0x74, 0x12, 0x17, 0x4f, 0x54, 0x50, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
-	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x17, 0x4f, 0x54,
-	0x50, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5e, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x57,
-	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e,
-	0x12, 0x35, 0x0a, 0x33, 0x1a, 0x31, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75,
-	0x74, 0x43, 0x69, 0x43, 0x6f, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x61, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x5a,
-	0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x32, 0x0a, 0x30, 0x4a, 0x2e, 0x7b, 0x20, 0x22, 0x63,
-	0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x4f, 0x54,
-	0x50, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63,
-	0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x6f, 0x74, 0x70, 0x28, 0x00, 0x30, 0x00, 0x12, 0x96,
-	0x04, 0x0a, 0x0c, 0x43, 0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
-	0x22, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e,
-	0x43, 0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68,
-	0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x03, 0x88, 0x02, 0x00, 0x90, 0x02,
-	0x00, 0x92, 0x41, 0x89, 0x03, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x43, 0x61, 0x73,
-	0x68, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x4a, 0x5c, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x55, 0x0a, 0x1e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x33, 0x0a, 0x31, 0x1a,
-	0x2f, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63,
-	0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43, 0x6f,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x4a, 0x6c, 0x0a, 0x03, 0x34, 0x32, 0x32, 0x12, 0x65, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x3e, 0x0a, 0x3c,
+	0x4a, 0x3a, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x34, 0x2c,
+	0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x63, 0x61, 0x6e, 0xe2, 0x80, 0x99, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69,
+	0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x28, 0x00, 0x30, 0x00, 0x12, 0x87, 0x04, 0x0a, 0x0b, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73,
+	0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xac, 0x03, 0x88, 0x02,
+	0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xfe, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e,
+	0x43, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x13, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a, 0x30,
+	0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43,
+	0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4a, 0x65, 0x0a, 0x03, 0x34, 0x32, 0x32, 0x12, 0x5e, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72,
 	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
 	0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12,
-	0x3d, 0x0a, 0x3b, 0x4a, 0x39, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34,
+	0x36, 0x0a, 0x34, 0x4a, 0x32, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34,
 	0x32, 0x32, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22,
-	0x54, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x61, 0x20, 0x77,
-	0x61, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x22, 0x20, 0x7d, 0x4a, 0x6e,
-	0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x67, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x20, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x22, 0x20, 0x7d, 0x4a, 0x6d, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x66,
+	0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x3e, 0x0a, 0x3c, 0x4a, 0x3a, 0x7b, 0x20, 0x22, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58,
+	0x45, 0x43, 0x55, 0x54, 0x45, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x20, 0x2d, 0x20, 0x47, 0x43,
+	0x41, 0x53, 0x48, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22,
+	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f,
+	0x75, 0x74, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0xf9,
+	0x03, 0x0a, 0x09, 0x43, 0x69, 0x43, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x63,
+	0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43,
+	0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69,
+	0x43, 0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xa4, 0x03, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xf8, 0x02, 0x0a, 0x0d, 0x43, 0x61,
+	0x73, 0x68, 0x49, 0x6e, 0x43, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x11, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x4a, 0x59, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x52, 0x0a, 0x1e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x30, 0x0a, 0x2e,
+	0x1a, 0x2c, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43,
+	0x6f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x65,
+	0x0a, 0x03, 0x34, 0x32, 0x32, 0x12, 0x5e, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
 	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x3f, 0x0a,
-	0x3d, 0x4a, 0x3b, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x30, 0x30,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x36, 0x0a,
+	0x34, 0x4a, 0x32, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x32,
 	0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49,
-	0x43, 0x4f, 0x20, 0x2d, 0x20, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x20, 0x45, 0x52,
-	0x52, 0x4f, 0x52, 0x20, 0x2d, 0x20, 0x47, 0x43, 0x41, 0x53, 0x48, 0x22, 0x20, 0x7d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73,
-	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0xc4, 0x03, 0x0a, 0x10, 0x43, 0x49, 0x43, 0x4f,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x63,
-	0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x49, 0x43,
-	0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73,
-	0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xda, 0x02,
-	0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xb4, 0x02, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72,
-	0x20, 0x43, 0x49, 0x2f, 0x43, 0x4f, 0x12, 0x22, 0x47, 0x65, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x20,
-	0x66, 0x6f, 0x72, 0x20, 0x43, 0x49, 0x2f, 0x43, 0x4f, 0x2e, 0x1a, 0x1f, 0x47, 0x65, 0x74, 0x20,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74,
-	0x20, 0x66, 0x6f, 0x72, 0x20, 0x43, 0x49, 0x2f, 0x43, 0x4f, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x60, 0x0a,
-	0x03, 0x32, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x37, 0x0a, 0x35, 0x1a, 0x33, 0x23, 0x2f, 0x64, 0x65,
-	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e,
-	0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a,
-	0x5d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x56, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
-	0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e,
-	0x64, 0x2e, 0x12, 0x3a, 0x0a, 0x38, 0x4a, 0x36, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22,
-	0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x2d, 0x63, 0x6f, 0x2f,
-	0x62, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x28, 0x00, 0x30, 0x00, 0x1a, 0x03,
-	0x88, 0x02, 0x00, 0x42, 0x52, 0x48, 0x01, 0x50, 0x00, 0x5a, 0x37, 0x62, 0x72, 0x61, 0x6e, 0x6b,
-	0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f,
-	0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73,
-	0x68, 0x6f, 0x75, 0x74, 0x3b, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f,
-	0x75, 0x74, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01, 0x00, 0xb8, 0x01, 0x00, 0xd8, 0x01,
-	0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x45, 0x20, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x22, 0x20, 0x7d, 0x4a, 0x6d, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x66, 0x0a, 0x24,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x2e, 0x12, 0x3e, 0x0a, 0x3c, 0x4a, 0x3a, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x3a, 0x20, 0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x45, 0x58, 0x45, 0x43,
+	0x55, 0x54, 0x45, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x20, 0x2d, 0x20, 0x47, 0x43, 0x41, 0x53,
+	0x48, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74,
+	0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa4, 0x03, 0x0a, 0x0e, 0x43,
+	0x69, 0x43, 0x6f, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x24, 0x2e,
+	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69,
+	0x43, 0x6f, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68,
+	0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc0, 0x02, 0x88, 0x02, 0x00,
+	0x90, 0x02, 0x00, 0x92, 0x41, 0x96, 0x02, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e, 0x43,
+	0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x4f, 0x54, 0x50, 0x20, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x17, 0x4f, 0x54, 0x50, 0x20, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x20, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5e, 0x0a, 0x03, 0x32, 0x30,
+	0x30, 0x12, 0x57, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c,
+	0x6c, 0x79, 0x2e, 0x12, 0x35, 0x0a, 0x33, 0x1a, 0x31, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73,
+	0x68, 0x6f, 0x75, 0x74, 0x43, 0x69, 0x43, 0x6f, 0x4f, 0x54, 0x50, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x61, 0x0a, 0x03, 0x35, 0x30,
+	0x30, 0x12, 0x5a, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68,
+	0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x12, 0x32, 0x0a, 0x30, 0x4a, 0x2e, 0x7b,
+	0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d,
+	0x20, 0x4f, 0x54, 0x50, 0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68,
+	0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x6f, 0x74, 0x70, 0x28, 0x00, 0x30,
+	0x00, 0x12, 0x96, 0x04, 0x0a, 0x0c, 0x43, 0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f,
+	0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63,
+	0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x03, 0x88, 0x02,
+	0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x89, 0x03, 0x0a, 0x0d, 0x43, 0x61, 0x73, 0x68, 0x49, 0x6e,
+	0x43, 0x61, 0x73, 0x68, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x14, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5c, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x55, 0x0a, 0x1e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x33,
+	0x0a, 0x31, 0x1a, 0x2f, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43,
+	0x69, 0x43, 0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x4a, 0x6c, 0x0a, 0x03, 0x34, 0x32, 0x32, 0x12, 0x65, 0x0a, 0x24, 0x52, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x2e, 0x12, 0x3d, 0x0a, 0x3b, 0x4a, 0x39, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22,
+	0x3a, 0x20, 0x34, 0x32, 0x32, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x3a, 0x20, 0x22, 0x54, 0x68, 0x65, 0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x64, 0x61, 0x74,
+	0x61, 0x20, 0x77, 0x61, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x22, 0x20,
+	0x7d, 0x4a, 0x6e, 0x0a, 0x03, 0x35, 0x30, 0x30, 0x12, 0x67, 0x0a, 0x24, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e,
+	0x12, 0x3f, 0x0a, 0x3d, 0x4a, 0x3b, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20,
+	0x35, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20,
+	0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x2d, 0x20, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45,
+	0x20, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x20, 0x2d, 0x20, 0x47, 0x43, 0x41, 0x53, 0x48, 0x22, 0x20,
+	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f,
+	0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0xc4, 0x03, 0x0a, 0x10, 0x43,
+	0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x26, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e,
+	0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xda, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xb4, 0x02, 0x0a, 0x1a, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20,
+	0x66, 0x6f, 0x72, 0x20, 0x43, 0x49, 0x2f, 0x43, 0x4f, 0x12, 0x22, 0x47, 0x65, 0x74, 0x20, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x43, 0x49, 0x2f, 0x43, 0x4f, 0x2e, 0x1a, 0x1f, 0x47,
+	0x65, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4c,
+	0x69, 0x73, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x43, 0x49, 0x2f, 0x43, 0x4f, 0x2e, 0x3a, 0x10,
+	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x4a, 0x60, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x37, 0x0a, 0x35, 0x1a, 0x33, 0x23,
+	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x63, 0x61, 0x73,
+	0x68, 0x69, 0x6e, 0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x49, 0x43, 0x4f, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4a, 0x5d, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x56, 0x0a, 0x18, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66,
+	0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x3a, 0x0a, 0x38, 0x4a, 0x36, 0x7b, 0x20, 0x22, 0x63, 0x6f,
+	0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x43, 0x49, 0x43, 0x4f, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20,
+	0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x69, 0x2d,
+	0x63, 0x6f, 0x2f, 0x62, 0x69, 0x6c, 0x6c, 0x65, 0x72, 0x6c, 0x69, 0x73, 0x74, 0x28, 0x00, 0x30,
+	0x00, 0x1a, 0x03, 0x88, 0x02, 0x00, 0x42, 0x52, 0x48, 0x01, 0x50, 0x00, 0x5a, 0x37, 0x62, 0x72,
+	0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x75,
+	0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e,
+	0x63, 0x61, 0x73, 0x68, 0x6f, 0x75, 0x74, 0x3b, 0x63, 0x61, 0x73, 0x68, 0x69, 0x6e, 0x63, 0x61,
+	0x73, 0x68, 0x6f, 0x75, 0x74, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01, 0x00, 0xb8, 0x01,
+	0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
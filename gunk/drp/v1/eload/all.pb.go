@@ -0,0 +1,866 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        (unknown)
+// source: brank.as/petnet/gunk/drp/v1/eload/all.proto
+
+package eload
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ELoadProductsRequest filters the eload product catalog. Empty fields match
+// all products.
+type ELoadProductsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider    string `protobuf:"bytes,1,opt,name=Provider,json=provider,proto3" json:"provider,omitempty"`
+	ProductType string `protobuf:"bytes,2,opt,name=ProductType,json=product_type,proto3" json:"product_type,omitempty"`
+}
+
+func (x *ELoadProductsRequest) Reset() {
+	*x = ELoadProductsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadProductsRequest) ProtoMessage() {}
+
+func (x *ELoadProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadProductsRequest.ProtoReflect.Descriptor instead.
+func (*ELoadProductsRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ELoadProductsRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ELoadProductsRequest) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+// ELoadProduct is a prepaid load product. Amount and Commission are in PHP.
+type ELoadProduct struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductCode string `protobuf:"bytes,1,opt,name=ProductCode,json=product_code,proto3" json:"product_code,omitempty"`
+	ProductName string `protobuf:"bytes,2,opt,name=ProductName,json=product_name,proto3" json:"product_name,omitempty"`
+	Provider    string `protobuf:"bytes,3,opt,name=Provider,json=provider,proto3" json:"provider,omitempty"`
+	ProductType string `protobuf:"bytes,4,opt,name=ProductType,json=product_type,proto3" json:"product_type,omitempty"`
+	Amount      string `protobuf:"bytes,5,opt,name=Amount,json=amount,proto3" json:"amount,omitempty"`
+	Commission  string `protobuf:"bytes,6,opt,name=Commission,json=commission,proto3" json:"commission,omitempty"`
+	Remarks     string `protobuf:"bytes,7,opt,name=Remarks,json=remarks,proto3" json:"remarks,omitempty"`
+}
+
+func (x *ELoadProduct) Reset() {
+	*x = ELoadProduct{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadProduct) ProtoMessage() {}
+
+func (x *ELoadProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadProduct.ProtoReflect.Descriptor instead.
+func (*ELoadProduct) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ELoadProduct) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ELoadProduct) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ELoadProduct) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ELoadProduct) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *ELoadProduct) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ELoadProduct) GetCommission() string {
+	if x != nil {
+		return x.Commission
+	}
+	return ""
+}
+
+func (x *ELoadProduct) GetRemarks() string {
+	if x != nil {
+		return x.Remarks
+	}
+	return ""
+}
+
+type ELoadProductsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Products []*ELoadProduct `protobuf:"bytes,1,rep,name=Products,json=products,proto3" json:"products,omitempty"`
+}
+
+func (x *ELoadProductsResponse) Reset() {
+	*x = ELoadProductsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadProductsResponse) ProtoMessage() {}
+
+func (x *ELoadProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadProductsResponse.ProtoReflect.Descriptor instead.
+func (*ELoadProductsResponse) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ELoadProductsResponse) GetProducts() []*ELoadProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ELoadValidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProductCode    string `protobuf:"bytes,1,opt,name=ProductCode,json=product_code,proto3" json:"product_code,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=Amount,json=amount,proto3" json:"amount,omitempty"`
+	TargetMobileNo string `protobuf:"bytes,3,opt,name=TargetMobileNo,json=target_mobile_no,proto3" json:"target_mobile_no,omitempty"`
+}
+
+func (x *ELoadValidateRequest) Reset() {
+	*x = ELoadValidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadValidateRequest) ProtoMessage() {}
+
+func (x *ELoadValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadValidateRequest.ProtoReflect.Descriptor instead.
+func (*ELoadValidateRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ELoadValidateRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ELoadValidateRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ELoadValidateRequest) GetTargetMobileNo() string {
+	if x != nil {
+		return x.TargetMobileNo
+	}
+	return ""
+}
+
+type ELoadValidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Product        *ELoadProduct `protobuf:"bytes,1,opt,name=Product,json=product,proto3" json:"product,omitempty"`
+	TargetMobileNo string        `protobuf:"bytes,2,opt,name=TargetMobileNo,json=target_mobile_no,proto3" json:"target_mobile_no,omitempty"`
+}
+
+func (x *ELoadValidateResponse) Reset() {
+	*x = ELoadValidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadValidateResponse) ProtoMessage() {}
+
+func (x *ELoadValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadValidateResponse.ProtoReflect.Descriptor instead.
+func (*ELoadValidateResponse) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ELoadValidateResponse) GetProduct() *ELoadProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ELoadValidateResponse) GetTargetMobileNo() string {
+	if x != nil {
+		return x.TargetMobileNo
+	}
+	return ""
+}
+
+type ELoadSellRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID      string `protobuf:"bytes,1,opt,name=SessionID,json=session_id,proto3" json:"session_id,omitempty"`
+	Password       string `protobuf:"bytes,2,opt,name=Password,json=password,proto3" json:"password,omitempty"`
+	ProductCode    string `protobuf:"bytes,3,opt,name=ProductCode,json=product_code,proto3" json:"product_code,omitempty"`
+	Amount         string `protobuf:"bytes,4,opt,name=Amount,json=amount,proto3" json:"amount,omitempty"`
+	TargetMobileNo string `protobuf:"bytes,5,opt,name=TargetMobileNo,json=target_mobile_no,proto3" json:"target_mobile_no,omitempty"`
+}
+
+func (x *ELoadSellRequest) Reset() {
+	*x = ELoadSellRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadSellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadSellRequest) ProtoMessage() {}
+
+func (x *ELoadSellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadSellRequest.ProtoReflect.Descriptor instead.
+func (*ELoadSellRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ELoadSellRequest) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ELoadSellRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ELoadSellRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ELoadSellRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ELoadSellRequest) GetTargetMobileNo() string {
+	if x != nil {
+		return x.TargetMobileNo
+	}
+	return ""
+}
+
+type ELoadSellResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ELoadID         string                 `protobuf:"bytes,1,opt,name=ELoadID,json=eload_id,proto3" json:"eload_id,omitempty"`
+	Product         *ELoadProduct          `protobuf:"bytes,2,opt,name=Product,json=product,proto3" json:"product,omitempty"`
+	TargetMobileNo  string                 `protobuf:"bytes,3,opt,name=TargetMobileNo,json=target_mobile_no,proto3" json:"target_mobile_no,omitempty"`
+	ReferenceNumber string                 `protobuf:"bytes,4,opt,name=ReferenceNumber,json=reference_number,proto3" json:"reference_number,omitempty"`
+	TraceID         string                 `protobuf:"bytes,5,opt,name=TraceID,json=trace_id,proto3" json:"trace_id,omitempty"`
+	EPIN            string                 `protobuf:"bytes,6,opt,name=EPIN,json=epin,proto3" json:"epin,omitempty"`
+	TrxDate         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=TrxDate,json=trx_date,proto3" json:"trx_date,omitempty"`
+}
+
+func (x *ELoadSellResponse) Reset() {
+	*x = ELoadSellResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ELoadSellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ELoadSellResponse) ProtoMessage() {}
+
+func (x *ELoadSellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ELoadSellResponse.ProtoReflect.Descriptor instead.
+func (*ELoadSellResponse) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ELoadSellResponse) GetELoadID() string {
+	if x != nil {
+		return x.ELoadID
+	}
+	return ""
+}
+
+func (x *ELoadSellResponse) GetProduct() *ELoadProduct {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ELoadSellResponse) GetTargetMobileNo() string {
+	if x != nil {
+		return x.TargetMobileNo
+	}
+	return ""
+}
+
+func (x *ELoadSellResponse) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *ELoadSellResponse) GetTraceID() string {
+	if x != nil {
+		return x.TraceID
+	}
+	return ""
+}
+
+func (x *ELoadSellResponse) GetEPIN() string {
+	if x != nil {
+		return x.EPIN
+	}
+	return ""
+}
+
+func (x *ELoadSellResponse) GetTrxDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrxDate
+	}
+	return nil
+}
+
+var File_brank_as_petnet_gunk_drp_v1_eload_all_proto protoreflect.FileDescriptor
+
+var file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDesc = []byte{
+	0x0a, 0x2b, 0x62, 0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65,
+	0x74, 0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x2f, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x75, 0x0a, 0x14, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xc1, 0x02, 0x0a, 0x0c, 0x45,
+	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x0b, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
+	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x2d, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0x5c,
+	0x0a, 0x15, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42, 0x0a,
+	0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xd7, 0x01, 0x0a,
+	0x14, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x3a, 0x36,
+	0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x2d, 0x0a, 0x2b, 0xd2, 0x01, 0x0c, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0xd2, 0x01, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x62,
+	0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x45, 0x4c, 0x6f, 0x61, 0x64,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
+	0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x6f, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xbe, 0x02, 0x0a, 0x10, 0x45, 0x4c,
+	0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
+	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x2d, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f,
+	0x62, 0x69, 0x6c, 0x65, 0x4e, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00,
+	0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x3a, 0x4e, 0x08, 0x00, 0x10, 0x00,
+	0x18, 0x00, 0x92, 0x41, 0x45, 0x0a, 0x43, 0xd2, 0x01, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0xd2, 0x01, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0xd2,
+	0x01, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0xd2, 0x01,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0xd2, 0x01, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x22, 0xf4, 0x02, 0x0a, 0x11, 0x45,
+	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x07, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x12, 0x34, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x62, 0x69,
+	0x6c, 0x65, 0x4e, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d,
+	0x6f, 0x62, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x25, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x65, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x45, 0x50, 0x49, 0x4e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x04, 0x65, 0x70, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x07, 0x54, 0x72, 0x78, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
+	0x08, 0x74, 0x72, 0x78, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18,
+	0x00, 0x32, 0x95, 0x09, 0x0a, 0x0c, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x9d, 0x02, 0x0a, 0x0d, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x4c, 0x6f,
+	0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xcc, 0x01, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xa8, 0x01, 0x0a, 0x05, 0x45, 0x4c,
+	0x6f, 0x61, 0x64, 0x12, 0x0e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x20, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x1a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72,
+	0x65, 0x70, 0x61, 0x69, 0x64, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x20, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x55, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x4e, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x2c, 0x0a, 0x2a, 0x1a, 0x28, 0x23, 0x2f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x4c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x28, 0x00,
+	0x30, 0x00, 0x12, 0xbc, 0x03, 0x0a, 0x0d, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x4c, 0x6f,
+	0x61, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xeb, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xc4, 0x02, 0x0a, 0x05, 0x45, 0x4c,
+	0x6f, 0x61, 0x64, 0x12, 0x0e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x20, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x1a, 0x2b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x20, 0x61, 0x20, 0x6c, 0x6f, 0x61,
+	0x64, 0x20, 0x63, 0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x73, 0x6f, 0x6c, 0x64, 0x20, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x20, 0x73, 0x65, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x74, 0x2e,
+	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x4a, 0x55, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x4e, 0x0a, 0x1e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x2c, 0x0a, 0x2a, 0x1a,
+	0x28, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x94, 0x01, 0x0a, 0x03, 0x34, 0x30,
+	0x30, 0x12, 0x8c, 0x01, 0x0a, 0x3e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77,
+	0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2c,
+	0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x6f, 0x62, 0x69, 0x6c,
+	0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x2e, 0x12, 0x4a, 0x0a, 0x48, 0x4a, 0x46, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64,
+	0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x7d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x28, 0x00, 0x30,
+	0x00, 0x12, 0xa0, 0x03, 0x0a, 0x09, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x12,
+	0x17, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x2e, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xdb, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xb8, 0x02, 0x0a,
+	0x05, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x0a, 0x45, 0x4c, 0x6f, 0x61, 0x64, 0x20, 0x53, 0x65,
+	0x6c, 0x6c, 0x1a, 0x27, 0x53, 0x65, 0x6c, 0x6c, 0x20, 0x61, 0x20, 0x70, 0x72, 0x65, 0x70, 0x61,
+	0x69, 0x64, 0x20, 0x6c, 0x6f, 0x61, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x61, 0x20, 0x6d, 0x6f, 0x62,
+	0x69, 0x6c, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x51, 0x0a,
+	0x03, 0x32, 0x30, 0x30, 0x12, 0x4a, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x28, 0x0a, 0x26, 0x1a, 0x24, 0x23, 0x2f, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45,
+	0x4c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4a, 0x94, 0x01, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x8c, 0x01, 0x0a, 0x3e, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2c, 0x20, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x6f,
+	0x72, 0x20, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x65, 0x20, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x20,
+	0x69, 0x73, 0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2e, 0x12, 0x4a, 0x0a, 0x48, 0x4a,
+	0x46, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20,
+	0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x20, 0x64, 0x6f, 0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x20, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a,
+	0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x2f, 0x73, 0x65, 0x6c, 0x6c,
+	0x28, 0x00, 0x30, 0x00, 0x1a, 0x03, 0x88, 0x02, 0x00, 0x42, 0x42, 0x48, 0x01, 0x50, 0x00, 0x5a,
+	0x27, 0x62, 0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74,
+	0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6c, 0x6f,
+	0x61, 0x64, 0x3b, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01,
+	0x00, 0xb8, 0x01, 0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescOnce sync.Once
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescData = file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDesc
+)
+
+func file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescGZIP() []byte {
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescOnce.Do(func() {
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescData = protoimpl.X.CompressGZIP(file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescData)
+	})
+	return file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDescData
+}
+
+var (
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_goTypes  = []interface{}{
+		(*ELoadProductsRequest)(nil),  // 0: eload.ELoadProductsRequest
+		(*ELoadProduct)(nil),          // 1: eload.ELoadProduct
+		(*ELoadProductsResponse)(nil), // 2: eload.ELoadProductsResponse
+		(*ELoadValidateRequest)(nil),  // 3: eload.ELoadValidateRequest
+		(*ELoadValidateResponse)(nil), // 4: eload.ELoadValidateResponse
+		(*ELoadSellRequest)(nil),      // 5: eload.ELoadSellRequest
+		(*ELoadSellResponse)(nil),     // 6: eload.ELoadSellResponse
+		(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	}
+)
+
+var file_brank_as_petnet_gunk_drp_v1_eload_all_proto_depIdxs = []int32{
+	1, // 0: eload.ELoadProductsResponse.Products:type_name -> eload.ELoadProduct
+	1, // 1: eload.ELoadValidateResponse.Product:type_name -> eload.ELoadProduct
+	1, // 2: eload.ELoadSellResponse.Product:type_name -> eload.ELoadProduct
+	7, // 3: eload.ELoadSellResponse.TrxDate:type_name -> google.protobuf.Timestamp
+	0, // 4: eload.ELoadService.ELoadProducts:input_type -> eload.ELoadProductsRequest
+	3, // 5: eload.ELoadService.ELoadValidate:input_type -> eload.ELoadValidateRequest
+	5, // 6: eload.ELoadService.ELoadSell:input_type -> eload.ELoadSellRequest
+	2, // 7: eload.ELoadService.ELoadProducts:output_type -> eload.ELoadProductsResponse
+	4, // 8: eload.ELoadService.ELoadValidate:output_type -> eload.ELoadValidateResponse
+	6, // 9: eload.ELoadService.ELoadSell:output_type -> eload.ELoadSellResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_brank_as_petnet_gunk_drp_v1_eload_all_proto_init() }
+func file_brank_as_petnet_gunk_drp_v1_eload_all_proto_init() {
+	if File_brank_as_petnet_gunk_drp_v1_eload_all_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadProductsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadProduct); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadProductsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadValidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadValidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadSellRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ELoadSellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_brank_as_petnet_gunk_drp_v1_eload_all_proto_goTypes,
+		DependencyIndexes: file_brank_as_petnet_gunk_drp_v1_eload_all_proto_depIdxs,
+		MessageInfos:      file_brank_as_petnet_gunk_drp_v1_eload_all_proto_msgTypes,
+	}.Build()
+	File_brank_as_petnet_gunk_drp_v1_eload_all_proto = out.File
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_rawDesc = nil
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_goTypes = nil
+	file_brank_as_petnet_gunk_drp_v1_eload_all_proto_depIdxs = nil
+}
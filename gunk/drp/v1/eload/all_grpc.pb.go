@@ -0,0 +1,181 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package eload
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ELoadServiceClient is the client API for ELoadService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ELoadServiceClient interface {
+	// Products
+	ELoadProducts(ctx context.Context, in *ELoadProductsRequest, opts ...grpc.CallOption) (*ELoadProductsResponse, error)
+	// Validate
+	ELoadValidate(ctx context.Context, in *ELoadValidateRequest, opts ...grpc.CallOption) (*ELoadValidateResponse, error)
+	// Sell
+	ELoadSell(ctx context.Context, in *ELoadSellRequest, opts ...grpc.CallOption) (*ELoadSellResponse, error)
+}
+
+type eLoadServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewELoadServiceClient(cc grpc.ClientConnInterface) ELoadServiceClient {
+	return &eLoadServiceClient{cc}
+}
+
+func (c *eLoadServiceClient) ELoadProducts(ctx context.Context, in *ELoadProductsRequest, opts ...grpc.CallOption) (*ELoadProductsResponse, error) {
+	out := new(ELoadProductsResponse)
+	err := c.cc.Invoke(ctx, "/eload.ELoadService/ELoadProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLoadServiceClient) ELoadValidate(ctx context.Context, in *ELoadValidateRequest, opts ...grpc.CallOption) (*ELoadValidateResponse, error) {
+	out := new(ELoadValidateResponse)
+	err := c.cc.Invoke(ctx, "/eload.ELoadService/ELoadValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLoadServiceClient) ELoadSell(ctx context.Context, in *ELoadSellRequest, opts ...grpc.CallOption) (*ELoadSellResponse, error) {
+	out := new(ELoadSellResponse)
+	err := c.cc.Invoke(ctx, "/eload.ELoadService/ELoadSell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ELoadServiceServer is the server API for ELoadService service.
+// All implementations must embed UnimplementedELoadServiceServer
+// for forward compatibility
+type ELoadServiceServer interface {
+	// Products
+	ELoadProducts(context.Context, *ELoadProductsRequest) (*ELoadProductsResponse, error)
+	// Validate
+	ELoadValidate(context.Context, *ELoadValidateRequest) (*ELoadValidateResponse, error)
+	// Sell
+	ELoadSell(context.Context, *ELoadSellRequest) (*ELoadSellResponse, error)
+	mustEmbedUnimplementedELoadServiceServer()
+}
+
+// UnimplementedELoadServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedELoadServiceServer struct{}
+
+func (UnimplementedELoadServiceServer) ELoadProducts(context.Context, *ELoadProductsRequest) (*ELoadProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ELoadProducts not implemented")
+}
+
+func (UnimplementedELoadServiceServer) ELoadValidate(context.Context, *ELoadValidateRequest) (*ELoadValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ELoadValidate not implemented")
+}
+
+func (UnimplementedELoadServiceServer) ELoadSell(context.Context, *ELoadSellRequest) (*ELoadSellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ELoadSell not implemented")
+}
+func (UnimplementedELoadServiceServer) mustEmbedUnimplementedELoadServiceServer() {}
+
+// UnsafeELoadServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ELoadServiceServer will
+// result in compilation errors.
+type UnsafeELoadServiceServer interface {
+	mustEmbedUnimplementedELoadServiceServer()
+}
+
+func RegisterELoadServiceServer(s grpc.ServiceRegistrar, srv ELoadServiceServer) {
+	s.RegisterService(&ELoadService_ServiceDesc, srv)
+}
+
+func _ELoadService_ELoadProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ELoadProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELoadServiceServer).ELoadProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eload.ELoadService/ELoadProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELoadServiceServer).ELoadProducts(ctx, req.(*ELoadProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELoadService_ELoadValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ELoadValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELoadServiceServer).ELoadValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eload.ELoadService/ELoadValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELoadServiceServer).ELoadValidate(ctx, req.(*ELoadValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELoadService_ELoadSell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ELoadSellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELoadServiceServer).ELoadSell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eload.ELoadService/ELoadSell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELoadServiceServer).ELoadSell(ctx, req.(*ELoadSellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ELoadService_ServiceDesc is the grpc.ServiceDesc for ELoadService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ELoadService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "eload.ELoadService",
+	HandlerType: (*ELoadServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ELoadProducts",
+			Handler:    _ELoadService_ELoadProducts_Handler,
+		},
+		{
+			MethodName: "ELoadValidate",
+			Handler:    _ELoadService_ELoadValidate_Handler,
+		},
+		{
+			MethodName: "ELoadSell",
+			Handler:    _ELoadService_ELoadSell_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "brank.as/petnet/gunk/drp/v1/eload/all.proto",
+}
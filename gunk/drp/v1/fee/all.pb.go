@@ -150,6 +150,227 @@ func (x *FeeInquiryResponse) GetFees() map[string]string {
 	return nil
 }
 
+// CompareQuotesRequest asks every partner with the remit type for a quote.
+// RemitType defaults to Send. Partners limits the comparison, all partners are
+// asked when empty. Email is needed by partners that quote per customer.
+type CompareQuotesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount    *terminal.SendAmount `protobuf:"bytes,1,opt,name=Amount,json=amount,proto3" json:"amount,omitempty"`
+	RemitType string               `protobuf:"bytes,2,opt,name=RemitType,json=remit_type,proto3" json:"remit_type,omitempty"`
+	Partners  []string             `protobuf:"bytes,3,rep,name=Partners,json=partners,proto3" json:"partners,omitempty"`
+	Email     string               `protobuf:"bytes,4,opt,name=Email,json=email,proto3" json:"email,omitempty"`
+}
+
+func (x *CompareQuotesRequest) Reset() {
+	*x = CompareQuotesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareQuotesRequest) ProtoMessage() {}
+
+func (x *CompareQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareQuotesRequest.ProtoReflect.Descriptor instead.
+func (*CompareQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompareQuotesRequest) GetAmount() *terminal.SendAmount {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CompareQuotesRequest) GetRemitType() string {
+	if x != nil {
+		return x.RemitType
+	}
+	return ""
+}
+
+func (x *CompareQuotesRequest) GetPartners() []string {
+	if x != nil {
+		return x.Partners
+	}
+	return nil
+}
+
+func (x *CompareQuotesRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// PartnerQuote is the cost of sending through a partner. Fee is everything
+// charged on top of the principal. Error is set when the partner could not
+// quote in time.
+type PartnerQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemitPartner   string           `protobuf:"bytes,1,opt,name=RemitPartner,json=remit_partner,proto3" json:"remit_partner,omitempty"`
+	RemitType      string           `protobuf:"bytes,2,opt,name=RemitType,json=remit_type,proto3" json:"remit_type,omitempty"`
+	DeliveryMethod string           `protobuf:"bytes,3,opt,name=DeliveryMethod,json=delivery_method,proto3" json:"delivery_method,omitempty"`
+	Fee            *terminal.Amount `protobuf:"bytes,4,opt,name=Fee,json=fee,proto3" json:"fee,omitempty"`
+	ExchangeRate   string           `protobuf:"bytes,5,opt,name=ExchangeRate,json=exchange_rate,proto3" json:"exchange_rate,omitempty"`
+	ReceiveAmount  *terminal.Amount `protobuf:"bytes,6,opt,name=ReceiveAmount,json=receive_amount,proto3" json:"receive_amount,omitempty"`
+	Error          string           `protobuf:"bytes,7,opt,name=Error,json=error,proto3" json:"error,omitempty"`
+}
+
+func (x *PartnerQuote) Reset() {
+	*x = PartnerQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartnerQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartnerQuote) ProtoMessage() {}
+
+func (x *PartnerQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartnerQuote.ProtoReflect.Descriptor instead.
+func (*PartnerQuote) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PartnerQuote) GetRemitPartner() string {
+	if x != nil {
+		return x.RemitPartner
+	}
+	return ""
+}
+
+func (x *PartnerQuote) GetRemitType() string {
+	if x != nil {
+		return x.RemitType
+	}
+	return ""
+}
+
+func (x *PartnerQuote) GetDeliveryMethod() string {
+	if x != nil {
+		return x.DeliveryMethod
+	}
+	return ""
+}
+
+func (x *PartnerQuote) GetFee() *terminal.Amount {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *PartnerQuote) GetExchangeRate() string {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return ""
+}
+
+func (x *PartnerQuote) GetReceiveAmount() *terminal.Amount {
+	if x != nil {
+		return x.ReceiveAmount
+	}
+	return nil
+}
+
+func (x *PartnerQuote) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CompareQuotesResponse lists the best quote for the customer first, failed
+// partners last.
+type CompareQuotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quotes []*PartnerQuote `protobuf:"bytes,1,rep,name=Quotes,json=quotes,proto3" json:"quotes,omitempty"`
+}
+
+func (x *CompareQuotesResponse) Reset() {
+	*x = CompareQuotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareQuotesResponse) ProtoMessage() {}
+
+func (x *CompareQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareQuotesResponse.ProtoReflect.Descriptor instead.
+func (*CompareQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompareQuotesResponse) GetQuotes() []*PartnerQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
 var File_brank_as_petnet_gunk_drp_v1_fee_all_proto protoreflect.FileDescriptor
 
 var file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDesc = []byte{
@@ -192,39 +413,111 @@ var file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDesc = []byte{
 	0x04, 0x66, 0x65, 0x65, 0x73, 0x1a, 0x2b, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x73, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x0b, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x12,
 	0x0d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x02,
-	0x38, 0x01, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x32, 0xb0, 0x03, 0x0a, 0x0a, 0x46,
-	0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x9c, 0x03, 0x0a, 0x0a, 0x46, 0x65,
-	0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x12, 0x16, 0x2e, 0x66, 0x65, 0x65, 0x2e, 0x46,
-	0x65, 0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x66, 0x65, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8, 0x02, 0x88, 0x02, 0x00, 0x90,
-	0x02, 0x00, 0x92, 0x41, 0xa5, 0x02, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x12, 0x14, 0x50, 0x61, 0x72,
-	0x74, 0x6e, 0x65, 0x72, 0x20, 0x46, 0x65, 0x65, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79,
-	0x2e, 0x1a, 0x2a, 0x47, 0x65, 0x74, 0x20, 0x61, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66,
-	0x20, 0x66, 0x65, 0x65, 0x73, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x63, 0x68, 0x6f, 0x6f, 0x73, 0x65,
-	0x6e, 0x20, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x3a, 0x10, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a,
-	0x50, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x49, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x27, 0x0a, 0x25, 0x1a, 0x23, 0x23, 0x2f,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x65, 0x65, 0x46,
+	0x38, 0x01, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0xdb, 0x01, 0x0a, 0x14, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x09, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x72, 0x65,
+	0x6d, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x74,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x73,
+	0x12, 0x20, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x3a, 0x14, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x92, 0x41, 0x0b, 0x0a, 0x09, 0xd2,
+	0x01, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xef, 0x02, 0x0a, 0x0c, 0x50, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
+	0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x6d,
+	0x69, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x09, 0x52, 0x65,
+	0x6d, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x69, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x33, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x03, 0x46, 0x65,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2f, 0x0a, 0x0c, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0d, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x20, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0x56, 0x0a, 0x15, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x65, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65,
+	0x72, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00,
+	0x18, 0x00, 0x32, 0x85, 0x07, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x9c, 0x03, 0x0a, 0x0a, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79,
+	0x12, 0x16, 0x2e, 0x66, 0x65, 0x65, 0x2e, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x66, 0x65, 0x65, 0x2e, 0x46,
 	0x65, 0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4a, 0x39, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x32, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x6f,
-	0x72, 0x20, 0x6d, 0x61, 0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x2e, 0x4a, 0x3d, 0x0a, 0x03,
-	0x34, 0x30, 0x31, 0x12, 0x36, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20,
-	0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x74,
-	0x68, 0x69, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x7b, 0x52,
-	0x65, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x7d, 0x2f, 0x69, 0x6e, 0x71,
-	0x75, 0x69, 0x72, 0x79, 0x28, 0x00, 0x30, 0x00, 0x1a, 0x03, 0x88, 0x02, 0x00, 0x42, 0x3e, 0x48,
-	0x01, 0x50, 0x00, 0x5a, 0x23, 0x62, 0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65,
-	0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x65, 0x65, 0x3b, 0x66, 0x65, 0x65, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01,
-	0x00, 0xb8, 0x01, 0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0xd8, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0xa5, 0x02, 0x0a, 0x03,
+	0x46, 0x65, 0x65, 0x12, 0x14, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x20, 0x46, 0x65, 0x65,
+	0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x2e, 0x1a, 0x2a, 0x47, 0x65, 0x74, 0x20, 0x61,
+	0x20, 0x6c, 0x69, 0x73, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x66, 0x65, 0x65, 0x73, 0x20, 0x66, 0x6f,
+	0x72, 0x20, 0x63, 0x68, 0x6f, 0x6f, 0x73, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x20,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x50, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x49,
+	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e,
+	0x12, 0x27, 0x0a, 0x25, 0x1a, 0x23, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x65, 0x65, 0x46, 0x65, 0x65, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x39, 0x0a, 0x03, 0x34, 0x30, 0x30,
+	0x12, 0x32, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65,
+	0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73, 0x20, 0x69, 0x6e, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61, 0x6c, 0x66, 0x6f, 0x72,
+	0x6d, 0x65, 0x64, 0x2e, 0x4a, 0x3d, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12, 0x36, 0x0a, 0x34, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x20, 0x74, 0x6f, 0x20, 0x70,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x7b, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x6e, 0x65, 0x72, 0x7d, 0x2f, 0x69, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x79, 0x28, 0x00, 0x30, 0x00,
+	0x12, 0xd2, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x19, 0x2e, 0x66, 0x65, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x66, 0x65, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x03, 0x88, 0x02, 0x00, 0x90,
+	0x02, 0x00, 0x92, 0x41, 0xe1, 0x02, 0x0a, 0x03, 0x46, 0x65, 0x65, 0x12, 0x17, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x20, 0x50, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x20, 0x51, 0x75, 0x6f,
+	0x74, 0x65, 0x73, 0x2e, 0x1a, 0x60, 0x47, 0x65, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x65,
+	0x65, 0x2c, 0x20, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x72, 0x61, 0x74, 0x65,
+	0x20, 0x61, 0x6e, 0x64, 0x20, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x20, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x20, 0x6f, 0x66, 0x20, 0x65, 0x76, 0x65, 0x72, 0x79, 0x20, 0x70, 0x61, 0x72,
+	0x74, 0x6e, 0x65, 0x72, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x2c, 0x20, 0x62, 0x65, 0x73, 0x74, 0x20, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x20,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x2e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x53, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12,
+	0x4c, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79,
+	0x2e, 0x12, 0x2a, 0x0a, 0x28, 0x1a, 0x26, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x65, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x39, 0x0a,
+	0x03, 0x34, 0x30, 0x30, 0x12, 0x32, 0x0a, 0x30, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
+	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x69, 0x73,
+	0x20, 0x69, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x20, 0x6f, 0x72, 0x20, 0x6d, 0x61,
+	0x6c, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x2e, 0x4a, 0x3d, 0x0a, 0x03, 0x34, 0x30, 0x31, 0x12,
+	0x36, 0x0a, 0x34, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e,
+	0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x20,
+	0x74, 0x6f, 0x20, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72,
+	0x65, 0x28, 0x00, 0x30, 0x00, 0x1a, 0x03, 0x88, 0x02, 0x00, 0x42, 0x3e, 0x48, 0x01, 0x50, 0x00,
+	0x5a, 0x23, 0x62, 0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65,
+	0x74, 0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65,
+	0x65, 0x3b, 0x66, 0x65, 0x65, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01, 0x00, 0xb8, 0x01,
+	0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -240,25 +533,35 @@ func file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+	file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 	file_brank_as_petnet_gunk_drp_v1_fee_all_proto_goTypes  = []interface{}{
-		(*FeeInquiryRequest)(nil),   // 0: fee.FeeInquiryRequest
-		(*FeeInquiryResponse)(nil),  // 1: fee.FeeInquiryResponse
-		nil,                         // 2: fee.FeeInquiryResponse.FeesEntry
-		(*terminal.SendAmount)(nil), // 3: terminal.SendAmount
+		(*FeeInquiryRequest)(nil),     // 0: fee.FeeInquiryRequest
+		(*FeeInquiryResponse)(nil),    // 1: fee.FeeInquiryResponse
+		(*CompareQuotesRequest)(nil),  // 2: fee.CompareQuotesRequest
+		(*PartnerQuote)(nil),          // 3: fee.PartnerQuote
+		(*CompareQuotesResponse)(nil), // 4: fee.CompareQuotesResponse
+		nil,                           // 5: fee.FeeInquiryResponse.FeesEntry
+		(*terminal.SendAmount)(nil),   // 6: terminal.SendAmount
+		(*terminal.Amount)(nil),       // 7: terminal.Amount
 	}
 )
 
 var file_brank_as_petnet_gunk_drp_v1_fee_all_proto_depIdxs = []int32{
-	3, // 0: fee.FeeInquiryRequest.Amount:type_name -> terminal.SendAmount
-	2, // 1: fee.FeeInquiryResponse.Fees:type_name -> fee.FeeInquiryResponse.FeesEntry
-	0, // 2: fee.FeeService.FeeInquiry:input_type -> fee.FeeInquiryRequest
-	1, // 3: fee.FeeService.FeeInquiry:output_type -> fee.FeeInquiryResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	6, // 0: fee.FeeInquiryRequest.Amount:type_name -> terminal.SendAmount
+	5, // 1: fee.FeeInquiryResponse.Fees:type_name -> fee.FeeInquiryResponse.FeesEntry
+	6, // 2: fee.CompareQuotesRequest.Amount:type_name -> terminal.SendAmount
+	7, // 3: fee.PartnerQuote.Fee:type_name -> terminal.Amount
+	7, // 4: fee.PartnerQuote.ReceiveAmount:type_name -> terminal.Amount
+	3, // 5: fee.CompareQuotesResponse.Quotes:type_name -> fee.PartnerQuote
+	0, // 6: fee.FeeService.FeeInquiry:input_type -> fee.FeeInquiryRequest
+	2, // 7: fee.FeeService.CompareQuotes:input_type -> fee.CompareQuotesRequest
+	1, // 8: fee.FeeService.FeeInquiry:output_type -> fee.FeeInquiryResponse
+	4, // 9: fee.FeeService.CompareQuotes:output_type -> fee.CompareQuotesResponse
+	8, // [8:10] is the sub-list for method output_type
+	6, // [6:8] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_brank_as_petnet_gunk_drp_v1_fee_all_proto_init() }
@@ -291,6 +594,42 @@ func file_brank_as_petnet_gunk_drp_v1_fee_all_proto_init() {
 				return nil
 			}
 		}
+		file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareQuotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartnerQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_fee_all_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareQuotesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -298,7 +637,7 @@ func file_brank_as_petnet_gunk_drp_v1_fee_all_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_brank_as_petnet_gunk_drp_v1_fee_all_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 type FeeServiceClient interface {
 	// Get a list of fees for choosen remit type.
 	FeeInquiry(ctx context.Context, in *FeeInquiryRequest, opts ...grpc.CallOption) (*FeeInquiryResponse, error)
+	// Compare the fees and rates of the partners.
+	CompareQuotes(ctx context.Context, in *CompareQuotesRequest, opts ...grpc.CallOption) (*CompareQuotesResponse, error)
 }
 
 type feeServiceClient struct {
@@ -40,12 +42,23 @@ func (c *feeServiceClient) FeeInquiry(ctx context.Context, in *FeeInquiryRequest
 	return out, nil
 }
 
+func (c *feeServiceClient) CompareQuotes(ctx context.Context, in *CompareQuotesRequest, opts ...grpc.CallOption) (*CompareQuotesResponse, error) {
+	out := new(CompareQuotesResponse)
+	err := c.cc.Invoke(ctx, "/fee.FeeService/CompareQuotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FeeServiceServer is the server API for FeeService service.
 // All implementations must embed UnimplementedFeeServiceServer
 // for forward compatibility
 type FeeServiceServer interface {
 	// Get a list of fees for choosen remit type.
 	FeeInquiry(context.Context, *FeeInquiryRequest) (*FeeInquiryResponse, error)
+	// Compare the fees and rates of the partners.
+	CompareQuotes(context.Context, *CompareQuotesRequest) (*CompareQuotesResponse, error)
 	mustEmbedUnimplementedFeeServiceServer()
 }
 
@@ -55,6 +68,10 @@ type UnimplementedFeeServiceServer struct{}
 func (UnimplementedFeeServiceServer) FeeInquiry(context.Context, *FeeInquiryRequest) (*FeeInquiryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeInquiry not implemented")
 }
+
+func (UnimplementedFeeServiceServer) CompareQuotes(context.Context, *CompareQuotesRequest) (*CompareQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareQuotes not implemented")
+}
 func (UnimplementedFeeServiceServer) mustEmbedUnimplementedFeeServiceServer() {}
 
 // UnsafeFeeServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +103,24 @@ func _FeeService_FeeInquiry_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FeeService_CompareQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FeeServiceServer).CompareQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fee.FeeService/CompareQuotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FeeServiceServer).CompareQuotes(ctx, req.(*CompareQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FeeService_ServiceDesc is the grpc.ServiceDesc for FeeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +132,10 @@ var FeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FeeInquiry",
 			Handler:    _FeeService_FeeInquiry_Handler,
 		},
+		{
+			MethodName: "CompareQuotes",
+			Handler:    _FeeService_CompareQuotes_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/fee/all.proto",
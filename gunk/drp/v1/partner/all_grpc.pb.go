@@ -26,6 +26,26 @@ type RemitPartnerServiceClient interface {
 	InputGuide(ctx context.Context, in *InputGuideRequest, opts ...grpc.CallOption) (*InputGuideResponse, error)
 	// get partners remco id
 	GetPartnersRemco(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPartnersRemcoResponse, error)
+	// List the partners of the partner registry, including disabled partners.
+	ListRegistryPartners(ctx context.Context, in *ListRegistryPartnersRequest, opts ...grpc.CallOption) (*ListRegistryPartnersResponse, error)
+	// Get a partner of the partner registry.
+	GetRegistryPartner(ctx context.Context, in *GetRegistryPartnerRequest, opts ...grpc.CallOption) (*RegistryPartner, error)
+	// Register a partner.
+	CreateRegistryPartner(ctx context.Context, in *CreateRegistryPartnerRequest, opts ...grpc.CallOption) (*RegistryPartner, error)
+	// Update a registered partner.
+	UpdateRegistryPartner(ctx context.Context, in *UpdateRegistryPartnerRequest, opts ...grpc.CallOption) (*RegistryPartner, error)
+	// Remove a partner from the partner registry.
+	DeleteRegistryPartner(ctx context.Context, in *DeleteRegistryPartnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Refresh the cached input guide of a partner.
+	RefreshInputGuide(ctx context.Context, in *RefreshInputGuideRequest, opts ...grpc.CallOption) (*RefreshInputGuideResponse, error)
+	// List the other info fields of a partner.
+	ListOtherInfoFields(ctx context.Context, in *ListOtherInfoFieldsRequest, opts ...grpc.CallOption) (*ListOtherInfoFieldsResponse, error)
+	// Add or replace an other info field of a partner.
+	SetOtherInfoField(ctx context.Context, in *SetOtherInfoFieldRequest, opts ...grpc.CallOption) (*OtherInfoField, error)
+	// Remove an other info field of a partner.
+	DeleteOtherInfoField(ctx context.Context, in *DeleteOtherInfoFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Report the circuit breaker state of the partners.
+	PartnerHealth(ctx context.Context, in *PartnerHealthRequest, opts ...grpc.CallOption) (*PartnerHealthResponse, error)
 }
 
 type remitPartnerServiceClient struct {
@@ -63,6 +83,96 @@ func (c *remitPartnerServiceClient) GetPartnersRemco(ctx context.Context, in *em
 	return out, nil
 }
 
+func (c *remitPartnerServiceClient) ListRegistryPartners(ctx context.Context, in *ListRegistryPartnersRequest, opts ...grpc.CallOption) (*ListRegistryPartnersResponse, error) {
+	out := new(ListRegistryPartnersResponse)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/ListRegistryPartners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) GetRegistryPartner(ctx context.Context, in *GetRegistryPartnerRequest, opts ...grpc.CallOption) (*RegistryPartner, error) {
+	out := new(RegistryPartner)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/GetRegistryPartner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) CreateRegistryPartner(ctx context.Context, in *CreateRegistryPartnerRequest, opts ...grpc.CallOption) (*RegistryPartner, error) {
+	out := new(RegistryPartner)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/CreateRegistryPartner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) UpdateRegistryPartner(ctx context.Context, in *UpdateRegistryPartnerRequest, opts ...grpc.CallOption) (*RegistryPartner, error) {
+	out := new(RegistryPartner)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/UpdateRegistryPartner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) DeleteRegistryPartner(ctx context.Context, in *DeleteRegistryPartnerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/DeleteRegistryPartner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) RefreshInputGuide(ctx context.Context, in *RefreshInputGuideRequest, opts ...grpc.CallOption) (*RefreshInputGuideResponse, error) {
+	out := new(RefreshInputGuideResponse)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/RefreshInputGuide", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) ListOtherInfoFields(ctx context.Context, in *ListOtherInfoFieldsRequest, opts ...grpc.CallOption) (*ListOtherInfoFieldsResponse, error) {
+	out := new(ListOtherInfoFieldsResponse)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/ListOtherInfoFields", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) SetOtherInfoField(ctx context.Context, in *SetOtherInfoFieldRequest, opts ...grpc.CallOption) (*OtherInfoField, error) {
+	out := new(OtherInfoField)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/SetOtherInfoField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) DeleteOtherInfoField(ctx context.Context, in *DeleteOtherInfoFieldRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/DeleteOtherInfoField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remitPartnerServiceClient) PartnerHealth(ctx context.Context, in *PartnerHealthRequest, opts ...grpc.CallOption) (*PartnerHealthResponse, error) {
+	out := new(PartnerHealthResponse)
+	err := c.cc.Invoke(ctx, "/partner.RemitPartnerService/PartnerHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RemitPartnerServiceServer is the server API for RemitPartnerService service.
 // All implementations must embed UnimplementedRemitPartnerServiceServer
 // for forward compatibility
@@ -73,6 +183,26 @@ type RemitPartnerServiceServer interface {
 	InputGuide(context.Context, *InputGuideRequest) (*InputGuideResponse, error)
 	// get partners remco id
 	GetPartnersRemco(context.Context, *emptypb.Empty) (*GetPartnersRemcoResponse, error)
+	// List the partners of the partner registry, including disabled partners.
+	ListRegistryPartners(context.Context, *ListRegistryPartnersRequest) (*ListRegistryPartnersResponse, error)
+	// Get a partner of the partner registry.
+	GetRegistryPartner(context.Context, *GetRegistryPartnerRequest) (*RegistryPartner, error)
+	// Register a partner.
+	CreateRegistryPartner(context.Context, *CreateRegistryPartnerRequest) (*RegistryPartner, error)
+	// Update a registered partner.
+	UpdateRegistryPartner(context.Context, *UpdateRegistryPartnerRequest) (*RegistryPartner, error)
+	// Remove a partner from the partner registry.
+	DeleteRegistryPartner(context.Context, *DeleteRegistryPartnerRequest) (*emptypb.Empty, error)
+	// Refresh the cached input guide of a partner.
+	RefreshInputGuide(context.Context, *RefreshInputGuideRequest) (*RefreshInputGuideResponse, error)
+	// List the other info fields of a partner.
+	ListOtherInfoFields(context.Context, *ListOtherInfoFieldsRequest) (*ListOtherInfoFieldsResponse, error)
+	// Add or replace an other info field of a partner.
+	SetOtherInfoField(context.Context, *SetOtherInfoFieldRequest) (*OtherInfoField, error)
+	// Remove an other info field of a partner.
+	DeleteOtherInfoField(context.Context, *DeleteOtherInfoFieldRequest) (*emptypb.Empty, error)
+	// Report the circuit breaker state of the partners.
+	PartnerHealth(context.Context, *PartnerHealthRequest) (*PartnerHealthResponse, error)
 	mustEmbedUnimplementedRemitPartnerServiceServer()
 }
 
@@ -90,6 +220,46 @@ func (UnimplementedRemitPartnerServiceServer) InputGuide(context.Context, *Input
 func (UnimplementedRemitPartnerServiceServer) GetPartnersRemco(context.Context, *emptypb.Empty) (*GetPartnersRemcoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPartnersRemco not implemented")
 }
+
+func (UnimplementedRemitPartnerServiceServer) ListRegistryPartners(context.Context, *ListRegistryPartnersRequest) (*ListRegistryPartnersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegistryPartners not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) GetRegistryPartner(context.Context, *GetRegistryPartnerRequest) (*RegistryPartner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistryPartner not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) CreateRegistryPartner(context.Context, *CreateRegistryPartnerRequest) (*RegistryPartner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRegistryPartner not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) UpdateRegistryPartner(context.Context, *UpdateRegistryPartnerRequest) (*RegistryPartner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRegistryPartner not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) DeleteRegistryPartner(context.Context, *DeleteRegistryPartnerRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRegistryPartner not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) RefreshInputGuide(context.Context, *RefreshInputGuideRequest) (*RefreshInputGuideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshInputGuide not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) ListOtherInfoFields(context.Context, *ListOtherInfoFieldsRequest) (*ListOtherInfoFieldsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOtherInfoFields not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) SetOtherInfoField(context.Context, *SetOtherInfoFieldRequest) (*OtherInfoField, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOtherInfoField not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) DeleteOtherInfoField(context.Context, *DeleteOtherInfoFieldRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOtherInfoField not implemented")
+}
+
+func (UnimplementedRemitPartnerServiceServer) PartnerHealth(context.Context, *PartnerHealthRequest) (*PartnerHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartnerHealth not implemented")
+}
 func (UnimplementedRemitPartnerServiceServer) mustEmbedUnimplementedRemitPartnerServiceServer() {}
 
 // UnsafeRemitPartnerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -157,6 +327,186 @@ func _RemitPartnerService_GetPartnersRemco_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RemitPartnerService_ListRegistryPartners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistryPartnersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).ListRegistryPartners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/ListRegistryPartners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).ListRegistryPartners(ctx, req.(*ListRegistryPartnersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_GetRegistryPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistryPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).GetRegistryPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/GetRegistryPartner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).GetRegistryPartner(ctx, req.(*GetRegistryPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_CreateRegistryPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRegistryPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).CreateRegistryPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/CreateRegistryPartner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).CreateRegistryPartner(ctx, req.(*CreateRegistryPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_UpdateRegistryPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRegistryPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).UpdateRegistryPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/UpdateRegistryPartner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).UpdateRegistryPartner(ctx, req.(*UpdateRegistryPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_DeleteRegistryPartner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRegistryPartnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).DeleteRegistryPartner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/DeleteRegistryPartner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).DeleteRegistryPartner(ctx, req.(*DeleteRegistryPartnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_RefreshInputGuide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshInputGuideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).RefreshInputGuide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/RefreshInputGuide",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).RefreshInputGuide(ctx, req.(*RefreshInputGuideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_ListOtherInfoFields_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOtherInfoFieldsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).ListOtherInfoFields(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/ListOtherInfoFields",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).ListOtherInfoFields(ctx, req.(*ListOtherInfoFieldsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_SetOtherInfoField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOtherInfoFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).SetOtherInfoField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/SetOtherInfoField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).SetOtherInfoField(ctx, req.(*SetOtherInfoFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_DeleteOtherInfoField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOtherInfoFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).DeleteOtherInfoField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/DeleteOtherInfoField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).DeleteOtherInfoField(ctx, req.(*DeleteOtherInfoFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemitPartnerService_PartnerHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartnerHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitPartnerServiceServer).PartnerHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/partner.RemitPartnerService/PartnerHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitPartnerServiceServer).PartnerHealth(ctx, req.(*PartnerHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RemitPartnerService_ServiceDesc is the grpc.ServiceDesc for RemitPartnerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -176,6 +526,46 @@ var RemitPartnerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPartnersRemco",
 			Handler:    _RemitPartnerService_GetPartnersRemco_Handler,
 		},
+		{
+			MethodName: "ListRegistryPartners",
+			Handler:    _RemitPartnerService_ListRegistryPartners_Handler,
+		},
+		{
+			MethodName: "GetRegistryPartner",
+			Handler:    _RemitPartnerService_GetRegistryPartner_Handler,
+		},
+		{
+			MethodName: "CreateRegistryPartner",
+			Handler:    _RemitPartnerService_CreateRegistryPartner_Handler,
+		},
+		{
+			MethodName: "UpdateRegistryPartner",
+			Handler:    _RemitPartnerService_UpdateRegistryPartner_Handler,
+		},
+		{
+			MethodName: "DeleteRegistryPartner",
+			Handler:    _RemitPartnerService_DeleteRegistryPartner_Handler,
+		},
+		{
+			MethodName: "RefreshInputGuide",
+			Handler:    _RemitPartnerService_RefreshInputGuide_Handler,
+		},
+		{
+			MethodName: "ListOtherInfoFields",
+			Handler:    _RemitPartnerService_ListOtherInfoFields_Handler,
+		},
+		{
+			MethodName: "SetOtherInfoField",
+			Handler:    _RemitPartnerService_SetOtherInfoField_Handler,
+		},
+		{
+			MethodName: "DeleteOtherInfoField",
+			Handler:    _RemitPartnerService_DeleteOtherInfoField_Handler,
+		},
+		{
+			MethodName: "PartnerHealth",
+			Handler:    _RemitPartnerService_PartnerHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/partner/all.proto",
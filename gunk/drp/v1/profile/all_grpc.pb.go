@@ -35,6 +35,16 @@ type ProfileServiceClient interface {
 	UpdateRecipient(ctx context.Context, in *UpdateRecipientRequest, opts ...grpc.CallOption) (*UpdateRecipientResponse, error)
 	// Get user.
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// Customer transaction history.
+	CustomerHistory(ctx context.Context, in *CustomerHistoryRequest, opts ...grpc.CallOption) (*CustomerHistoryResponse, error)
+	// Start customer enrollment.
+	StartEnrollment(ctx context.Context, in *StartEnrollmentRequest, opts ...grpc.CallOption) (*StartEnrollmentResponse, error)
+	// Confirm customer enrollment.
+	ConfirmEnrollment(ctx context.Context, in *ConfirmEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmEnrollmentResponse, error)
+	// Update enrolled customer.
+	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error)
+	// Look up enrolled customer.
+	LookupCustomer(ctx context.Context, in *LookupCustomerRequest, opts ...grpc.CallOption) (*LookupCustomerResponse, error)
 }
 
 type profileServiceClient struct {
@@ -117,6 +127,51 @@ func (c *profileServiceClient) GetUser(ctx context.Context, in *GetUserRequest,
 	return out, nil
 }
 
+func (c *profileServiceClient) CustomerHistory(ctx context.Context, in *CustomerHistoryRequest, opts ...grpc.CallOption) (*CustomerHistoryResponse, error) {
+	out := new(CustomerHistoryResponse)
+	err := c.cc.Invoke(ctx, "/profile.ProfileService/CustomerHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) StartEnrollment(ctx context.Context, in *StartEnrollmentRequest, opts ...grpc.CallOption) (*StartEnrollmentResponse, error) {
+	out := new(StartEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/profile.ProfileService/StartEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) ConfirmEnrollment(ctx context.Context, in *ConfirmEnrollmentRequest, opts ...grpc.CallOption) (*ConfirmEnrollmentResponse, error) {
+	out := new(ConfirmEnrollmentResponse)
+	err := c.cc.Invoke(ctx, "/profile.ProfileService/ConfirmEnrollment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error) {
+	out := new(UpdateCustomerResponse)
+	err := c.cc.Invoke(ctx, "/profile.ProfileService/UpdateCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileServiceClient) LookupCustomer(ctx context.Context, in *LookupCustomerRequest, opts ...grpc.CallOption) (*LookupCustomerResponse, error) {
+	out := new(LookupCustomerResponse)
+	err := c.cc.Invoke(ctx, "/profile.ProfileService/LookupCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProfileServiceServer is the server API for ProfileService service.
 // All implementations must embed UnimplementedProfileServiceServer
 // for forward compatibility
@@ -137,6 +192,16 @@ type ProfileServiceServer interface {
 	UpdateRecipient(context.Context, *UpdateRecipientRequest) (*UpdateRecipientResponse, error)
 	// Get user.
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// Customer transaction history.
+	CustomerHistory(context.Context, *CustomerHistoryRequest) (*CustomerHistoryResponse, error)
+	// Start customer enrollment.
+	StartEnrollment(context.Context, *StartEnrollmentRequest) (*StartEnrollmentResponse, error)
+	// Confirm customer enrollment.
+	ConfirmEnrollment(context.Context, *ConfirmEnrollmentRequest) (*ConfirmEnrollmentResponse, error)
+	// Update enrolled customer.
+	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
+	// Look up enrolled customer.
+	LookupCustomer(context.Context, *LookupCustomerRequest) (*LookupCustomerResponse, error)
 	mustEmbedUnimplementedProfileServiceServer()
 }
 
@@ -174,6 +239,26 @@ func (UnimplementedProfileServiceServer) UpdateRecipient(context.Context, *Updat
 func (UnimplementedProfileServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
+
+func (UnimplementedProfileServiceServer) CustomerHistory(context.Context, *CustomerHistoryRequest) (*CustomerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CustomerHistory not implemented")
+}
+
+func (UnimplementedProfileServiceServer) StartEnrollment(context.Context, *StartEnrollmentRequest) (*StartEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEnrollment not implemented")
+}
+
+func (UnimplementedProfileServiceServer) ConfirmEnrollment(context.Context, *ConfirmEnrollmentRequest) (*ConfirmEnrollmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEnrollment not implemented")
+}
+
+func (UnimplementedProfileServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
+}
+
+func (UnimplementedProfileServiceServer) LookupCustomer(context.Context, *LookupCustomerRequest) (*LookupCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupCustomer not implemented")
+}
 func (UnimplementedProfileServiceServer) mustEmbedUnimplementedProfileServiceServer() {}
 
 // UnsafeProfileServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -331,6 +416,96 @@ func _ProfileService_GetUser_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProfileService_CustomerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).CustomerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileService/CustomerHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).CustomerHistory(ctx, req.(*CustomerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_StartEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).StartEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileService/StartEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).StartEnrollment(ctx, req.(*StartEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_ConfirmEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).ConfirmEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileService/ConfirmEnrollment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).ConfirmEnrollment(ctx, req.(*ConfirmEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).UpdateCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileService/UpdateCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).UpdateCustomer(ctx, req.(*UpdateCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileService_LookupCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServiceServer).LookupCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.ProfileService/LookupCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServiceServer).LookupCustomer(ctx, req.(*LookupCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProfileService_ServiceDesc is the grpc.ServiceDesc for ProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -370,6 +545,26 @@ var ProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUser",
 			Handler:    _ProfileService_GetUser_Handler,
 		},
+		{
+			MethodName: "CustomerHistory",
+			Handler:    _ProfileService_CustomerHistory_Handler,
+		},
+		{
+			MethodName: "StartEnrollment",
+			Handler:    _ProfileService_StartEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmEnrollment",
+			Handler:    _ProfileService_ConfirmEnrollment_Handler,
+		},
+		{
+			MethodName: "UpdateCustomer",
+			Handler:    _ProfileService_UpdateCustomer_Handler,
+		},
+		{
+			MethodName: "LookupCustomer",
+			Handler:    _ProfileService_LookupCustomer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/profile/all.proto",
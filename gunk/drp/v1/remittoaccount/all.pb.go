@@ -24,6 +24,110 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SortOrder int32
+
+const (
+	SortOrder_DESC SortOrder = 0
+	SortOrder_ASC  SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "DESC",
+		1: "ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"DESC": 0,
+		"ASC":  1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_enumTypes[0].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_enumTypes[0]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDescGZIP(), []int{0}
+}
+
+type SortByColumn int32
+
+const (
+	SortByColumn_OmitSortByColumn         SortByColumn = 0
+	SortByColumn_ReferenceNumber          SortByColumn = 1
+	SortByColumn_TotalAmount              SortByColumn = 2
+	SortByColumn_TransactionCompletedTime SortByColumn = 3
+	SortByColumn_Partner                  SortByColumn = 4
+	SortByColumn_ServiceCharge            SortByColumn = 5
+)
+
+// Enum value maps for SortByColumn.
+var (
+	SortByColumn_name = map[int32]string{
+		0: "OmitSortByColumn",
+		1: "ReferenceNumber",
+		2: "TotalAmount",
+		3: "TransactionCompletedTime",
+		4: "Partner",
+		5: "ServiceCharge",
+	}
+	SortByColumn_value = map[string]int32{
+		"OmitSortByColumn":         0,
+		"ReferenceNumber":          1,
+		"TotalAmount":              2,
+		"TransactionCompletedTime": 3,
+		"Partner":                  4,
+		"ServiceCharge":            5,
+	}
+)
+
+func (x SortByColumn) Enum() *SortByColumn {
+	p := new(SortByColumn)
+	*p = x
+	return p
+}
+
+func (x SortByColumn) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortByColumn) Descriptor() protoreflect.EnumDescriptor {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_enumTypes[1].Descriptor()
+}
+
+func (SortByColumn) Type() protoreflect.EnumType {
+	return &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_enumTypes[1]
+}
+
+func (x SortByColumn) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortByColumn.Descriptor instead.
+func (SortByColumn) EnumDescriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDescGZIP(), []int{1}
+}
+
 type RTAInquireRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1512,6 +1616,436 @@ func (x *RTARetryResponse) GetBankCode() string {
 	return ""
 }
 
+type RTATransactListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From            string       `protobuf:"bytes,1,opt,name=From,json=from,proto3" json:"from,omitempty"`
+	Until           string       `protobuf:"bytes,2,opt,name=Until,json=until,proto3" json:"until,omitempty"`
+	Limit           int32        `protobuf:"varint,3,opt,name=Limit,json=limit,proto3" json:"limit,omitempty"`
+	Offset          int32        `protobuf:"varint,4,opt,name=Offset,json=offset,proto3" json:"offset,omitempty"`
+	SortOrder       SortOrder    `protobuf:"varint,5,opt,name=SortOrder,json=sort_order,proto3,enum=remittoaccount.SortOrder" json:"sort_order,omitempty"`
+	SortByColumn    SortByColumn `protobuf:"varint,6,opt,name=SortByColumn,json=sort_by_column,proto3,enum=remittoaccount.SortByColumn" json:"sort_by_column,omitempty"`
+	ReferenceNumber string       `protobuf:"bytes,7,opt,name=ReferenceNumber,json=reference_number,proto3" json:"reference_number,omitempty"`
+	ExcludePartners []string     `protobuf:"bytes,8,rep,name=ExcludePartners,json=exclude_partners,proto3" json:"exclude_partners,omitempty"`
+	OrgID           string       `protobuf:"bytes,9,opt,name=OrgID,json=org_id,proto3" json:"org_id,omitempty"`
+	// PageToken lists the page after the one that returned it as
+	// next_page_token. Offset and sort_by_column are ignored and total is not
+	// counted when it is set.
+	PageToken string `protobuf:"bytes,10,opt,name=PageToken,json=page_token,proto3" json:"page_token,omitempty"`
+	// BranchID and TellerID list only the transactions attributed to the
+	// branch or teller.
+	BranchID string `protobuf:"bytes,11,opt,name=BranchID,json=branch_id,proto3" json:"branch_id,omitempty"`
+	TellerID string `protobuf:"bytes,12,opt,name=TellerID,json=teller_id,proto3" json:"teller_id,omitempty"`
+}
+
+func (x *RTATransactListRequest) Reset() {
+	*x = RTATransactListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RTATransactListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RTATransactListRequest) ProtoMessage() {}
+
+func (x *RTATransactListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RTATransactListRequest.ProtoReflect.Descriptor instead.
+func (*RTATransactListRequest) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RTATransactListRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *RTATransactListRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *RTATransactListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RTATransactListRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *RTATransactListRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_DESC
+}
+
+func (x *RTATransactListRequest) GetSortByColumn() SortByColumn {
+	if x != nil {
+		return x.SortByColumn
+	}
+	return SortByColumn_OmitSortByColumn
+}
+
+func (x *RTATransactListRequest) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *RTATransactListRequest) GetExcludePartners() []string {
+	if x != nil {
+		return x.ExcludePartners
+	}
+	return nil
+}
+
+func (x *RTATransactListRequest) GetOrgID() string {
+	if x != nil {
+		return x.OrgID
+	}
+	return ""
+}
+
+func (x *RTATransactListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *RTATransactListRequest) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *RTATransactListRequest) GetTellerID() string {
+	if x != nil {
+		return x.TellerID
+	}
+	return ""
+}
+
+type RTATransactListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Next         int32          `protobuf:"varint,1,opt,name=Next,json=next,proto3" json:"next,omitempty"`
+	RTATransacts []*RTATransact `protobuf:"bytes,2,rep,name=RTATransacts,json=rta_transact,proto3" json:"rta_transact,omitempty"`
+	Total        int32          `protobuf:"varint,3,opt,name=Total,json=total,proto3" json:"total,omitempty"`
+	// NextPageToken is set when sort_by_column is omitted and more rows
+	// may follow.
+	NextPageToken string `protobuf:"bytes,4,opt,name=NextPageToken,json=next_page_token,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *RTATransactListResponse) Reset() {
+	*x = RTATransactListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RTATransactListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RTATransactListResponse) ProtoMessage() {}
+
+func (x *RTATransactListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RTATransactListResponse.ProtoReflect.Descriptor instead.
+func (*RTATransactListResponse) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RTATransactListResponse) GetNext() int32 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
+}
+
+func (x *RTATransactListResponse) GetRTATransacts() []*RTATransact {
+	if x != nil {
+		return x.RTATransacts
+	}
+	return nil
+}
+
+func (x *RTATransactListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RTATransactListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RTATransact is a recorded remit to account payment. The amounts are in the
+// currency of the transaction, as sent to the partner.
+type RTATransact struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID                       string                 `protobuf:"bytes,1,opt,name=ID,json=id,proto3" json:"id,omitempty"`
+	ReferenceNumber          string                 `protobuf:"bytes,2,opt,name=ReferenceNumber,json=reference_number,proto3" json:"reference_number,omitempty"`
+	Partner                  string                 `protobuf:"bytes,3,opt,name=Partner,json=partner,proto3" json:"partner,omitempty"`
+	Currency                 string                 `protobuf:"bytes,4,opt,name=Currency,json=currency,proto3" json:"currency,omitempty"`
+	PrincipalAmount          string                 `protobuf:"bytes,5,opt,name=PrincipalAmount,json=principal_amount,proto3" json:"principal_amount,omitempty"`
+	ServiceCharge            string                 `protobuf:"bytes,6,opt,name=ServiceCharge,json=service_charge,proto3" json:"service_charge,omitempty"`
+	TotalAmount              string                 `protobuf:"bytes,7,opt,name=TotalAmount,json=total_amount,proto3" json:"total_amount,omitempty"`
+	AccountNumber            string                 `protobuf:"bytes,8,opt,name=AccountNumber,json=account_number,proto3" json:"account_number,omitempty"`
+	AccountName              string                 `protobuf:"bytes,9,opt,name=AccountName,json=account_name,proto3" json:"account_name,omitempty"`
+	BankID                   int32                  `protobuf:"varint,10,opt,name=BankID,json=bank_id,proto3" json:"bank_id,omitempty"`
+	RemitterFirstname        string                 `protobuf:"bytes,11,opt,name=RemitterFirstname,json=remitter_firstname,proto3" json:"remitter_firstname,omitempty"`
+	RemitterMiddlename       string                 `protobuf:"bytes,12,opt,name=RemitterMiddlename,json=remitter_middlename,proto3" json:"remitter_middlename,omitempty"`
+	RemitterLastname         string                 `protobuf:"bytes,13,opt,name=RemitterLastname,json=remitter_lastname,proto3" json:"remitter_lastname,omitempty"`
+	BeneficiaryFirstname     string                 `protobuf:"bytes,14,opt,name=BeneficiaryFirstname,json=beneficiary_firstname,proto3" json:"beneficiary_firstname,omitempty"`
+	BeneficiaryMiddlename    string                 `protobuf:"bytes,15,opt,name=BeneficiaryMiddlename,json=beneficiary_middlename,proto3" json:"beneficiary_middlename,omitempty"`
+	BeneficiaryLastname      string                 `protobuf:"bytes,16,opt,name=BeneficiaryLastname,json=beneficiary_lastname,proto3" json:"beneficiary_lastname,omitempty"`
+	TxnStatus                string                 `protobuf:"bytes,17,opt,name=TxnStatus,json=txn_status,proto3" json:"txn_status,omitempty"`
+	ErrorCode                string                 `protobuf:"bytes,18,opt,name=ErrorCode,json=error_code,proto3" json:"error_code,omitempty"`
+	ErrorMessage             string                 `protobuf:"bytes,19,opt,name=ErrorMessage,json=error_message,proto3" json:"error_message,omitempty"`
+	TransactionCompletedTime *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=TransactionCompletedTime,json=transaction_completed_time,proto3" json:"transaction_completed_time,omitempty"`
+	BranchID                 string                 `protobuf:"bytes,21,opt,name=BranchID,json=branch_id,proto3" json:"branch_id,omitempty"`
+	TellerID                 string                 `protobuf:"bytes,22,opt,name=TellerID,json=teller_id,proto3" json:"teller_id,omitempty"`
+}
+
+func (x *RTATransact) Reset() {
+	*x = RTATransact{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RTATransact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RTATransact) ProtoMessage() {}
+
+func (x *RTATransact) ProtoReflect() protoreflect.Message {
+	mi := &file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RTATransact.ProtoReflect.Descriptor instead.
+func (*RTATransact) Descriptor() ([]byte, []int) {
+	return file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RTATransact) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *RTATransact) GetReferenceNumber() string {
+	if x != nil {
+		return x.ReferenceNumber
+	}
+	return ""
+}
+
+func (x *RTATransact) GetPartner() string {
+	if x != nil {
+		return x.Partner
+	}
+	return ""
+}
+
+func (x *RTATransact) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RTATransact) GetPrincipalAmount() string {
+	if x != nil {
+		return x.PrincipalAmount
+	}
+	return ""
+}
+
+func (x *RTATransact) GetServiceCharge() string {
+	if x != nil {
+		return x.ServiceCharge
+	}
+	return ""
+}
+
+func (x *RTATransact) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *RTATransact) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *RTATransact) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *RTATransact) GetBankID() int32 {
+	if x != nil {
+		return x.BankID
+	}
+	return 0
+}
+
+func (x *RTATransact) GetRemitterFirstname() string {
+	if x != nil {
+		return x.RemitterFirstname
+	}
+	return ""
+}
+
+func (x *RTATransact) GetRemitterMiddlename() string {
+	if x != nil {
+		return x.RemitterMiddlename
+	}
+	return ""
+}
+
+func (x *RTATransact) GetRemitterLastname() string {
+	if x != nil {
+		return x.RemitterLastname
+	}
+	return ""
+}
+
+func (x *RTATransact) GetBeneficiaryFirstname() string {
+	if x != nil {
+		return x.BeneficiaryFirstname
+	}
+	return ""
+}
+
+func (x *RTATransact) GetBeneficiaryMiddlename() string {
+	if x != nil {
+		return x.BeneficiaryMiddlename
+	}
+	return ""
+}
+
+func (x *RTATransact) GetBeneficiaryLastname() string {
+	if x != nil {
+		return x.BeneficiaryLastname
+	}
+	return ""
+}
+
+func (x *RTATransact) GetTxnStatus() string {
+	if x != nil {
+		return x.TxnStatus
+	}
+	return ""
+}
+
+func (x *RTATransact) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *RTATransact) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RTATransact) GetTransactionCompletedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactionCompletedTime
+	}
+	return nil
+}
+
+func (x *RTATransact) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *RTATransact) GetTellerID() string {
+	if x != nil {
+		return x.TellerID
+	}
+	return ""
+}
+
 var File_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto protoreflect.FileDescriptor
 
 var file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDesc = []byte{
@@ -2032,88 +2566,253 @@ var file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDesc = []byte{
 	0x12, 0x27, 0x0a, 0x08, 0x42, 0x61, 0x6e, 0x6b, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09,
 	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18,
-	0x00, 0x32, 0xb0, 0x09, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89, 0x03, 0x0a, 0x0a,
-	0x52, 0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x6d,
-	0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x49,
-	0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x00, 0x22, 0xda, 0x04, 0x0a, 0x16, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x05,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18,
+	0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x20,
+	0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x22, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74,
+	0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a,
+	0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0c, 0x53, 0x6f,
+	0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x42, 0x0a,
+	0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0e, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52,
+	0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x0f, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x6e, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x4f, 0x72, 0x67, 0x49,
+	0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
+	0x00, 0x50, 0x00, 0x52, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x09, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x74,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3a, 0x14, 0x08, 0x00, 0x10, 0x00, 0x18, 0x00,
+	0x92, 0x41, 0x0b, 0x0a, 0x09, 0xd2, 0x01, 0x06, 0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x22, 0xe4,
+	0x01, 0x0a, 0x17, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x54,
+	0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2e, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0c, 0x72, 0x74, 0x61, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x0d, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x06, 0x08,
+	0x00, 0x10, 0x00, 0x18, 0x00, 0x22, 0x89, 0x09, 0x0a, 0x0b, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x35, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00,
+	0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74,
+	0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x6e, 0x65, 0x72, 0x12, 0x26,
+	0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x35, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6e, 0x63, 0x69,
+	0x70, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x10, 0x70, 0x72, 0x69,
+	0x6e, 0x63, 0x69, 0x70, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x31, 0x0a,
+	0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00,
+	0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65,
+	0x12, 0x2d, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
+	0x00, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2d, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
+	0x00, 0x50, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x23, 0x0a, 0x06, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x07, 0x62,
+	0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x12, 0x72,
+	0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x10, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x14, 0x42, 0x65, 0x6e, 0x65, 0x66,
+	0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x46, 0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50,
+	0x00, 0x52, 0x15, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x5f, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x15, 0x42, 0x65, 0x6e, 0x65,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30,
+	0x00, 0x50, 0x00, 0x52, 0x16, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79,
+	0x5f, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x13, 0x42,
+	0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x4c, 0x61, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x14, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72,
+	0x79, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x09, 0x54, 0x78,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08,
+	0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x74, 0x78, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x50, 0x00, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x2f, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00,
+	0x50, 0x00, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x64, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x1a, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x49, 0x44, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28,
+	0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x12, 0x27, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x44, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0x08, 0x00, 0x18, 0x00, 0x28, 0x00, 0x30, 0x00, 0x50, 0x00, 0x52, 0x09,
+	0x74, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x3a, 0x06, 0x08, 0x00, 0x10, 0x00, 0x18,
+	0x00, 0x2a, 0x2a, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0c,
+	0x0a, 0x04, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0b, 0x0a, 0x03,
+	0x41, 0x53, 0x43, 0x10, 0x01, 0x1a, 0x02, 0x08, 0x00, 0x1a, 0x02, 0x18, 0x00, 0x2a, 0xa4, 0x01,
+	0x0a, 0x0c, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x18,
+	0x0a, 0x10, 0x4f, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x10, 0x00, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x17, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x02, 0x08,
+	0x00, 0x12, 0x13, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x10, 0x02, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x20, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x10, 0x03, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x0f, 0x0a, 0x07, 0x50, 0x61, 0x72, 0x74,
+	0x6e, 0x65, 0x72, 0x10, 0x04, 0x1a, 0x02, 0x08, 0x00, 0x12, 0x15, 0x0a, 0x0d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x72, 0x67, 0x65, 0x10, 0x05, 0x1a, 0x02, 0x08, 0x00,
+	0x1a, 0x02, 0x18, 0x00, 0x32, 0xea, 0x0c, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89,
+	0x03, 0x0a, 0x0a, 0x52, 0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x12, 0x21, 0x2e,
 	0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52,
-	0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xaf, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x8b, 0x02, 0x0a, 0x0e,
-	0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
-	0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x1a, 0x18, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20,
-	0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a,
-	0x30, 0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4a, 0x56, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x4f, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f,
-	0x75, 0x6e, 0x64, 0x2e, 0x12, 0x33, 0x0a, 0x31, 0x4a, 0x2f, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64,
-	0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x54, 0x41, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
-	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x69, 0x6e, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0x89, 0x03, 0x0a, 0x0a, 0x52, 0x54, 0x41, 0x50,
-	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x65, 0x6d, 0x69,
+	0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x2e, 0x52, 0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x8b,
+	0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x20, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x1a, 0x18, 0x52, 0x65, 0x6d,
+	0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x49, 0x6e,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54,
+	0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e,
+	0x12, 0x32, 0x0a, 0x30, 0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x54, 0x41, 0x49, 0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x56, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x4f, 0x0a, 0x18, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74,
+	0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e, 0x12, 0x33, 0x0a, 0x31, 0x4a, 0x2f, 0x7b, 0x20, 0x22,
+	0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20, 0x22, 0x52, 0x54, 0x41, 0x20, 0x49, 0x6e, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x69,
+	0x6e, 0x71, 0x75, 0x69, 0x72, 0x65, 0x28, 0x00, 0x30, 0x00, 0x12, 0x89, 0x03, 0x0a, 0x0a, 0x52,
+	0x54, 0x41, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x72, 0x65, 0x6d, 0x69,
 	0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xaf, 0x02,
-	0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x8b, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69,
-	0x74, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x52, 0x65, 0x6d, 0x69,
-	0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x18, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x3a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a, 0x30, 0x1a, 0x2e, 0x23,
-	0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x6d,
-	0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x54, 0x41, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x56, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x4f, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e,
-	0x12, 0x33, 0x0a, 0x31, 0x4a, 0x2f, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20,
-	0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20,
-	0x22, 0x52, 0x54, 0x41, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f,
-	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x28,
-	0x00, 0x30, 0x00, 0x12, 0xf9, 0x02, 0x0a, 0x08, 0x52, 0x54, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x12, 0x1f, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x2e, 0x52, 0x54, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0xa5, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x83, 0x02,
-	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x16, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79, 0x1a, 0x16, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20,
-	0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79,
-	0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73,
-	0x6f, 0x6e, 0x4a, 0x59, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x52, 0x0a, 0x1e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x30, 0x0a, 0x2e, 0x1a,
-	0x2c, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72,
-	0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x54, 0x41,
-	0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x54, 0x0a,
-	0x03, 0x34, 0x30, 0x30, 0x12, 0x4d, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64,
-	0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x2e,
-	0x12, 0x31, 0x0a, 0x2f, 0x4a, 0x2d, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a, 0x20,
-	0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a, 0x20,
-	0x22, 0x52, 0x54, 0x41, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x76,
-	0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x28, 0x00, 0x30, 0x00, 0x1a,
-	0x03, 0x88, 0x02, 0x00, 0x42, 0x54, 0x48, 0x01, 0x50, 0x00, 0x5a, 0x39, 0x62, 0x72, 0x61, 0x6e,
-	0x6b, 0x2e, 0x61, 0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x75, 0x6e, 0x6b,
-	0x2f, 0x64, 0x72, 0x70, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3b, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01, 0x00, 0xb8, 0x01,
-	0x00, 0xd8, 0x01, 0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72,
+	0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54,
+	0x41, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xaf, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92, 0x41, 0x8b, 0x02, 0x0a, 0x0e, 0x52,
+	0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x52,
+	0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x18, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x4a, 0x5b, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x54, 0x0a, 0x1e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x32, 0x0a, 0x30,
+	0x1a, 0x2e, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x54,
+	0x41, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4a, 0x56, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x4f, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x2e, 0x12, 0x33, 0x0a, 0x31, 0x4a, 0x2f, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x52, 0x54, 0x41, 0x20, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x20,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01,
+	0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x28, 0x00, 0x30, 0x00, 0x12, 0xf9, 0x02, 0x0a, 0x08, 0x52, 0x54, 0x41, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa5, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00, 0x92,
+	0x41, 0x83, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79, 0x1a, 0x16, 0x52, 0x65, 0x6d,
+	0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x59, 0x0a, 0x03, 0x32, 0x30, 0x30, 0x12, 0x52, 0x0a, 0x1e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64,
+	0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x30,
+	0x0a, 0x2e, 0x1a, 0x2c, 0x23, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x54, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4a, 0x54, 0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x4d, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75,
+	0x6e, 0x64, 0x2e, 0x12, 0x31, 0x0a, 0x2f, 0x4a, 0x2d, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65,
+	0x22, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x3a, 0x20, 0x22, 0x52, 0x54, 0x41, 0x20, 0x52, 0x65, 0x74, 0x72, 0x79, 0x20, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22,
+	0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x28, 0x00,
+	0x30, 0x00, 0x12, 0xb7, 0x03, 0x0a, 0x0f, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xce, 0x02, 0x88, 0x02, 0x00, 0x90, 0x02, 0x00,
+	0x92, 0x41, 0xa8, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x54, 0x6f, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74, 0x6f, 0x20, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x21, 0x52, 0x65, 0x6d, 0x69, 0x74, 0x20, 0x74,
+	0x6f, 0x20, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x4c, 0x69, 0x73, 0x74, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x4a, 0x60, 0x0a, 0x03,
+	0x32, 0x30, 0x30, 0x12, 0x59, 0x0a, 0x1e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x20, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x66,
+	0x75, 0x6c, 0x6c, 0x79, 0x2e, 0x12, 0x37, 0x0a, 0x35, 0x1a, 0x33, 0x23, 0x2f, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x54, 0x41, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4a, 0x5c,
+	0x0a, 0x03, 0x34, 0x30, 0x30, 0x12, 0x55, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x66, 0x6f, 0x75, 0x6e, 0x64,
+	0x2e, 0x12, 0x39, 0x0a, 0x37, 0x4a, 0x35, 0x7b, 0x20, 0x22, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x3a,
+	0x20, 0x34, 0x30, 0x30, 0x2c, 0x20, 0x22, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3a,
+	0x20, 0x22, 0x52, 0x54, 0x41, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x20, 0x4c,
+	0x69, 0x73, 0x74, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x20, 0x7d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x74, 0x61, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x6c, 0x69, 0x73, 0x74, 0x28, 0x00, 0x30, 0x00, 0x1a, 0x03, 0x88, 0x02,
+	0x00, 0x42, 0x54, 0x48, 0x01, 0x50, 0x00, 0x5a, 0x39, 0x62, 0x72, 0x61, 0x6e, 0x6b, 0x2e, 0x61,
+	0x73, 0x2f, 0x70, 0x65, 0x74, 0x6e, 0x65, 0x74, 0x2f, 0x67, 0x75, 0x6e, 0x6b, 0x2f, 0x64, 0x72,
+	0x70, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x3b, 0x72, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x6f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x80, 0x01, 0x00, 0x88, 0x01, 0x00, 0x90, 0x01, 0x00, 0xb8, 0x01, 0x00, 0xd8, 0x01,
+	0x00, 0xf8, 0x01, 0x01, 0xd0, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2129,39 +2828,51 @@ func file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDescGZIP() []b
 }
 
 var (
-	file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-	file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_goTypes  = []interface{}{
-		(*RTAInquireRequest)(nil),     // 0: remittoaccount.RTAInquireRequest
-		(*RTAInquireResponse)(nil),    // 1: remittoaccount.RTAInquireResponse
-		(*RTAInquireResult)(nil),      // 2: remittoaccount.RTAInquireResult
-		(*RTAPaymentRequest)(nil),     // 3: remittoaccount.RTAPaymentRequest
-		(*RTAPaymentResult)(nil),      // 4: remittoaccount.RTAPaymentResult
-		(*RTAPaymentResponse)(nil),    // 5: remittoaccount.RTAPaymentResponse
-		(*RTARetryRequest)(nil),       // 6: remittoaccount.RTARetryRequest
-		(*RTARetryResponse)(nil),      // 7: remittoaccount.RTARetryResponse
-		(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+	file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes  = make([]protoimpl.MessageInfo, 11)
+	file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_goTypes   = []interface{}{
+		(SortOrder)(0),                  // 0: remittoaccount.SortOrder
+		(SortByColumn)(0),               // 1: remittoaccount.SortByColumn
+		(*RTAInquireRequest)(nil),       // 2: remittoaccount.RTAInquireRequest
+		(*RTAInquireResponse)(nil),      // 3: remittoaccount.RTAInquireResponse
+		(*RTAInquireResult)(nil),        // 4: remittoaccount.RTAInquireResult
+		(*RTAPaymentRequest)(nil),       // 5: remittoaccount.RTAPaymentRequest
+		(*RTAPaymentResult)(nil),        // 6: remittoaccount.RTAPaymentResult
+		(*RTAPaymentResponse)(nil),      // 7: remittoaccount.RTAPaymentResponse
+		(*RTARetryRequest)(nil),         // 8: remittoaccount.RTARetryRequest
+		(*RTARetryResponse)(nil),        // 9: remittoaccount.RTARetryResponse
+		(*RTATransactListRequest)(nil),  // 10: remittoaccount.RTATransactListRequest
+		(*RTATransactListResponse)(nil), // 11: remittoaccount.RTATransactListResponse
+		(*RTATransact)(nil),             // 12: remittoaccount.RTATransact
+		(*timestamppb.Timestamp)(nil),   // 13: google.protobuf.Timestamp
 	}
 )
 
 var file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_depIdxs = []int32{
-	2,  // 0: remittoaccount.RTAInquireResponse.Result:type_name -> remittoaccount.RTAInquireResult
-	8,  // 1: remittoaccount.RTAInquireResult.CreatedAt:type_name -> google.protobuf.Timestamp
-	8,  // 2: remittoaccount.RTAInquireResult.UpdatedAt:type_name -> google.protobuf.Timestamp
-	8,  // 3: remittoaccount.RTAPaymentResult.Created:type_name -> google.protobuf.Timestamp
-	8,  // 4: remittoaccount.RTAPaymentResult.Updated:type_name -> google.protobuf.Timestamp
-	4,  // 5: remittoaccount.RTAPaymentResponse.Result:type_name -> remittoaccount.RTAPaymentResult
-	4,  // 6: remittoaccount.RTARetryResponse.Result:type_name -> remittoaccount.RTAPaymentResult
-	0,  // 7: remittoaccount.RemitToAccountService.RTAInquire:input_type -> remittoaccount.RTAInquireRequest
-	3,  // 8: remittoaccount.RemitToAccountService.RTAPayment:input_type -> remittoaccount.RTAPaymentRequest
-	6,  // 9: remittoaccount.RemitToAccountService.RTARetry:input_type -> remittoaccount.RTARetryRequest
-	1,  // 10: remittoaccount.RemitToAccountService.RTAInquire:output_type -> remittoaccount.RTAInquireResponse
-	5,  // 11: remittoaccount.RemitToAccountService.RTAPayment:output_type -> remittoaccount.RTAPaymentResponse
-	7,  // 12: remittoaccount.RemitToAccountService.RTARetry:output_type -> remittoaccount.RTARetryResponse
-	10, // [10:13] is the sub-list for method output_type
-	7,  // [7:10] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	4,  // 0: remittoaccount.RTAInquireResponse.Result:type_name -> remittoaccount.RTAInquireResult
+	13, // 1: remittoaccount.RTAInquireResult.CreatedAt:type_name -> google.protobuf.Timestamp
+	13, // 2: remittoaccount.RTAInquireResult.UpdatedAt:type_name -> google.protobuf.Timestamp
+	13, // 3: remittoaccount.RTAPaymentResult.Created:type_name -> google.protobuf.Timestamp
+	13, // 4: remittoaccount.RTAPaymentResult.Updated:type_name -> google.protobuf.Timestamp
+	6,  // 5: remittoaccount.RTAPaymentResponse.Result:type_name -> remittoaccount.RTAPaymentResult
+	6,  // 6: remittoaccount.RTARetryResponse.Result:type_name -> remittoaccount.RTAPaymentResult
+	0,  // 7: remittoaccount.RTATransactListRequest.SortOrder:type_name -> remittoaccount.SortOrder
+	1,  // 8: remittoaccount.RTATransactListRequest.SortByColumn:type_name -> remittoaccount.SortByColumn
+	12, // 9: remittoaccount.RTATransactListResponse.RTATransacts:type_name -> remittoaccount.RTATransact
+	13, // 10: remittoaccount.RTATransact.TransactionCompletedTime:type_name -> google.protobuf.Timestamp
+	2,  // 11: remittoaccount.RemitToAccountService.RTAInquire:input_type -> remittoaccount.RTAInquireRequest
+	5,  // 12: remittoaccount.RemitToAccountService.RTAPayment:input_type -> remittoaccount.RTAPaymentRequest
+	8,  // 13: remittoaccount.RemitToAccountService.RTARetry:input_type -> remittoaccount.RTARetryRequest
+	10, // 14: remittoaccount.RemitToAccountService.RTATransactList:input_type -> remittoaccount.RTATransactListRequest
+	3,  // 15: remittoaccount.RemitToAccountService.RTAInquire:output_type -> remittoaccount.RTAInquireResponse
+	7,  // 16: remittoaccount.RemitToAccountService.RTAPayment:output_type -> remittoaccount.RTAPaymentResponse
+	9,  // 17: remittoaccount.RemitToAccountService.RTARetry:output_type -> remittoaccount.RTARetryResponse
+	11, // 18: remittoaccount.RemitToAccountService.RTATransactList:output_type -> remittoaccount.RTATransactListResponse
+	15, // [15:19] is the sub-list for method output_type
+	11, // [11:15] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_init() }
@@ -2266,19 +2977,56 @@ func file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_init() {
 				return nil
 			}
 		}
+		file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RTATransactListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RTATransactListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RTATransact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_goTypes,
 		DependencyIndexes: file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_depIdxs,
+		EnumInfos:         file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_enumTypes,
 		MessageInfos:      file_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto_msgTypes,
 	}.Build()
 	File_brank_as_petnet_gunk_drp_v1_remittoaccount_all_proto = out.File
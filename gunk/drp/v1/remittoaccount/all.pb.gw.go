@@ -130,6 +130,38 @@ func local_request_RemitToAccountService_RTARetry_0(ctx context.Context, marshal
 	return msg, metadata, err
 }
 
+var filter_RemitToAccountService_RTATransactList_0 = &utilities.DoubleArray{Encoding: map[string]int{}, Base: []int(nil), Check: []int(nil)}
+
+func request_RemitToAccountService_RTATransactList_0(ctx context.Context, marshaler runtime.Marshaler, client RemitToAccountServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq RTATransactListRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_RemitToAccountService_RTATransactList_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.RTATransactList(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_RemitToAccountService_RTATransactList_0(ctx context.Context, marshaler runtime.Marshaler, server RemitToAccountServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq RTATransactListRequest
+	var metadata runtime.ServerMetadata
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_RemitToAccountService_RTATransactList_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.RTATransactList(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterRemitToAccountServiceHandlerServer registers the http handlers for service RemitToAccountService to "mux".
 // UnaryRPC     :call RemitToAccountServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -201,6 +233,28 @@ func RegisterRemitToAccountServiceHandlerServer(ctx context.Context, mux *runtim
 		forward_RemitToAccountService_RTARetry_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 
+	mux.Handle("GET", pattern_RemitToAccountService_RTATransactList_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/remittoaccount.RemitToAccountService/RTATransactList")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_RemitToAccountService_RTATransactList_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_RemitToAccountService_RTATransactList_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
 	return nil
 }
 
@@ -298,6 +352,25 @@ func RegisterRemitToAccountServiceHandlerClient(ctx context.Context, mux *runtim
 		forward_RemitToAccountService_RTARetry_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 
+	mux.Handle("GET", pattern_RemitToAccountService_RTATransactList_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/remittoaccount.RemitToAccountService/RTATransactList")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_RemitToAccountService_RTATransactList_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_RemitToAccountService_RTATransactList_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
 	return nil
 }
 
@@ -307,6 +380,8 @@ var (
 	pattern_RemitToAccountService_RTAPayment_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "rta", "payment"}, ""))
 
 	pattern_RemitToAccountService_RTARetry_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "rta", "retry"}, ""))
+
+	pattern_RemitToAccountService_RTATransactList_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "rta", "transactlist"}, ""))
 )
 
 var (
@@ -315,4 +390,6 @@ var (
 	forward_RemitToAccountService_RTAPayment_0 = runtime.ForwardResponseMessage
 
 	forward_RemitToAccountService_RTARetry_0 = runtime.ForwardResponseMessage
+
+	forward_RemitToAccountService_RTATransactList_0 = runtime.ForwardResponseMessage
 )
@@ -25,6 +25,8 @@ type RemitToAccountServiceClient interface {
 	RTAPayment(ctx context.Context, in *RTAPaymentRequest, opts ...grpc.CallOption) (*RTAPaymentResponse, error)
 	// RTA Retry.
 	RTARetry(ctx context.Context, in *RTARetryRequest, opts ...grpc.CallOption) (*RTARetryResponse, error)
+	// RTA Transact List.
+	RTATransactList(ctx context.Context, in *RTATransactListRequest, opts ...grpc.CallOption) (*RTATransactListResponse, error)
 }
 
 type remitToAccountServiceClient struct {
@@ -62,6 +64,15 @@ func (c *remitToAccountServiceClient) RTARetry(ctx context.Context, in *RTARetry
 	return out, nil
 }
 
+func (c *remitToAccountServiceClient) RTATransactList(ctx context.Context, in *RTATransactListRequest, opts ...grpc.CallOption) (*RTATransactListResponse, error) {
+	out := new(RTATransactListResponse)
+	err := c.cc.Invoke(ctx, "/remittoaccount.RemitToAccountService/RTATransactList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RemitToAccountServiceServer is the server API for RemitToAccountService service.
 // All implementations must embed UnimplementedRemitToAccountServiceServer
 // for forward compatibility
@@ -72,6 +83,8 @@ type RemitToAccountServiceServer interface {
 	RTAPayment(context.Context, *RTAPaymentRequest) (*RTAPaymentResponse, error)
 	// RTA Retry.
 	RTARetry(context.Context, *RTARetryRequest) (*RTARetryResponse, error)
+	// RTA Transact List.
+	RTATransactList(context.Context, *RTATransactListRequest) (*RTATransactListResponse, error)
 	mustEmbedUnimplementedRemitToAccountServiceServer()
 }
 
@@ -89,6 +102,10 @@ func (UnimplementedRemitToAccountServiceServer) RTAPayment(context.Context, *RTA
 func (UnimplementedRemitToAccountServiceServer) RTARetry(context.Context, *RTARetryRequest) (*RTARetryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RTARetry not implemented")
 }
+
+func (UnimplementedRemitToAccountServiceServer) RTATransactList(context.Context, *RTATransactListRequest) (*RTATransactListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RTATransactList not implemented")
+}
 func (UnimplementedRemitToAccountServiceServer) mustEmbedUnimplementedRemitToAccountServiceServer() {}
 
 // UnsafeRemitToAccountServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -156,6 +173,24 @@ func _RemitToAccountService_RTARetry_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RemitToAccountService_RTATransactList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RTATransactListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemitToAccountServiceServer).RTATransactList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/remittoaccount.RemitToAccountService/RTATransactList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemitToAccountServiceServer).RTATransactList(ctx, req.(*RTATransactListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RemitToAccountService_ServiceDesc is the grpc.ServiceDesc for RemitToAccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -175,6 +210,10 @@ var RemitToAccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RTARetry",
 			Handler:    _RemitToAccountService_RTARetry_Handler,
 		},
+		{
+			MethodName: "RTATransactList",
+			Handler:    _RemitToAccountService_RTATransactList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/remittoaccount/all.proto",
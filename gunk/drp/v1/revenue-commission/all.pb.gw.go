@@ -973,6 +973,92 @@ func local_request_RevenueCommissionService_SendTransactionReport_0(ctx context.
 	return msg, metadata, err
 }
 
+var filter_RevenueCommissionService_GenerateCommissionStatement_0 = &utilities.DoubleArray{Encoding: map[string]int{"OrgID": 0, "YearMonth": 1}, Base: []int{1, 1, 2, 0, 0}, Check: []int{0, 1, 1, 2, 3}}
+
+func request_RevenueCommissionService_GenerateCommissionStatement_0(ctx context.Context, marshaler runtime.Marshaler, client RevenueCommissionServiceClient, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GenerateCommissionStatementRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["OrgID"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "OrgID")
+	}
+
+	protoReq.OrgID, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "OrgID", err)
+	}
+
+	val, ok = pathParams["YearMonth"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "YearMonth")
+	}
+
+	protoReq.YearMonth, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "YearMonth", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_RevenueCommissionService_GenerateCommissionStatement_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := client.GenerateCommissionStatement(ctx, &protoReq, grpc.Header(&metadata.HeaderMD), grpc.Trailer(&metadata.TrailerMD))
+	return msg, metadata, err
+}
+
+func local_request_RevenueCommissionService_GenerateCommissionStatement_0(ctx context.Context, marshaler runtime.Marshaler, server RevenueCommissionServiceServer, req *http.Request, pathParams map[string]string) (proto.Message, runtime.ServerMetadata, error) {
+	var protoReq GenerateCommissionStatementRequest
+	var metadata runtime.ServerMetadata
+
+	var (
+		val string
+		ok  bool
+		err error
+		_   = err
+	)
+
+	val, ok = pathParams["OrgID"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "OrgID")
+	}
+
+	protoReq.OrgID, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "OrgID", err)
+	}
+
+	val, ok = pathParams["YearMonth"]
+	if !ok {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "missing parameter %s", "YearMonth")
+	}
+
+	protoReq.YearMonth, err = runtime.String(val)
+	if err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "type mismatch, parameter: %s, error: %v", "YearMonth", err)
+	}
+
+	if err := req.ParseForm(); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if err := runtime.PopulateQueryParameters(&protoReq, req.Form, filter_RevenueCommissionService_GenerateCommissionStatement_0); err != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	msg, err := server.GenerateCommissionStatement(ctx, &protoReq)
+	return msg, metadata, err
+}
+
 // RegisterRevenueCommissionServiceHandlerServer registers the http handlers for service RevenueCommissionService to "mux".
 // UnaryRPC     :call RevenueCommissionServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -1462,6 +1548,28 @@ func RegisterRevenueCommissionServiceHandlerServer(ctx context.Context, mux *run
 		forward_RevenueCommissionService_SendTransactionReport_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 
+	mux.Handle("GET", pattern_RevenueCommissionService_GenerateCommissionStatement_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		var stream runtime.ServerTransportStream
+		ctx = grpc.NewContextWithServerTransportStream(ctx, &stream)
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateIncomingContext(ctx, mux, req, "/revenue_commission.RevenueCommissionService/GenerateCommissionStatement")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := local_request_RevenueCommissionService_GenerateCommissionStatement_0(rctx, inboundMarshaler, server, req, pathParams)
+		md.HeaderMD, md.TrailerMD = metadata.Join(md.HeaderMD, stream.Header()), metadata.Join(md.TrailerMD, stream.Trailer())
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_RevenueCommissionService_GenerateCommissionStatement_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
 	return nil
 }
 
@@ -1920,6 +2028,25 @@ func RegisterRevenueCommissionServiceHandlerClient(ctx context.Context, mux *run
 		forward_RevenueCommissionService_SendTransactionReport_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
 	})
 
+	mux.Handle("GET", pattern_RevenueCommissionService_GenerateCommissionStatement_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/revenue_commission.RevenueCommissionService/GenerateCommissionStatement")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_RevenueCommissionService_GenerateCommissionStatement_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_RevenueCommissionService_GenerateCommissionStatement_0(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+	})
+
 	return nil
 }
 
@@ -1967,6 +2094,8 @@ var (
 	pattern_RevenueCommissionService_ListDSATransactionCountByYearMonth_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 2, 3, 1, 0, 4, 1, 5, 4}, []string{"v1", "revenue-commission", "dsa-trx-count", "year-month", "YearMonth"}, ""))
 
 	pattern_RevenueCommissionService_SendTransactionReport_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"v1", "send-transaction-report"}, ""))
+
+	pattern_RevenueCommissionService_GenerateCommissionStatement_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2, 1, 0, 4, 1, 5, 3, 1, 0, 4, 1, 5, 4}, []string{"v1", "revenue-commission", "commission-statement", "OrgID", "YearMonth"}, ""))
 )
 
 var (
@@ -2013,4 +2142,6 @@ var (
 	forward_RevenueCommissionService_ListDSATransactionCountByYearMonth_0 = runtime.ForwardResponseMessage
 
 	forward_RevenueCommissionService_SendTransactionReport_0 = runtime.ForwardResponseMessage
+
+	forward_RevenueCommissionService_GenerateCommissionStatement_0 = runtime.ForwardResponseMessage
 )
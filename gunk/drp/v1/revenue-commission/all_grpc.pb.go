@@ -64,6 +64,8 @@ type RevenueCommissionServiceClient interface {
 	ListDSATransactionCountByYearMonth(ctx context.Context, in *ListDSATransactionCountByYearMonthRequest, opts ...grpc.CallOption) (*ListDSATransactionCountResponse, error)
 	// Send Transaction Report
 	SendTransactionReport(ctx context.Context, in *SendTransactionReportRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GenerateCommissionStatement Generates the commission statement of a DSA for a year and month.
+	GenerateCommissionStatement(ctx context.Context, in *GenerateCommissionStatementRequest, opts ...grpc.CallOption) (*GenerateCommissionStatementResponse, error)
 }
 
 type revenueCommissionServiceClient struct {
@@ -272,6 +274,15 @@ func (c *revenueCommissionServiceClient) SendTransactionReport(ctx context.Conte
 	return out, nil
 }
 
+func (c *revenueCommissionServiceClient) GenerateCommissionStatement(ctx context.Context, in *GenerateCommissionStatementRequest, opts ...grpc.CallOption) (*GenerateCommissionStatementResponse, error) {
+	out := new(GenerateCommissionStatementResponse)
+	err := c.cc.Invoke(ctx, "/revenue_commission.RevenueCommissionService/GenerateCommissionStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RevenueCommissionServiceServer is the server API for RevenueCommissionService service.
 // All implementations must embed UnimplementedRevenueCommissionServiceServer
 // for forward compatibility
@@ -320,6 +331,8 @@ type RevenueCommissionServiceServer interface {
 	ListDSATransactionCountByYearMonth(context.Context, *ListDSATransactionCountByYearMonthRequest) (*ListDSATransactionCountResponse, error)
 	// Send Transaction Report
 	SendTransactionReport(context.Context, *SendTransactionReportRequest) (*emptypb.Empty, error)
+	// GenerateCommissionStatement Generates the commission statement of a DSA for a year and month.
+	GenerateCommissionStatement(context.Context, *GenerateCommissionStatementRequest) (*GenerateCommissionStatementResponse, error)
 	mustEmbedUnimplementedRevenueCommissionServiceServer()
 }
 
@@ -414,6 +427,10 @@ func (UnimplementedRevenueCommissionServiceServer) SendTransactionReport(context
 	return nil, status.Errorf(codes.Unimplemented, "method SendTransactionReport not implemented")
 }
 
+func (UnimplementedRevenueCommissionServiceServer) GenerateCommissionStatement(context.Context, *GenerateCommissionStatementRequest) (*GenerateCommissionStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateCommissionStatement not implemented")
+}
+
 func (UnimplementedRevenueCommissionServiceServer) mustEmbedUnimplementedRevenueCommissionServiceServer() {
 }
 
@@ -824,6 +841,24 @@ func _RevenueCommissionService_SendTransactionReport_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RevenueCommissionService_GenerateCommissionStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateCommissionStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RevenueCommissionServiceServer).GenerateCommissionStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/revenue_commission.RevenueCommissionService/GenerateCommissionStatement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RevenueCommissionServiceServer).GenerateCommissionStatement(ctx, req.(*GenerateCommissionStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RevenueCommissionService_ServiceDesc is the grpc.ServiceDesc for RevenueCommissionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -919,6 +954,10 @@ var RevenueCommissionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendTransactionReport",
 			Handler:    _RevenueCommissionService_SendTransactionReport_Handler,
 		},
+		{
+			MethodName: "GenerateCommissionStatement",
+			Handler:    _RevenueCommissionService_GenerateCommissionStatement_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/revenue-commission/all.proto",
@@ -21,6 +21,16 @@ const _ = grpc.SupportPackageIsVersion7
 type TellerServiceClient interface {
 	// Register a remittance teller to the perahub conex platform.
 	RegisterTeller(ctx context.Context, in *RegisterTellerRequest, opts ...grpc.CallOption) (*RegisterTellerResponse, error)
+	// Get a teller of the DSA.
+	GetTeller(ctx context.Context, in *GetTellerRequest, opts ...grpc.CallOption) (*GetTellerResponse, error)
+	// List the tellers of the DSA.
+	ListTellers(ctx context.Context, in *ListTellersRequest, opts ...grpc.CallOption) (*ListTellersResponse, error)
+	// Enable a disabled teller.
+	EnableTeller(ctx context.Context, in *EnableTellerRequest, opts ...grpc.CallOption) (*EnableTellerResponse, error)
+	// Disable a teller.
+	DisableTeller(ctx context.Context, in *DisableTellerRequest, opts ...grpc.CallOption) (*DisableTellerResponse, error)
+	// Assign a teller to a branch.
+	AssignTellerBranch(ctx context.Context, in *AssignTellerBranchRequest, opts ...grpc.CallOption) (*AssignTellerBranchResponse, error)
 }
 
 type tellerServiceClient struct {
@@ -40,12 +50,67 @@ func (c *tellerServiceClient) RegisterTeller(ctx context.Context, in *RegisterTe
 	return out, nil
 }
 
+func (c *tellerServiceClient) GetTeller(ctx context.Context, in *GetTellerRequest, opts ...grpc.CallOption) (*GetTellerResponse, error) {
+	out := new(GetTellerResponse)
+	err := c.cc.Invoke(ctx, "/teller.TellerService/GetTeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) ListTellers(ctx context.Context, in *ListTellersRequest, opts ...grpc.CallOption) (*ListTellersResponse, error) {
+	out := new(ListTellersResponse)
+	err := c.cc.Invoke(ctx, "/teller.TellerService/ListTellers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) EnableTeller(ctx context.Context, in *EnableTellerRequest, opts ...grpc.CallOption) (*EnableTellerResponse, error) {
+	out := new(EnableTellerResponse)
+	err := c.cc.Invoke(ctx, "/teller.TellerService/EnableTeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) DisableTeller(ctx context.Context, in *DisableTellerRequest, opts ...grpc.CallOption) (*DisableTellerResponse, error) {
+	out := new(DisableTellerResponse)
+	err := c.cc.Invoke(ctx, "/teller.TellerService/DisableTeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) AssignTellerBranch(ctx context.Context, in *AssignTellerBranchRequest, opts ...grpc.CallOption) (*AssignTellerBranchResponse, error) {
+	out := new(AssignTellerBranchResponse)
+	err := c.cc.Invoke(ctx, "/teller.TellerService/AssignTellerBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TellerServiceServer is the server API for TellerService service.
 // All implementations must embed UnimplementedTellerServiceServer
 // for forward compatibility
 type TellerServiceServer interface {
 	// Register a remittance teller to the perahub conex platform.
 	RegisterTeller(context.Context, *RegisterTellerRequest) (*RegisterTellerResponse, error)
+	// Get a teller of the DSA.
+	GetTeller(context.Context, *GetTellerRequest) (*GetTellerResponse, error)
+	// List the tellers of the DSA.
+	ListTellers(context.Context, *ListTellersRequest) (*ListTellersResponse, error)
+	// Enable a disabled teller.
+	EnableTeller(context.Context, *EnableTellerRequest) (*EnableTellerResponse, error)
+	// Disable a teller.
+	DisableTeller(context.Context, *DisableTellerRequest) (*DisableTellerResponse, error)
+	// Assign a teller to a branch.
+	AssignTellerBranch(context.Context, *AssignTellerBranchRequest) (*AssignTellerBranchResponse, error)
 	mustEmbedUnimplementedTellerServiceServer()
 }
 
@@ -55,6 +120,26 @@ type UnimplementedTellerServiceServer struct{}
 func (UnimplementedTellerServiceServer) RegisterTeller(context.Context, *RegisterTellerRequest) (*RegisterTellerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterTeller not implemented")
 }
+
+func (UnimplementedTellerServiceServer) GetTeller(context.Context, *GetTellerRequest) (*GetTellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeller not implemented")
+}
+
+func (UnimplementedTellerServiceServer) ListTellers(context.Context, *ListTellersRequest) (*ListTellersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTellers not implemented")
+}
+
+func (UnimplementedTellerServiceServer) EnableTeller(context.Context, *EnableTellerRequest) (*EnableTellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableTeller not implemented")
+}
+
+func (UnimplementedTellerServiceServer) DisableTeller(context.Context, *DisableTellerRequest) (*DisableTellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTeller not implemented")
+}
+
+func (UnimplementedTellerServiceServer) AssignTellerBranch(context.Context, *AssignTellerBranchRequest) (*AssignTellerBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTellerBranch not implemented")
+}
 func (UnimplementedTellerServiceServer) mustEmbedUnimplementedTellerServiceServer() {}
 
 // UnsafeTellerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -86,6 +171,96 @@ func _TellerService_RegisterTeller_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TellerService_GetTeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).GetTeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teller.TellerService/GetTeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).GetTeller(ctx, req.(*GetTellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_ListTellers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTellersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).ListTellers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teller.TellerService/ListTellers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).ListTellers(ctx, req.(*ListTellersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_EnableTeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableTellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).EnableTeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teller.TellerService/EnableTeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).EnableTeller(ctx, req.(*EnableTellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_DisableTeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).DisableTeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teller.TellerService/DisableTeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).DisableTeller(ctx, req.(*DisableTellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_AssignTellerBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTellerBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).AssignTellerBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teller.TellerService/AssignTellerBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).AssignTellerBranch(ctx, req.(*AssignTellerBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TellerService_ServiceDesc is the grpc.ServiceDesc for TellerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +272,26 @@ var TellerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterTeller",
 			Handler:    _TellerService_RegisterTeller_Handler,
 		},
+		{
+			MethodName: "GetTeller",
+			Handler:    _TellerService_GetTeller_Handler,
+		},
+		{
+			MethodName: "ListTellers",
+			Handler:    _TellerService_ListTellers_Handler,
+		},
+		{
+			MethodName: "EnableTeller",
+			Handler:    _TellerService_EnableTeller_Handler,
+		},
+		{
+			MethodName: "DisableTeller",
+			Handler:    _TellerService_DisableTeller_Handler,
+		},
+		{
+			MethodName: "AssignTellerBranch",
+			Handler:    _TellerService_AssignTellerBranch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/drp/v1/teller/all.proto",
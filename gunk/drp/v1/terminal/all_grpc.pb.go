@@ -23,10 +23,14 @@ type TerminalServiceClient interface {
 	CreateRemit(ctx context.Context, in *CreateRemitRequest, opts ...grpc.CallOption) (*CreateRemitResponse, error)
 	// Confirm and process Remittance transaction.
 	ConfirmRemit(ctx context.Context, in *ConfirmRemitRequest, opts ...grpc.CallOption) (*ConfirmRemitResponse, error)
+	// Send the OTP required to confirm a Remittance transaction.
+	RequestRemitOTP(ctx context.Context, in *RequestRemitOTPRequest, opts ...grpc.CallOption) (*RequestRemitOTPResponse, error)
 	// Get user profile by ID.
 	ListRemit(ctx context.Context, in *ListRemitRequest, opts ...grpc.CallOption) (*ListRemitResponse, error)
 	// Search remittance.
 	LookupRemit(ctx context.Context, in *LookupRemitRequest, opts ...grpc.CallOption) (*LookupRemitResponse, error)
+	// Remittance payout status.
+	RemitStatus(ctx context.Context, in *RemitStatusRequest, opts ...grpc.CallOption) (*RemitStatusResponse, error)
 	// Disburse remittance.
 	DisburseRemit(ctx context.Context, in *DisburseRemitRequest, opts ...grpc.CallOption) (*DisburseRemitResponse, error)
 	// Search partner by transaction id.
@@ -59,6 +63,15 @@ func (c *terminalServiceClient) ConfirmRemit(ctx context.Context, in *ConfirmRem
 	return out, nil
 }
 
+func (c *terminalServiceClient) RequestRemitOTP(ctx context.Context, in *RequestRemitOTPRequest, opts ...grpc.CallOption) (*RequestRemitOTPResponse, error) {
+	out := new(RequestRemitOTPResponse)
+	err := c.cc.Invoke(ctx, "/terminal.TerminalService/RequestRemitOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *terminalServiceClient) ListRemit(ctx context.Context, in *ListRemitRequest, opts ...grpc.CallOption) (*ListRemitResponse, error) {
 	out := new(ListRemitResponse)
 	err := c.cc.Invoke(ctx, "/terminal.TerminalService/ListRemit", in, out, opts...)
@@ -77,6 +90,15 @@ func (c *terminalServiceClient) LookupRemit(ctx context.Context, in *LookupRemit
 	return out, nil
 }
 
+func (c *terminalServiceClient) RemitStatus(ctx context.Context, in *RemitStatusRequest, opts ...grpc.CallOption) (*RemitStatusResponse, error) {
+	out := new(RemitStatusResponse)
+	err := c.cc.Invoke(ctx, "/terminal.TerminalService/RemitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *terminalServiceClient) DisburseRemit(ctx context.Context, in *DisburseRemitRequest, opts ...grpc.CallOption) (*DisburseRemitResponse, error) {
 	out := new(DisburseRemitResponse)
 	err := c.cc.Invoke(ctx, "/terminal.TerminalService/DisburseRemit", in, out, opts...)
@@ -103,10 +125,14 @@ type TerminalServiceServer interface {
 	CreateRemit(context.Context, *CreateRemitRequest) (*CreateRemitResponse, error)
 	// Confirm and process Remittance transaction.
 	ConfirmRemit(context.Context, *ConfirmRemitRequest) (*ConfirmRemitResponse, error)
+	// Send the OTP required to confirm a Remittance transaction.
+	RequestRemitOTP(context.Context, *RequestRemitOTPRequest) (*RequestRemitOTPResponse, error)
 	// Get user profile by ID.
 	ListRemit(context.Context, *ListRemitRequest) (*ListRemitResponse, error)
 	// Search remittance.
 	LookupRemit(context.Context, *LookupRemitRequest) (*LookupRemitResponse, error)
+	// Remittance payout status.
+	RemitStatus(context.Context, *RemitStatusRequest) (*RemitStatusResponse, error)
 	// Disburse remittance.
 	DisburseRemit(context.Context, *DisburseRemitRequest) (*DisburseRemitResponse, error)
 	// Search partner by transaction id.
@@ -125,6 +151,10 @@ func (UnimplementedTerminalServiceServer) ConfirmRemit(context.Context, *Confirm
 	return nil, status.Errorf(codes.Unimplemented, "method ConfirmRemit not implemented")
 }
 
+func (UnimplementedTerminalServiceServer) RequestRemitOTP(context.Context, *RequestRemitOTPRequest) (*RequestRemitOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRemitOTP not implemented")
+}
+
 func (UnimplementedTerminalServiceServer) ListRemit(context.Context, *ListRemitRequest) (*ListRemitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRemit not implemented")
 }
@@ -133,6 +163,10 @@ func (UnimplementedTerminalServiceServer) LookupRemit(context.Context, *LookupRe
 	return nil, status.Errorf(codes.Unimplemented, "method LookupRemit not implemented")
 }
 
+func (UnimplementedTerminalServiceServer) RemitStatus(context.Context, *RemitStatusRequest) (*RemitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemitStatus not implemented")
+}
+
 func (UnimplementedTerminalServiceServer) DisburseRemit(context.Context, *DisburseRemitRequest) (*DisburseRemitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DisburseRemit not implemented")
 }
@@ -189,6 +223,24 @@ func _TerminalService_ConfirmRemit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TerminalService_RequestRemitOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRemitOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).RequestRemitOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terminal.TerminalService/RequestRemitOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).RequestRemitOTP(ctx, req.(*RequestRemitOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TerminalService_ListRemit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRemitRequest)
 	if err := dec(in); err != nil {
@@ -225,6 +277,24 @@ func _TerminalService_LookupRemit_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TerminalService_RemitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TerminalServiceServer).RemitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terminal.TerminalService/RemitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TerminalServiceServer).RemitStatus(ctx, req.(*RemitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TerminalService_DisburseRemit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DisburseRemitRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +346,10 @@ var TerminalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfirmRemit",
 			Handler:    _TerminalService_ConfirmRemit_Handler,
 		},
+		{
+			MethodName: "RequestRemitOTP",
+			Handler:    _TerminalService_RequestRemitOTP_Handler,
+		},
 		{
 			MethodName: "ListRemit",
 			Handler:    _TerminalService_ListRemit_Handler,
@@ -284,6 +358,10 @@ var TerminalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupRemit",
 			Handler:    _TerminalService_LookupRemit_Handler,
 		},
+		{
+			MethodName: "RemitStatus",
+			Handler:    _TerminalService_RemitStatus_Handler,
+		},
 		{
 			MethodName: "DisburseRemit",
 			Handler:    _TerminalService_DisburseRemit_Handler,
@@ -23,6 +23,8 @@ type EmailServiceClient interface {
 	SendOnboardingReminder(ctx context.Context, in *SendOnboardingReminderRequest, opts ...grpc.CallOption) (*SendOnboardingReminderResponse, error)
 	// Send service request status reminder email.
 	SendDsaServiceRequestNotification(ctx context.Context, in *SendDsaServiceRequestNotificationRequest, opts ...grpc.CallOption) (*SendDsaServiceRequestNotificationResponse, error)
+	// Send low biller wallet balance alert email.
+	SendBillerBalanceAlert(ctx context.Context, in *SendBillerBalanceAlertRequest, opts ...grpc.CallOption) (*SendBillerBalanceAlertResponse, error)
 }
 
 type emailServiceClient struct {
@@ -51,6 +53,15 @@ func (c *emailServiceClient) SendDsaServiceRequestNotification(ctx context.Conte
 	return out, nil
 }
 
+func (c *emailServiceClient) SendBillerBalanceAlert(ctx context.Context, in *SendBillerBalanceAlertRequest, opts ...grpc.CallOption) (*SendBillerBalanceAlertResponse, error) {
+	out := new(SendBillerBalanceAlertResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v1.email.EmailService/SendBillerBalanceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmailServiceServer is the server API for EmailService service.
 // All implementations must embed UnimplementedEmailServiceServer
 // for forward compatibility
@@ -59,6 +70,8 @@ type EmailServiceServer interface {
 	SendOnboardingReminder(context.Context, *SendOnboardingReminderRequest) (*SendOnboardingReminderResponse, error)
 	// Send service request status reminder email.
 	SendDsaServiceRequestNotification(context.Context, *SendDsaServiceRequestNotificationRequest) (*SendDsaServiceRequestNotificationResponse, error)
+	// Send low biller wallet balance alert email.
+	SendBillerBalanceAlert(context.Context, *SendBillerBalanceAlertRequest) (*SendBillerBalanceAlertResponse, error)
 	mustEmbedUnimplementedEmailServiceServer()
 }
 
@@ -72,6 +85,10 @@ func (UnimplementedEmailServiceServer) SendOnboardingReminder(context.Context, *
 func (UnimplementedEmailServiceServer) SendDsaServiceRequestNotification(context.Context, *SendDsaServiceRequestNotificationRequest) (*SendDsaServiceRequestNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendDsaServiceRequestNotification not implemented")
 }
+
+func (UnimplementedEmailServiceServer) SendBillerBalanceAlert(context.Context, *SendBillerBalanceAlertRequest) (*SendBillerBalanceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendBillerBalanceAlert not implemented")
+}
 func (UnimplementedEmailServiceServer) mustEmbedUnimplementedEmailServiceServer() {}
 
 // UnsafeEmailServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -121,6 +138,24 @@ func _EmailService_SendDsaServiceRequestNotification_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmailService_SendBillerBalanceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendBillerBalanceAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmailServiceServer).SendBillerBalanceAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v1.email.EmailService/SendBillerBalanceAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmailServiceServer).SendBillerBalanceAlert(ctx, req.(*SendBillerBalanceAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EmailService_ServiceDesc is the grpc.ServiceDesc for EmailService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -136,6 +171,10 @@ var EmailService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendDsaServiceRequestNotification",
 			Handler:    _EmailService_SendDsaServiceRequestNotification_Handler,
 		},
+		{
+			MethodName: "SendBillerBalanceAlert",
+			Handler:    _EmailService_SendBillerBalanceAlert_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/dsa/v1/email/all.proto",
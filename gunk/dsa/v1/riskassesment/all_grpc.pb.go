@@ -27,6 +27,10 @@ type RiskAssesmentServiceClient interface {
 	ListQuestion(ctx context.Context, in *ListQuestionRequest, opts ...grpc.CallOption) (*ListQuestionResponse, error)
 	// List  riskassesment.
 	ListMlTfQuestion(ctx context.Context, in *ListQuestionRequest, opts ...grpc.CallOption) (*ListQuestionResponse, error)
+	// Get risk score.
+	GetRiskScore(ctx context.Context, in *GetRiskScoreRequest, opts ...grpc.CallOption) (*GetRiskScoreResponse, error)
+	// List risk scores.
+	ListRiskScores(ctx context.Context, in *ListRiskScoresRequest, opts ...grpc.CallOption) (*ListRiskScoresResponse, error)
 }
 
 type riskAssesmentServiceClient struct {
@@ -73,6 +77,24 @@ func (c *riskAssesmentServiceClient) ListMlTfQuestion(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *riskAssesmentServiceClient) GetRiskScore(ctx context.Context, in *GetRiskScoreRequest, opts ...grpc.CallOption) (*GetRiskScoreResponse, error) {
+	out := new(GetRiskScoreResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v1.riskassesment.RiskAssesmentService/GetRiskScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *riskAssesmentServiceClient) ListRiskScores(ctx context.Context, in *ListRiskScoresRequest, opts ...grpc.CallOption) (*ListRiskScoresResponse, error) {
+	out := new(ListRiskScoresResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v1.riskassesment.RiskAssesmentService/ListRiskScores", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RiskAssesmentServiceServer is the server API for RiskAssesmentService service.
 // All implementations must embed UnimplementedRiskAssesmentServiceServer
 // for forward compatibility
@@ -85,6 +107,10 @@ type RiskAssesmentServiceServer interface {
 	ListQuestion(context.Context, *ListQuestionRequest) (*ListQuestionResponse, error)
 	// List  riskassesment.
 	ListMlTfQuestion(context.Context, *ListQuestionRequest) (*ListQuestionResponse, error)
+	// Get risk score.
+	GetRiskScore(context.Context, *GetRiskScoreRequest) (*GetRiskScoreResponse, error)
+	// List risk scores.
+	ListRiskScores(context.Context, *ListRiskScoresRequest) (*ListRiskScoresResponse, error)
 	mustEmbedUnimplementedRiskAssesmentServiceServer()
 }
 
@@ -106,6 +132,14 @@ func (UnimplementedRiskAssesmentServiceServer) ListQuestion(context.Context, *Li
 func (UnimplementedRiskAssesmentServiceServer) ListMlTfQuestion(context.Context, *ListQuestionRequest) (*ListQuestionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMlTfQuestion not implemented")
 }
+
+func (UnimplementedRiskAssesmentServiceServer) GetRiskScore(context.Context, *GetRiskScoreRequest) (*GetRiskScoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRiskScore not implemented")
+}
+
+func (UnimplementedRiskAssesmentServiceServer) ListRiskScores(context.Context, *ListRiskScoresRequest) (*ListRiskScoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiskScores not implemented")
+}
 func (UnimplementedRiskAssesmentServiceServer) mustEmbedUnimplementedRiskAssesmentServiceServer() {}
 
 // UnsafeRiskAssesmentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -191,6 +225,42 @@ func _RiskAssesmentService_ListMlTfQuestion_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RiskAssesmentService_GetRiskScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRiskScoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskAssesmentServiceServer).GetRiskScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v1.riskassesment.RiskAssesmentService/GetRiskScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskAssesmentServiceServer).GetRiskScore(ctx, req.(*GetRiskScoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RiskAssesmentService_ListRiskScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskAssesmentServiceServer).ListRiskScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v1.riskassesment.RiskAssesmentService/ListRiskScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskAssesmentServiceServer).ListRiskScores(ctx, req.(*ListRiskScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RiskAssesmentService_ServiceDesc is the grpc.ServiceDesc for RiskAssesmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +284,14 @@ var RiskAssesmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMlTfQuestion",
 			Handler:    _RiskAssesmentService_ListMlTfQuestion_Handler,
 		},
+		{
+			MethodName: "GetRiskScore",
+			Handler:    _RiskAssesmentService_GetRiskScore_Handler,
+		},
+		{
+			MethodName: "ListRiskScores",
+			Handler:    _RiskAssesmentService_ListRiskScores_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "brank.as/petnet/gunk/dsa/v1/riskassesment/all.proto",
@@ -21,6 +21,8 @@ const _ = grpc.SupportPackageIsVersion7
 type BranchServiceClient interface {
 	// Upsert branch.
 	UpsertBranch(ctx context.Context, in *UpsertBranchRequest, opts ...grpc.CallOption) (*UpsertBranchResponse, error)
+	// Get branch.
+	GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*GetBranchResponse, error)
 	// List branches.
 	ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error)
 }
@@ -42,6 +44,15 @@ func (c *branchServiceClient) UpsertBranch(ctx context.Context, in *UpsertBranch
 	return out, nil
 }
 
+func (c *branchServiceClient) GetBranch(ctx context.Context, in *GetBranchRequest, opts ...grpc.CallOption) (*GetBranchResponse, error) {
+	out := new(GetBranchResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.branch.BranchService/GetBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *branchServiceClient) ListBranches(ctx context.Context, in *ListBranchesRequest, opts ...grpc.CallOption) (*ListBranchesResponse, error) {
 	out := new(ListBranchesResponse)
 	err := c.cc.Invoke(ctx, "/petnet.v2.branch.BranchService/ListBranches", in, out, opts...)
@@ -57,6 +68,8 @@ func (c *branchServiceClient) ListBranches(ctx context.Context, in *ListBranches
 type BranchServiceServer interface {
 	// Upsert branch.
 	UpsertBranch(context.Context, *UpsertBranchRequest) (*UpsertBranchResponse, error)
+	// Get branch.
+	GetBranch(context.Context, *GetBranchRequest) (*GetBranchResponse, error)
 	// List branches.
 	ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error)
 	mustEmbedUnimplementedBranchServiceServer()
@@ -69,6 +82,10 @@ func (UnimplementedBranchServiceServer) UpsertBranch(context.Context, *UpsertBra
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertBranch not implemented")
 }
 
+func (UnimplementedBranchServiceServer) GetBranch(context.Context, *GetBranchRequest) (*GetBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranch not implemented")
+}
+
 func (UnimplementedBranchServiceServer) ListBranches(context.Context, *ListBranchesRequest) (*ListBranchesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBranches not implemented")
 }
@@ -103,6 +120,24 @@ func _BranchService_UpsertBranch_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BranchService_GetBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).GetBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.branch.BranchService/GetBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).GetBranch(ctx, req.(*GetBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BranchService_ListBranches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListBranchesRequest)
 	if err := dec(in); err != nil {
@@ -132,6 +167,10 @@ var BranchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertBranch",
 			Handler:    _BranchService_UpsertBranch_Handler,
 		},
+		{
+			MethodName: "GetBranch",
+			Handler:    _BranchService_GetBranch_Handler,
+		},
 		{
 			MethodName: "ListBranches",
 			Handler:    _BranchService_ListBranches_Handler,
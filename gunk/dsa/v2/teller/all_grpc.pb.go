@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package teller
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TellerServiceClient is the client API for TellerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TellerServiceClient interface {
+	// Create teller.
+	CreateTeller(ctx context.Context, in *CreateTellerRequest, opts ...grpc.CallOption) (*CreateTellerResponse, error)
+	// Register teller.
+	RegisterTeller(ctx context.Context, in *RegisterTellerRequest, opts ...grpc.CallOption) (*RegisterTellerResponse, error)
+	// Get teller.
+	GetTeller(ctx context.Context, in *GetTellerRequest, opts ...grpc.CallOption) (*GetTellerResponse, error)
+	// List tellers.
+	ListTellers(ctx context.Context, in *ListTellersRequest, opts ...grpc.CallOption) (*ListTellersResponse, error)
+	// Set teller status.
+	SetTellerStatus(ctx context.Context, in *SetTellerStatusRequest, opts ...grpc.CallOption) (*SetTellerStatusResponse, error)
+	// Assign teller branch.
+	AssignTellerBranch(ctx context.Context, in *AssignTellerBranchRequest, opts ...grpc.CallOption) (*AssignTellerBranchResponse, error)
+}
+
+type tellerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTellerServiceClient(cc grpc.ClientConnInterface) TellerServiceClient {
+	return &tellerServiceClient{cc}
+}
+
+func (c *tellerServiceClient) CreateTeller(ctx context.Context, in *CreateTellerRequest, opts ...grpc.CallOption) (*CreateTellerResponse, error) {
+	out := new(CreateTellerResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.teller.TellerService/CreateTeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) RegisterTeller(ctx context.Context, in *RegisterTellerRequest, opts ...grpc.CallOption) (*RegisterTellerResponse, error) {
+	out := new(RegisterTellerResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.teller.TellerService/RegisterTeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) GetTeller(ctx context.Context, in *GetTellerRequest, opts ...grpc.CallOption) (*GetTellerResponse, error) {
+	out := new(GetTellerResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.teller.TellerService/GetTeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) ListTellers(ctx context.Context, in *ListTellersRequest, opts ...grpc.CallOption) (*ListTellersResponse, error) {
+	out := new(ListTellersResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.teller.TellerService/ListTellers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) SetTellerStatus(ctx context.Context, in *SetTellerStatusRequest, opts ...grpc.CallOption) (*SetTellerStatusResponse, error) {
+	out := new(SetTellerStatusResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.teller.TellerService/SetTellerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tellerServiceClient) AssignTellerBranch(ctx context.Context, in *AssignTellerBranchRequest, opts ...grpc.CallOption) (*AssignTellerBranchResponse, error) {
+	out := new(AssignTellerBranchResponse)
+	err := c.cc.Invoke(ctx, "/petnet.v2.teller.TellerService/AssignTellerBranch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TellerServiceServer is the server API for TellerService service.
+// All implementations must embed UnimplementedTellerServiceServer
+// for forward compatibility
+type TellerServiceServer interface {
+	// Create teller.
+	CreateTeller(context.Context, *CreateTellerRequest) (*CreateTellerResponse, error)
+	// Register teller.
+	RegisterTeller(context.Context, *RegisterTellerRequest) (*RegisterTellerResponse, error)
+	// Get teller.
+	GetTeller(context.Context, *GetTellerRequest) (*GetTellerResponse, error)
+	// List tellers.
+	ListTellers(context.Context, *ListTellersRequest) (*ListTellersResponse, error)
+	// Set teller status.
+	SetTellerStatus(context.Context, *SetTellerStatusRequest) (*SetTellerStatusResponse, error)
+	// Assign teller branch.
+	AssignTellerBranch(context.Context, *AssignTellerBranchRequest) (*AssignTellerBranchResponse, error)
+	mustEmbedUnimplementedTellerServiceServer()
+}
+
+// UnimplementedTellerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTellerServiceServer struct{}
+
+func (UnimplementedTellerServiceServer) CreateTeller(context.Context, *CreateTellerRequest) (*CreateTellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeller not implemented")
+}
+
+func (UnimplementedTellerServiceServer) RegisterTeller(context.Context, *RegisterTellerRequest) (*RegisterTellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTeller not implemented")
+}
+
+func (UnimplementedTellerServiceServer) GetTeller(context.Context, *GetTellerRequest) (*GetTellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeller not implemented")
+}
+
+func (UnimplementedTellerServiceServer) ListTellers(context.Context, *ListTellersRequest) (*ListTellersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTellers not implemented")
+}
+
+func (UnimplementedTellerServiceServer) SetTellerStatus(context.Context, *SetTellerStatusRequest) (*SetTellerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTellerStatus not implemented")
+}
+
+func (UnimplementedTellerServiceServer) AssignTellerBranch(context.Context, *AssignTellerBranchRequest) (*AssignTellerBranchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTellerBranch not implemented")
+}
+func (UnimplementedTellerServiceServer) mustEmbedUnimplementedTellerServiceServer() {}
+
+// UnsafeTellerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TellerServiceServer will
+// result in compilation errors.
+type UnsafeTellerServiceServer interface {
+	mustEmbedUnimplementedTellerServiceServer()
+}
+
+func RegisterTellerServiceServer(s grpc.ServiceRegistrar, srv TellerServiceServer) {
+	s.RegisterService(&TellerService_ServiceDesc, srv)
+}
+
+func _TellerService_CreateTeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).CreateTeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.teller.TellerService/CreateTeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).CreateTeller(ctx, req.(*CreateTellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_RegisterTeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).RegisterTeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.teller.TellerService/RegisterTeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).RegisterTeller(ctx, req.(*RegisterTellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_GetTeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).GetTeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.teller.TellerService/GetTeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).GetTeller(ctx, req.(*GetTellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_ListTellers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTellersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).ListTellers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.teller.TellerService/ListTellers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).ListTellers(ctx, req.(*ListTellersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_SetTellerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTellerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).SetTellerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.teller.TellerService/SetTellerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).SetTellerStatus(ctx, req.(*SetTellerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TellerService_AssignTellerBranch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignTellerBranchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TellerServiceServer).AssignTellerBranch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petnet.v2.teller.TellerService/AssignTellerBranch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TellerServiceServer).AssignTellerBranch(ctx, req.(*AssignTellerBranchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TellerService_ServiceDesc is the grpc.ServiceDesc for TellerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TellerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "petnet.v2.teller.TellerService",
+	HandlerType: (*TellerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeller",
+			Handler:    _TellerService_CreateTeller_Handler,
+		},
+		{
+			MethodName: "RegisterTeller",
+			Handler:    _TellerService_RegisterTeller_Handler,
+		},
+		{
+			MethodName: "GetTeller",
+			Handler:    _TellerService_GetTeller_Handler,
+		},
+		{
+			MethodName: "ListTellers",
+			Handler:    _TellerService_ListTellers_Handler,
+		},
+		{
+			MethodName: "SetTellerStatus",
+			Handler:    _TellerService_SetTellerStatus_Handler,
+		},
+		{
+			MethodName: "AssignTellerBranch",
+			Handler:    _TellerService_AssignTellerBranch_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "brank.as/petnet/gunk/dsa/v2/teller/all.proto",
+}
@@ -46,6 +46,20 @@ func (s *Svc) UpsertBranch(ctx context.Context, b storage.Branch) (*storage.Bran
 	return br, nil
 }
 
+func (s *Svc) GetBranch(ctx context.Context, id string) (*storage.Branch, error) {
+	log := logging.FromContext(ctx)
+
+	br, err := s.st.GetBranch(ctx, id)
+	if err != nil {
+		if err == storage.NotFound {
+			return nil, status.Error(codes.NotFound, "branch record not found")
+		}
+		logging.WithError(err, log).Error("fetch branch")
+		return nil, status.Error(codes.Internal, "fetch branch record failed")
+	}
+	return br, nil
+}
+
 func (s *Svc) ListBranches(ctx context.Context, org string, lim, off int, title string) ([]storage.Branch, error) {
 	log := logging.FromContext(ctx)
 
@@ -12,6 +12,7 @@ import (
 type Mailer interface {
 	OnboardingReminder(email string, orgID string, userID string) error
 	DsaServiceRequestNotification(req eml.DsaServiceRequestNotificationForm) error
+	BillerBalanceAlert(req eml.BillerBalanceAlertForm) error
 }
 
 type Svc struct {
@@ -41,3 +42,13 @@ func (s *Svc) SendDsaServiceRequestNotification(ctx context.Context, req eml.Dsa
 	}
 	return nil
 }
+
+func (s *Svc) SendBillerBalanceAlert(ctx context.Context, req eml.BillerBalanceAlertForm) error {
+	log := logging.FromContext(ctx)
+
+	if err := s.mailer.BillerBalanceAlert(req); err != nil {
+		logging.WithError(err, log).Error("biller balance alert")
+		return status.Error(codes.Internal, "failed to send biller balance alert email")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package onboarding
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/profile/storage"
+	"brank.as/petnet/profile/storage/postgres"
+
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/serviceutil/logging"
+	"brank.as/petnet/svcutil/mw"
+)
+
+// transitions lists the states reachable from each onboarding state. A rejected
+// application goes back to draft so the DSA can correct and resubmit it.
+var transitions = map[string][]string{
+	storage.OnboardingDraft: {storage.OnboardingSubmitted},
+	storage.OnboardingSubmitted: {
+		storage.OnboardingDocumentsPending,
+		storage.OnboardingUnderReview,
+	},
+	storage.OnboardingDocumentsPending: {
+		storage.OnboardingSubmitted,
+		storage.OnboardingUnderReview,
+	},
+	storage.OnboardingUnderReview: {
+		storage.OnboardingDocumentsPending,
+		storage.OnboardingApproved,
+		storage.OnboardingRejected,
+	},
+	storage.OnboardingApproved: {storage.OnboardingLive},
+	storage.OnboardingRejected: {storage.OnboardingDraft},
+	storage.OnboardingLive:     {},
+}
+
+// orgStatus is the org profile status of each onboarding state, kept in step
+// with the onboarding state for the pages still reading the profile status.
+var orgStatus = map[string]ppb.Status{
+	storage.OnboardingDraft:            ppb.Status_Incomplete,
+	storage.OnboardingSubmitted:        ppb.Status_Pending,
+	storage.OnboardingDocumentsPending: ppb.Status_PendingDocuments,
+	storage.OnboardingUnderReview:      ppb.Status_Completed,
+	storage.OnboardingApproved:         ppb.Status_Accepted,
+	storage.OnboardingRejected:         ppb.Status_Rejected,
+	storage.OnboardingLive:             ppb.Status_Accepted,
+}
+
+// NextStates returns the states an org in the given state can move to.
+func NextStates(state string) []string {
+	return transitions[state]
+}
+
+// CanTransition reports whether moving from one state to another is allowed.
+func CanTransition(from, to string) bool {
+	for _, s := range transitions[from] {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+type Svc struct {
+	st *postgres.Storage
+}
+
+func New(st *postgres.Storage) *Svc {
+	return &Svc{st: st}
+}
+
+// GetOnboarding returns the onboarding state of the org and its history. Orgs
+// without any recorded transition are in draft.
+func (s *Svc) GetOnboarding(ctx context.Context, orgID string) (*storage.Onboarding, []storage.OnboardingHistory, error) {
+	log := logging.FromContext(ctx)
+
+	o, err := s.st.GetOnboarding(ctx, orgID)
+	switch {
+	case err == storage.NotFound:
+		o = &storage.Onboarding{OrgID: orgID, State: storage.OnboardingDraft}
+	case err != nil:
+		logging.WithError(err, log).Error("fetch onboarding")
+		return nil, nil, status.Error(codes.Internal, "fetch onboarding failed")
+	}
+	hs, err := s.st.ListOnboardingHistory(ctx, orgID)
+	if err != nil {
+		logging.WithError(err, log).Error("fetch onboarding history")
+		return nil, nil, status.Error(codes.Internal, "fetch onboarding history failed")
+	}
+	return o, hs, nil
+}
+
+// Transition moves the org to the given state, recording the acting user and
+// remarks.
+func (s *Svc) Transition(ctx context.Context, orgID, to, remarks string) (*storage.OnboardingHistory, error) {
+	log := logging.FromContext(ctx)
+
+	o, _, err := s.GetOnboarding(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !CanTransition(o.State, to) {
+		return nil, status.Errorf(codes.FailedPrecondition, "onboarding cannot move from %s to %s", o.State, to)
+	}
+	h, err := s.st.TransitionOnboarding(ctx, storage.OnboardingHistory{
+		OrgID:     orgID,
+		FromState: o.State,
+		ToState:   to,
+		ActorID:   mw.GetUserID(ctx),
+		Remarks:   remarks,
+	}, int(orgStatus[to]))
+	if err != nil {
+		if err == storage.Conflict {
+			return nil, status.Error(codes.Aborted, "onboarding state changed, please retry")
+		}
+		logging.WithError(err, log).Error("store onboarding transition")
+		return nil, status.Error(codes.Internal, "failed to record onboarding transition")
+	}
+	return h, nil
+}
@@ -0,0 +1,49 @@
+package onboarding
+
+import (
+	"testing"
+
+	"brank.as/petnet/profile/storage"
+)
+
+func TestCanTransition(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{from: storage.OnboardingDraft, to: storage.OnboardingSubmitted, want: true},
+		{from: storage.OnboardingSubmitted, to: storage.OnboardingDocumentsPending, want: true},
+		{from: storage.OnboardingDocumentsPending, to: storage.OnboardingSubmitted, want: true},
+		{from: storage.OnboardingSubmitted, to: storage.OnboardingUnderReview, want: true},
+		{from: storage.OnboardingUnderReview, to: storage.OnboardingApproved, want: true},
+		{from: storage.OnboardingUnderReview, to: storage.OnboardingRejected, want: true},
+		{from: storage.OnboardingApproved, to: storage.OnboardingLive, want: true},
+		{from: storage.OnboardingRejected, to: storage.OnboardingDraft, want: true},
+		{from: storage.OnboardingDraft, to: storage.OnboardingApproved},
+		{from: storage.OnboardingSubmitted, to: storage.OnboardingApproved},
+		{from: storage.OnboardingRejected, to: storage.OnboardingLive},
+		{from: storage.OnboardingLive, to: storage.OnboardingDraft},
+		{from: storage.OnboardingDraft, to: storage.OnboardingDraft},
+		{from: "", to: storage.OnboardingSubmitted},
+	}
+	for _, test := range tests {
+		if got := CanTransition(test.from, test.to); got != test.want {
+			t.Errorf("CanTransition(%q, %q) = %v, want %v", test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestTransitionsComplete(t *testing.T) {
+	t.Parallel()
+	for from, tos := range transitions {
+		if _, ok := orgStatus[from]; !ok {
+			t.Errorf("%s has no org profile status", from)
+		}
+		for _, to := range tos {
+			if _, ok := transitions[to]; !ok {
+				t.Errorf("%s moves to %s which has no transitions defined", from, to)
+			}
+		}
+	}
+}
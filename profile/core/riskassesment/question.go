@@ -57,6 +57,10 @@ func (s *Svc) UpsertMlTfQuestion(ctx context.Context, req *rat.RiskAssesmentQues
 		logging.WithError(err, log).Error("Upsert MLTF Question error")
 		return nil, err
 	}
+	// the answer is saved, a failed scoring is picked up by the next answer
+	if err := s.scoreOrg(ctx, qes.OrgID); err != nil {
+		logging.WithError(err, log).Error("scoring org")
+	}
 	return &rat.RiskAssesmentQuestionResponse{
 		Question: &rat.Question{
 			ID:             qes.ID,
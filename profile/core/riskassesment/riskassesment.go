@@ -5,9 +5,24 @@ import (
 )
 
 type Svc struct {
-	st *postgres.Storage
+	st    *postgres.Storage
+	model WeightModel
 }
 
-func New(st *postgres.Storage) *Svc {
-	return &Svc{st: st}
+// Option is type for creating service Svc with options
+type Option func(*Svc)
+
+// WithWeightModel sets the model the risk scores are computed with.
+func WithWeightModel(m WeightModel) Option {
+	return func(s *Svc) {
+		s.model = m
+	}
+}
+
+func New(st *postgres.Storage, opts ...Option) *Svc {
+	s := &Svc{st: st, model: DefaultWeightModel}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
 }
@@ -0,0 +1,86 @@
+package riskassesment
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ppb "brank.as/petnet/gunk/dsa/v1/profile"
+	rat "brank.as/petnet/gunk/dsa/v1/riskassesment"
+	"brank.as/petnet/profile/storage"
+	"brank.as/petnet/serviceutil/logging"
+)
+
+// scoreOrg computes the risk score of the org from its ML/TF answers and
+// sets it on the org profile.
+func (s *Svc) scoreOrg(ctx context.Context, orgID string) error {
+	log := logging.FromContext(ctx)
+	qs, err := s.st.ListOrgMlTfQuestion(ctx, orgID)
+	if err != nil {
+		logging.WithError(err, log).Error("list MLTF questions")
+		return err
+	}
+	rs := s.model.Score(qs)
+	rs.OrgID = orgID
+	if _, err := s.st.UpsertRiskScore(ctx, &rs); err != nil {
+		logging.WithError(err, log).Error("store risk score")
+		return err
+	}
+	if err := s.st.SetOrgProfileRiskScore(ctx, orgID, rs.RiskScore); err != nil {
+		logging.WithError(err, log).Error("set org profile risk score")
+		return err
+	}
+	return nil
+}
+
+func (s *Svc) GetRiskScore(ctx context.Context, req *rat.GetRiskScoreRequest) (*rat.GetRiskScoreResponse, error) {
+	log := logging.FromContext(ctx)
+	rs, err := s.st.GetRiskScore(ctx, req.GetOrgID())
+	if err != nil {
+		if err == storage.NotFound {
+			return nil, status.Error(codes.NotFound, "risk score not found")
+		}
+		logging.WithError(err, log).Error("get risk score")
+		return nil, err
+	}
+	return &rat.GetRiskScoreResponse{RiskScore: toRiskScore(*rs)}, nil
+}
+
+func (s *Svc) ListRiskScores(ctx context.Context, req *rat.ListRiskScoresRequest) (*rat.ListRiskScoresResponse, error) {
+	log := logging.FromContext(ctx)
+	rss, err := s.st.ListRiskScores(ctx, req.GetOrgIDs())
+	if err != nil {
+		logging.WithError(err, log).Error("list risk scores")
+		return nil, err
+	}
+	res := &rat.ListRiskScoresResponse{}
+	for _, rs := range rss {
+		res.RiskScores = append(res.RiskScores, toRiskScore(rs))
+	}
+	return res, nil
+}
+
+func toRiskScore(rs storage.RiskScore) *rat.OrgRiskScore {
+	r := &rat.OrgRiskScore{
+		OrgID:        rs.OrgID,
+		RiskScore:    ppb.RiskScore(rs.RiskScore),
+		Percent:      rs.Percent,
+		ModelVersion: rs.ModelVersion,
+		Updated:      timestamppb.New(rs.Updated),
+	}
+	for _, f := range rs.Breakdown.Questions {
+		r.Factors = append(r.Factors, &rat.RiskFactor{
+			QID:            f.QID,
+			Weight:         f.Weight,
+			CustomersTotal: f.CustomersTotal,
+			HrTotal:        f.HrTotal,
+			ImpactScore:    f.ImpactScore,
+			Points:         f.Points,
+			MaxPoints:      f.MaxPoints,
+			Note:           f.Note,
+		})
+	}
+	return r
+}
@@ -0,0 +1,118 @@
+package riskassesment
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/profile/storage"
+)
+
+// WeightModel weighs the ML/TF questionnaire answers into a risk score.
+//
+// Each answered question earns its weight times the answer's impact score,
+// out of its weight times MaxImpact. Questions none of the DSA's customers
+// or staff are exposed to earn nothing. The share of the maximum points
+// decides the risk level.
+type WeightModel struct {
+	// Version is stored with every score computed with the model.
+	Version string
+	// Weights by question ID, questions not listed weigh DefaultWeight.
+	Weights       map[string]float64
+	DefaultWeight float64
+	// MaxImpact is the highest impact score of an answer.
+	MaxImpact float64
+	// MediumAt and HighAt are the percent of the maximum points from which
+	// the risk is medium or high.
+	MediumAt float64
+	HighAt   float64
+}
+
+// DefaultWeightModel weighs all the questions the same.
+var DefaultWeightModel = WeightModel{
+	Version:       "v1",
+	DefaultWeight: 1,
+	MaxImpact:     5,
+	MediumAt:      35,
+	HighAt:        65,
+}
+
+const (
+	noteNoExposure    = "no customers or staff exposed"
+	noteInvalidAnswer = "invalid answer, scored as the highest impact"
+)
+
+// Score computes the risk score of the answers. Where a question was answered
+// more than once the last answer counts.
+func (m WeightModel) Score(qs []storage.Question) storage.RiskScore {
+	last := map[string]storage.Question{}
+	var qids []string
+	for _, q := range qs {
+		p, ok := last[q.QID]
+		if !ok {
+			qids = append(qids, q.QID)
+		}
+		if !ok || !q.Updated.Before(p.Updated) {
+			last[q.QID] = q
+		}
+	}
+
+	rs := storage.RiskScore{ModelVersion: m.Version}
+	var pts, max float64
+	for _, qid := range qids {
+		f := m.factor(last[qid])
+		pts += f.Points
+		max += f.MaxPoints
+		rs.Breakdown.Questions = append(rs.Breakdown.Questions, f)
+	}
+	if max > 0 {
+		rs.Percent = math.Round(pts/max*10000) / 100
+	}
+	rs.RiskScore = int(m.level(rs.Percent))
+	return rs
+}
+
+func (m WeightModel) factor(q storage.Question) storage.RiskFactor {
+	w, ok := m.Weights[q.QID]
+	if !ok {
+		w = m.DefaultWeight
+	}
+	f := storage.RiskFactor{
+		QID:       q.QID,
+		Weight:    w,
+		MaxPoints: w * m.MaxImpact,
+	}
+	ct, err1 := parseAnswer(q.CustomersTotal)
+	hr, err2 := parseAnswer(q.HrTotal)
+	is, err3 := parseAnswer(q.ImpactScore)
+	f.CustomersTotal, f.HrTotal, f.ImpactScore = ct, hr, is
+	switch {
+	case err1 != nil || err2 != nil || err3 != nil || is < 0:
+		f.Points = f.MaxPoints
+		f.Note = noteInvalidAnswer
+	case ct <= 0 && hr <= 0:
+		f.Note = noteNoExposure
+	default:
+		f.Points = w * math.Min(is, m.MaxImpact)
+	}
+	return f
+}
+
+func (m WeightModel) level(pct float64) ppb.RiskScore {
+	switch {
+	case pct >= m.HighAt:
+		return ppb.RiskScore_High
+	case pct >= m.MediumAt:
+		return ppb.RiskScore_Medium
+	}
+	return ppb.RiskScore_Low
+}
+
+func parseAnswer(s string) (float64, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
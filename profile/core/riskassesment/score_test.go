@@ -0,0 +1,102 @@
+package riskassesment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/profile/storage"
+)
+
+func TestScore(t *testing.T) {
+	t.Parallel()
+	m := WeightModel{
+		Version:       "test",
+		Weights:       map[string]float64{"1": 2},
+		DefaultWeight: 1,
+		MaxImpact:     5,
+		MediumAt:      35,
+		HighAt:        65,
+	}
+	now := time.Now()
+	q := func(qid, ct, hr, is string, upd time.Time) storage.Question {
+		return storage.Question{QID: qid, CustomersTotal: ct, HrTotal: hr, ImpactScore: is, Updated: upd}
+	}
+
+	tests := []struct {
+		desc  string
+		qs    []storage.Question
+		want  ppb.RiskScore
+		pct   float64
+		notes map[string]string
+	}{
+		{
+			desc: "Low",
+			qs: []storage.Question{
+				q("1", "10", "2", "1", now),
+				q("2", "0", "0", "5", now),
+			},
+			want:  ppb.RiskScore_Low,
+			pct:   13.33,
+			notes: map[string]string{"2": noteNoExposure},
+		},
+		{
+			desc: "Medium",
+			qs: []storage.Question{
+				q("1", "1,200", "0", "3", now),
+				q("2", "0", "3", "1", now),
+			},
+			want: ppb.RiskScore_Medium,
+			pct:  46.67,
+		},
+		{
+			desc: "High With Last Answer",
+			qs: []storage.Question{
+				q("1", "5", "1", "1", now),
+				q("1", "5", "1", "5", now.Add(time.Minute)),
+				q("2", "5", "1", "2", now),
+			},
+			want: ppb.RiskScore_High,
+			pct:  80,
+		},
+		{
+			desc: "Invalid Answer",
+			qs: []storage.Question{
+				q("2", "many", "1", "1", now),
+			},
+			want:  ppb.RiskScore_High,
+			pct:   100,
+			notes: map[string]string{"2": noteInvalidAnswer},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+			got := m.Score(test.qs)
+			if ppb.RiskScore(got.RiskScore) != test.want {
+				t.Errorf("want risk score %s, got %s", test.want, ppb.RiskScore(got.RiskScore))
+			}
+			if got.Percent != test.pct {
+				t.Errorf("want %v percent, got %v", test.pct, got.Percent)
+			}
+			if got.ModelVersion != "test" {
+				t.Errorf("want model version stored, got %q", got.ModelVersion)
+			}
+			notes := map[string]string{}
+			for _, f := range got.Breakdown.Questions {
+				if f.Note != "" {
+					notes[f.QID] = f.Note
+				}
+			}
+			if test.notes == nil {
+				test.notes = map[string]string{}
+			}
+			if !cmp.Equal(test.notes, notes) {
+				t.Error(cmp.Diff(test.notes, notes))
+			}
+		})
+	}
+}
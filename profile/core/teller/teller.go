@@ -0,0 +1,216 @@
+package teller
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/util/metautils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/profile/storage"
+	"brank.as/petnet/profile/storage/postgres"
+	"brank.as/petnet/serviceutil/logging"
+	upb "brank.as/rbac/gunk/v1/user"
+)
+
+type Svc struct {
+	st  *postgres.Storage
+	ucl upb.UserServiceClient
+	scl upb.SignupClient
+}
+
+func New(st *postgres.Storage, ucl upb.UserServiceClient, scl upb.SignupClient) *Svc {
+	return &Svc{st: st, ucl: ucl, scl: scl}
+}
+
+func (s *Svc) CreateTeller(ctx context.Context, t storage.Teller) (*storage.Teller, error) {
+	if err := s.checkTeller(ctx, t); err != nil {
+		return nil, err
+	}
+	return s.storeTeller(ctx, t)
+}
+
+// RegisterTeller signs up the teller's RBAC user and records the teller. The
+// user is disabled again when the teller can't be recorded so it is not left
+// able to log in without a teller.
+func (s *Svc) RegisterTeller(ctx context.Context, t storage.Teller, password string) (*storage.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	if err := s.checkTeller(ctx, t); err != nil {
+		return nil, err
+	}
+	u, err := s.scl.Signup(metautils.ExtractIncoming(ctx).ToOutgoing(ctx), &upb.SignupRequest{
+		Username:  t.Username,
+		FirstName: t.FirstName,
+		LastName:  t.LastName,
+		Email:     t.Email,
+		Password:  password,
+		OrgID:     t.OrgID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.AlreadyExists:
+			return nil, err
+		}
+		logging.WithError(err, log).Error("teller signup")
+		return nil, status.Error(codes.Internal, "failed to register teller")
+	}
+
+	t.UserID = u.GetUserID()
+	tl, err := s.storeTeller(ctx, t)
+	if err != nil {
+		if _, err := s.ucl.DisableUser(ctx, &upb.DisableUserRequest{UserID: t.UserID}); err != nil {
+			logging.WithError(err, log).WithField("user_id", t.UserID).Error("disable teller user")
+		}
+		return nil, err
+	}
+	return tl, nil
+}
+
+func (s *Svc) storeTeller(ctx context.Context, t storage.Teller) (*storage.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	tl, err := s.st.CreateTeller(ctx, t)
+	if err != nil {
+		if err == storage.Conflict {
+			return nil, status.Error(codes.AlreadyExists, "user is already a teller")
+		}
+		logging.WithError(err, log).Error("store teller")
+		return nil, status.Error(codes.Internal, "failed to record teller")
+	}
+	return tl, nil
+}
+
+// checkTeller ensures the teller's branch and terminal belong to its org.
+func (s *Svc) checkTeller(ctx context.Context, t storage.Teller) error {
+	if t.BranchID != "" {
+		if err := s.checkBranch(ctx, t.OrgID, t.BranchID); err != nil {
+			return err
+		}
+	}
+	if t.TerminalID != "" {
+		if err := s.checkTerminal(ctx, t.OrgID, t.TerminalID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Svc) GetTeller(ctx context.Context, id string) (*storage.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	tl, err := s.st.GetTeller(ctx, id)
+	if err != nil {
+		if err == storage.NotFound {
+			return nil, status.Error(codes.NotFound, "teller not found")
+		}
+		logging.WithError(err, log).Error("fetch teller")
+		return nil, status.Error(codes.Internal, "fetch teller failed")
+	}
+	return tl, nil
+}
+
+func (s *Svc) ListTellers(ctx context.Context, f storage.TellerFilter) ([]storage.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	tls, err := s.st.ListTellers(ctx, f)
+	if err != nil {
+		logging.WithError(err, log).Error("fetch org tellers")
+		return nil, status.Error(codes.Internal, "fetch tellers failed")
+	}
+	return tls, nil
+}
+
+// SetTellerStatus enables or disables the teller's RBAC user along with the
+// teller record so a disabled teller can no longer log in.
+func (s *Svc) SetTellerStatus(ctx context.Context, id, st string) (*storage.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	tl, err := s.GetTeller(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	switch st {
+	case storage.TellerEnabled:
+		_, err = s.ucl.EnableUser(ctx, &upb.EnableUserRequest{UserID: tl.UserID})
+	case storage.TellerDisabled:
+		_, err = s.ucl.DisableUser(ctx, &upb.DisableUserRequest{UserID: tl.UserID})
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid teller status")
+	}
+	if err != nil {
+		logging.WithError(err, log).Error("update teller user")
+		return nil, status.Error(codes.Internal, "update teller user failed")
+	}
+
+	tl, err = s.st.SetTellerStatus(ctx, id, st)
+	if err != nil {
+		if err == storage.NotFound {
+			return nil, status.Error(codes.NotFound, "teller not found")
+		}
+		logging.WithError(err, log).Error("update teller status")
+		return nil, status.Error(codes.Internal, "update teller status failed")
+	}
+	return tl, nil
+}
+
+func (s *Svc) AssignTellerBranch(ctx context.Context, id, branchID string) (*storage.Teller, error) {
+	log := logging.FromContext(ctx)
+
+	tl, err := s.GetTeller(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if branchID != "" {
+		if err := s.checkBranch(ctx, tl.OrgID, branchID); err != nil {
+			return nil, err
+		}
+	}
+	tl, err = s.st.AssignTellerBranch(ctx, id, branchID)
+	if err != nil {
+		if err == storage.NotFound {
+			return nil, status.Error(codes.NotFound, "teller not found")
+		}
+		logging.WithError(err, log).Error("assign teller branch")
+		return nil, status.Error(codes.Internal, "assign teller branch failed")
+	}
+	return tl, nil
+}
+
+// checkTerminal ensures the terminal is the OTC or digital terminal of the
+// org, the partners only know the terminals registered on the org profile.
+func (s *Svc) checkTerminal(ctx context.Context, orgID, terminalID string) error {
+	log := logging.FromContext(ctx)
+
+	pf, err := s.st.GetOrgProfile(ctx, orgID)
+	if err != nil {
+		if err == storage.NotFound {
+			return status.Error(codes.InvalidArgument, "org profile not found")
+		}
+		logging.WithError(err, log).Error("fetch org profile")
+		return status.Error(codes.Internal, "fetch org profile failed")
+	}
+	switch terminalID {
+	case pf.TerminalIdOtc, pf.TerminalIdDigital:
+		return nil
+	}
+	return status.Error(codes.InvalidArgument, "terminal is not a terminal of the org")
+}
+
+// checkBranch ensures the branch exists and belongs to the teller's org.
+func (s *Svc) checkBranch(ctx context.Context, orgID, branchID string) error {
+	log := logging.FromContext(ctx)
+
+	br, err := s.st.GetBranch(ctx, branchID)
+	if err != nil {
+		if err == storage.NotFound {
+			return status.Error(codes.InvalidArgument, "branch not found")
+		}
+		logging.WithError(err, log).Error("fetch branch")
+		return status.Error(codes.Internal, "fetch branch failed")
+	}
+	if br.OrgID != orgID {
+		return status.Error(codes.InvalidArgument, "branch belongs to another org")
+	}
+	return nil
+}
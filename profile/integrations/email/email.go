@@ -150,3 +150,26 @@ func (ms *MailSender) DsaServiceRequestNotification(req DsaServiceRequestNotific
 	imgs := []string{"white-logo.png"}
 	return ms.sendMail(ms.fromName, req.Email, subj, dsaServiceRequestNotification, req, imgs)
 }
+
+type BillerBalanceAlertForm struct {
+	Emails    []string
+	Partner   string
+	Balance   string
+	Threshold string
+	Checked   string
+}
+
+func (ms *MailSender) BillerBalanceAlert(req BillerBalanceAlertForm) error {
+	billerBalanceAlert := template.Must(
+		template.New("biller-balance-alert.html").
+			ParseFiles(assetPath + "biller-balance-alert.html"))
+
+	subj := fmt.Sprintf("PETNET - %s wallet balance is low", req.Partner)
+	imgs := []string{"white-logo.png"}
+	for _, e := range req.Emails {
+		if err := ms.sendMail(ms.fromName, e, subj, billerBalanceAlert, req, imgs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	fec "brank.as/petnet/profile/core/fees"
 	fic "brank.as/petnet/profile/core/file"
 	mc "brank.as/petnet/profile/core/mfa"
+	onbc "brank.as/petnet/profile/core/onboarding"
 	pnrc "brank.as/petnet/profile/core/partner"
 	rcc "brank.as/petnet/profile/core/partnercommission"
 	pnrcl "brank.as/petnet/profile/core/partnerlist"
@@ -27,6 +28,7 @@ import (
 	ric "brank.as/petnet/profile/core/riskassesment"
 	sec "brank.as/petnet/profile/core/session"
 	sic "brank.as/petnet/profile/core/signup"
+	tlc "brank.as/petnet/profile/core/teller"
 	"brank.as/petnet/profile/integrations/email"
 	"brank.as/petnet/profile/partners"
 	"brank.as/petnet/profile/permission"
@@ -38,6 +40,7 @@ import (
 	fes "brank.as/petnet/profile/services/fees"
 	fis "brank.as/petnet/profile/services/file"
 	ms "brank.as/petnet/profile/services/mfa"
+	onbs "brank.as/petnet/profile/services/onboarding"
 	ops "brank.as/petnet/profile/services/orgprofile"
 	pnrs "brank.as/petnet/profile/services/partner"
 	rcs "brank.as/petnet/profile/services/partnercommission"
@@ -50,6 +53,7 @@ import (
 	ss "brank.as/petnet/profile/services/service"
 	ses "brank.as/petnet/profile/services/session"
 	sis "brank.as/petnet/profile/services/signup"
+	tls "brank.as/petnet/profile/services/teller"
 	ups "brank.as/petnet/profile/services/userprofile"
 	"brank.as/petnet/profile/storage/postgres"
 	"brank.as/petnet/serviceutil/auth/hydra"
@@ -233,9 +237,15 @@ func setupServices(ctx context.Context, log *logrus.Entry, c *viper.Viper, store
 	op := ops.New(store, ucl, st)
 	up := ups.New(st)
 	tt := ats.New(atc.New(store))
-	rc := ric.New(store)
+	rm, err := riskWeightModel(c)
+	if err != nil {
+		return nil, err
+	}
+	rc := ric.New(store, ric.WithWeightModel(*rm))
 	rs := ris.New(rc)
 	br := brs.New(brc.New(store))
+	tl := tls.New(tlc.New(store, ucl, sicl))
+	onb := onbs.New(onbc.New(store))
 	emli := emlc.New(mailer)
 	em := ems.New(emli)
 	fi := fis.New(fic.New(store))
@@ -271,9 +281,13 @@ func setupServices(ctx context.Context, log *logrus.Entry, c *viper.Viper, store
 		logging.WithError(err, log).Fatal("unable to bootstrap petnet RTA partner")
 		return nil, err
 	}
+	if err := partners.BootstrapAdminELoadPartners(ctx, log, store); err != nil {
+		logging.WithError(err, log).Fatal("unable to bootstrap petnet eload partner")
+		return nil, err
+	}
 	return &Svcs{
 		external: []Service{},
-		internal: []Service{op, up, rbse, se, br, em, fi, fe, sv, ev, si, m, rbsus, rs, svl, s, tt, rcsc, rvsh, rsrp, svlc},
+		internal: []Service{op, up, rbse, se, br, em, fi, fe, sv, ev, si, m, rbsus, rs, svl, s, tt, rcsc, rvsh, rsrp, svlc, tl, onb},
 	}, nil
 }
 
@@ -339,6 +353,34 @@ func setupGRPCInternal(c *viper.Viper, log *logrus.Entry, s []Service,
 	return srv, gw, nil
 }
 
+// riskWeightModel reads the risk score weight model, the settings left out
+// keep their default.
+func riskWeightModel(c *viper.Viper) (*ric.WeightModel, error) {
+	m := ric.DefaultWeightModel
+	if v := c.GetString("riskScore.version"); v != "" {
+		m.Version = v
+	}
+	for k, f := range map[string]*float64{
+		"riskScore.defaultWeight": &m.DefaultWeight,
+		"riskScore.maxImpact":     &m.MaxImpact,
+		"riskScore.mediumAt":      &m.MediumAt,
+		"riskScore.highAt":        &m.HighAt,
+	} {
+		if c.IsSet(k) {
+			*f = c.GetFloat64(k)
+		}
+	}
+	if c.IsSet("riskScore.weights") {
+		if err := c.UnmarshalKey("riskScore.weights", &m.Weights); err != nil {
+			return nil, fmt.Errorf("invalid risk score weights: %w", err)
+		}
+	}
+	if m.MaxImpact <= 0 || m.MediumAt > m.HighAt {
+		return nil, fmt.Errorf("invalid risk score model %q", m.Version)
+	}
+	return &m, nil
+}
+
 // NewDBFromConfig build database connection from config file.
 func newDBFromConfig(config *viper.Viper) (*postgres.Storage, error) {
 	cf := func(c string) string { return config.GetString("database." + c) }
@@ -0,0 +1,14 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS risk_score (
+    org_id uuid PRIMARY KEY,
+    risk_score int NOT NULL DEFAULT 0,
+    percent double precision NOT NULL DEFAULT 0,
+    model_version text NOT NULL DEFAULT '',
+    breakdown jsonb NOT NULL DEFAULT '{}',
+    created timestamptz NOT NULL DEFAULT now(),
+    updated timestamptz NOT NULL DEFAULT now()
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS risk_score;
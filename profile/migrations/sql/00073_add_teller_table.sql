@@ -0,0 +1,27 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS teller (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL,
+    user_id text NOT NULL,
+    username text NOT NULL DEFAULT '',
+    first_name text NOT NULL DEFAULT '',
+    middle_name text NOT NULL DEFAULT '',
+    last_name text NOT NULL DEFAULT '',
+    email text NOT NULL DEFAULT '',
+    phone text NOT NULL DEFAULT '',
+    birthdate text NOT NULL DEFAULT '',
+    branch_id text NOT NULL DEFAULT '',
+    operator_id text NOT NULL DEFAULT '',
+    terminal_id text NOT NULL DEFAULT '',
+    status text NOT NULL DEFAULT 'ENABLED',
+    created timestamptz NOT NULL DEFAULT now(),
+    updated timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS teller_user_id_idx ON teller (user_id);
+CREATE INDEX IF NOT EXISTS teller_org_id_idx ON teller (org_id, branch_id);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS teller;
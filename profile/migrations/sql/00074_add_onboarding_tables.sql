@@ -0,0 +1,25 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS onboarding (
+    org_id uuid PRIMARY KEY,
+    state text NOT NULL DEFAULT 'DRAFT',
+    created timestamptz NOT NULL DEFAULT now(),
+    updated timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS onboarding_history (
+    id uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    org_id uuid NOT NULL,
+    from_state text NOT NULL DEFAULT '',
+    to_state text NOT NULL DEFAULT '',
+    actor_id text NOT NULL DEFAULT '',
+    remarks text NOT NULL DEFAULT '',
+    created timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS onboarding_history_org_id_idx ON onboarding_history (org_id, created);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS onboarding_history;
+DROP TABLE IF EXISTS onboarding;
@@ -0,0 +1,26 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+WITH backfill AS (
+    SELECT org_id, CASE status
+        WHEN 1 THEN 'APPROVED'
+        WHEN 2 THEN 'UNDER_REVIEW'
+        WHEN 3 THEN 'SUBMITTED'
+        WHEN 4 THEN 'REJECTED'
+        WHEN 5 THEN 'DOCUMENTS_PENDING'
+    END AS state
+    FROM org_profile
+), inserted AS (
+    INSERT INTO onboarding (org_id, state)
+    SELECT org_id, state FROM backfill WHERE state IS NOT NULL
+    ON CONFLICT (org_id) DO NOTHING
+    RETURNING org_id, state
+)
+INSERT INTO onboarding_history (org_id, from_state, to_state, remarks)
+SELECT org_id, 'DRAFT', state, 'backfilled from the org profile status' FROM inserted;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DELETE FROM onboarding o USING onboarding_history h
+WHERE h.org_id = o.org_id AND h.actor_id = '' AND h.remarks = 'backfilled from the org profile status'
+    AND NOT EXISTS (SELECT 1 FROM onboarding_history n WHERE n.org_id = o.org_id AND n.id <> h.id);
+DELETE FROM onboarding_history WHERE actor_id = '' AND remarks = 'backfilled from the org profile status';
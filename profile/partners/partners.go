@@ -91,3 +91,26 @@ func BootstrapAdminRTAPartners(ctx context.Context, log *logrus.Entry, st *postg
 	log.Info("Bootstrapped Petnet Admin RTA partners")
 	return nil
 }
+
+func BootstrapAdminELoadPartners(ctx context.Context, log *logrus.Entry, st *postgres.Storage) error {
+	for _, ptnr := range partners.ELoadPartnersList {
+		lr, err := st.GetPartnerList(ctx, &storage.PartnerList{
+			Stype:       ptnr.Stype,
+			ServiceName: sVcpb.ServiceType_ELOAD.String(),
+		})
+		switch {
+		case err != nil && err != storage.NotFound:
+			log.WithError(err).Error("Bootstrapped Petnet Admin eload partner get Failed")
+		case len(lr) > 0:
+			if _, err := st.UpdatePartnerList(ctx, ptnr); err != nil {
+				log.Info("Bootstrapped Petnet Admin eload partner update Failed")
+			}
+		default:
+			if _, err := st.CreatePartnerList(ctx, ptnr); err != nil {
+				log.Info("Bootstrapped Petnet Admin eload partner Create Failed")
+			}
+		}
+	}
+	log.Info("Bootstrapped Petnet Admin eload partners")
+	return nil
+}
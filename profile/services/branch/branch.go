@@ -18,6 +18,7 @@ type Svc struct {
 
 type BranchCore interface {
 	UpsertBranch(context.Context, storage.Branch) (*storage.Branch, error)
+	GetBranch(ctx context.Context, id string) (*storage.Branch, error)
 	ListBranches(ctx context.Context, org string, lim, off int, title string) ([]storage.Branch, error)
 }
 
@@ -0,0 +1,29 @@
+package branch
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bpb "brank.as/petnet/gunk/dsa/v2/branch"
+)
+
+func (s *Svc) GetBranch(ctx context.Context, req *bpb.GetBranchRequest) (*bpb.GetBranchResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	br, err := s.core.GetBranch(ctx, req.GetID())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve branch record")
+	}
+	return &bpb.GetBranchResponse{Branch: toBranch(*br)}, nil
+}
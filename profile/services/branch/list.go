@@ -12,6 +12,7 @@ import (
 
 	bpb "brank.as/petnet/gunk/dsa/v2/branch"
 	ppb "brank.as/petnet/gunk/dsa/v2/profile"
+	"brank.as/petnet/profile/storage"
 )
 
 func (s *Svc) ListBranches(ctx context.Context, req *bpb.ListBranchesRequest) (*bpb.ListBranchesResponse, error) {
@@ -31,31 +32,9 @@ func (s *Svc) ListBranches(ctx context.Context, req *bpb.ListBranchesRequest) (*
 		return nil, status.Error(codes.Internal, "failed to retrieve branch records")
 	}
 
-	ts := func(t time.Time) *tspb.Timestamp {
-		if t.IsZero() {
-			return nil
-		}
-		return tspb.New(t)
-	}
 	brs := make([]*bpb.Branch, len(br))
 	for i, b := range br {
-		brs[i] = &bpb.Branch{
-			ID:    b.ID,
-			OrgID: b.OrgID,
-			Title: b.Title,
-			Address: &ppb.Address{
-				Address1:   b.Address1,
-				City:       b.City,
-				State:      b.State,
-				PostalCode: b.PostalCode,
-			},
-			PhoneNumber:   b.PhoneNumber,
-			FaxNumber:     b.FaxNumber,
-			ContactPerson: b.ContactPerson,
-			Created:       ts(b.Created),
-			Updated:       ts(b.Updated),
-			Deleted:       ts(b.Deleted.Time),
-		}
+		brs[i] = toBranch(b)
 	}
 	var tot int32
 	if len(br) > 0 {
@@ -66,3 +45,29 @@ func (s *Svc) ListBranches(ctx context.Context, req *bpb.ListBranchesRequest) (*
 		Total:    tot,
 	}, nil
 }
+
+func toBranch(b storage.Branch) *bpb.Branch {
+	ts := func(t time.Time) *tspb.Timestamp {
+		if t.IsZero() {
+			return nil
+		}
+		return tspb.New(t)
+	}
+	return &bpb.Branch{
+		ID:    b.ID,
+		OrgID: b.OrgID,
+		Title: b.Title,
+		Address: &ppb.Address{
+			Address1:   b.Address1,
+			City:       b.City,
+			State:      b.State,
+			PostalCode: b.PostalCode,
+		},
+		PhoneNumber:   b.PhoneNumber,
+		FaxNumber:     b.FaxNumber,
+		ContactPerson: b.ContactPerson,
+		Created:       ts(b.Created),
+		Updated:       ts(b.Updated),
+		Deleted:       ts(b.Deleted.Time),
+	}
+}
@@ -0,0 +1,41 @@
+package fees
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	epb "brank.as/petnet/gunk/dsa/v1/email"
+	eml "brank.as/petnet/profile/integrations/email"
+)
+
+func (s *Svc) SendBillerBalanceAlert(ctx context.Context, req *epb.SendBillerBalanceAlertRequest) (*epb.SendBillerBalanceAlertResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Emails, validation.Required, validation.Each(is.Email)),
+		validation.Field(&req.Partner, validation.Required),
+		validation.Field(&req.Balance, validation.Required),
+		validation.Field(&req.Threshold, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	chk := time.Now()
+	if req.GetChecked().IsValid() {
+		chk = req.GetChecked().AsTime()
+	}
+	if err := s.es.SendBillerBalanceAlert(ctx, eml.BillerBalanceAlertForm{
+		Emails:    req.GetEmails(),
+		Partner:   req.GetPartner(),
+		Balance:   req.GetBalance(),
+		Threshold: req.GetThreshold(),
+		Checked:   chk.Format(time.RFC1123),
+	}); err != nil {
+		return nil, status.Error(codes.Internal, "failed to send biller balance alert")
+	}
+
+	return &epb.SendBillerBalanceAlertResponse{}, nil
+}
@@ -18,6 +18,7 @@ type Svc struct {
 type EmailStore interface {
 	SendOnboardingReminder(ctx context.Context, email string, orgID string, userID string) error
 	SendDsaServiceRequestNotification(ctx context.Context, req eml.DsaServiceRequestNotificationForm) error
+	SendBillerBalanceAlert(ctx context.Context, req eml.BillerBalanceAlertForm) error
 }
 
 func New(es EmailStore) *Svc {
@@ -0,0 +1,46 @@
+package onboarding
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	oc "brank.as/petnet/profile/core/onboarding"
+
+	opb "brank.as/petnet/gunk/dsa/v2/onboarding"
+)
+
+func (s *Svc) GetOnboarding(ctx context.Context, req *opb.GetOnboardingRequest) (*opb.GetOnboardingResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgID, validation.Required, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	o, hs, err := s.core.GetOnboarding(ctx, req.GetOrgID())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve onboarding")
+	}
+	res := &opb.GetOnboardingResponse{
+		OrgID:   o.OrgID,
+		State:   toState(o.State),
+		History: make([]*opb.Transition, len(hs)),
+	}
+	if !o.Updated.IsZero() {
+		res.Updated = tspb.New(o.Updated)
+	}
+	for _, n := range oc.NextStates(o.State) {
+		res.NextStates = append(res.NextStates, toState(n))
+	}
+	for i, h := range hs {
+		res.History[i] = toTransition(h)
+	}
+	return res, nil
+}
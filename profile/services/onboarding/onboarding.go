@@ -0,0 +1,72 @@
+package onboarding
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"brank.as/petnet/profile/storage"
+
+	opb "brank.as/petnet/gunk/dsa/v2/onboarding"
+)
+
+type Svc struct {
+	opb.UnimplementedOnboardingServiceServer
+	core OnboardingCore
+}
+
+type OnboardingCore interface {
+	GetOnboarding(ctx context.Context, orgID string) (*storage.Onboarding, []storage.OnboardingHistory, error)
+	Transition(ctx context.Context, orgID, to, remarks string) (*storage.OnboardingHistory, error)
+}
+
+func New(core OnboardingCore) *Svc {
+	return &Svc{
+		core: core,
+	}
+}
+
+// RegisterService with grpc server.
+func (s *Svc) Register(srv *grpc.Server) { opb.RegisterOnboardingServiceServer(srv, s) }
+
+// RegisterGateway grpcgw
+func (s *Svc) RegisterGateway(ctx context.Context, mux *runtime.ServeMux, address string, options []grpc.DialOption) error {
+	return opb.RegisterOnboardingServiceHandlerFromEndpoint(ctx, mux, address, options)
+}
+
+var states = map[string]opb.State{
+	storage.OnboardingDraft:            opb.State_Draft,
+	storage.OnboardingSubmitted:        opb.State_Submitted,
+	storage.OnboardingDocumentsPending: opb.State_DocumentsPending,
+	storage.OnboardingUnderReview:      opb.State_UnderReview,
+	storage.OnboardingApproved:         opb.State_Approved,
+	storage.OnboardingRejected:         opb.State_Rejected,
+	storage.OnboardingLive:             opb.State_Live,
+}
+
+func toState(s string) opb.State {
+	return states[s]
+}
+
+func fromState(s opb.State) string {
+	for k, v := range states {
+		if v == s {
+			return k
+		}
+	}
+	return ""
+}
+
+func toTransition(h storage.OnboardingHistory) *opb.Transition {
+	return &opb.Transition{
+		ID:      h.ID,
+		OrgID:   h.OrgID,
+		From:    toState(h.FromState),
+		To:      toState(h.ToState),
+		ActorID: h.ActorID,
+		Remarks: h.Remarks,
+		Created: tspb.New(h.Created),
+	}
+}
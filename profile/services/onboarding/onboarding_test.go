@@ -0,0 +1,110 @@
+package onboarding
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/profile/storage/postgres"
+
+	opb "brank.as/petnet/gunk/dsa/v2/onboarding"
+	oc "brank.as/petnet/profile/core/onboarding"
+)
+
+func TestOnboarding(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	oid := uuid.NewString()
+	s := New(oc.New(newTestStorage(t)))
+
+	got, err := s.GetOnboarding(ctx, &opb.GetOnboardingRequest{OrgID: oid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &opb.GetOnboardingResponse{
+		OrgID:      oid,
+		State:      opb.State_Draft,
+		NextStates: []opb.State{opb.State_Submitted},
+		History:    []*opb.Transition{},
+	}
+	o := cmp.Options{
+		cmpopts.IgnoreFields(opb.GetOnboardingResponse{}, "Updated"),
+		cmpopts.IgnoreFields(opb.Transition{}, "ID", "Created"),
+		cmpopts.IgnoreUnexported(opb.GetOnboardingResponse{}, opb.Transition{}),
+		cmpopts.EquateEmpty(),
+	}
+	if !cmp.Equal(want, got, o) {
+		t.Error("(-want +got): ", cmp.Diff(want, got, o))
+	}
+
+	if _, err := s.TransitionOnboarding(ctx, &opb.TransitionOnboardingRequest{
+		OrgID: oid,
+		State: opb.State_Approved,
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("want failed precondition approving a draft, got %v", err)
+	}
+	for _, st := range []opb.State{opb.State_Submitted, opb.State_UnderReview} {
+		if _, err := s.TransitionOnboarding(ctx, &opb.TransitionOnboardingRequest{
+			OrgID:   oid,
+			State:   st,
+			Remarks: st.String(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err = s.GetOnboarding(ctx, &opb.GetOnboardingRequest{OrgID: oid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = &opb.GetOnboardingResponse{
+		OrgID: oid,
+		State: opb.State_UnderReview,
+		NextStates: []opb.State{
+			opb.State_DocumentsPending, opb.State_Approved, opb.State_Rejected,
+		},
+		History: []*opb.Transition{
+			{OrgID: oid, From: opb.State_Draft, To: opb.State_Submitted, Remarks: "Submitted"},
+			{OrgID: oid, From: opb.State_Submitted, To: opb.State_UnderReview, Remarks: "UnderReview"},
+		},
+	}
+	if !cmp.Equal(want, got, o) {
+		t.Error("(-want +got): ", cmp.Diff(want, got, o))
+	}
+}
+
+var _testStorage *postgres.Storage
+
+func TestMain(m *testing.M) {
+	const dbConnEnv = "DATABASE_CONNECTION"
+	ddlConnStr := os.Getenv(dbConnEnv)
+	if ddlConnStr == "" {
+		log.Printf("%s is not set, skipping", dbConnEnv)
+		return
+	}
+
+	var teardown func()
+	_testStorage, teardown = postgres.NewTestStorage(ddlConnStr, filepath.Join("..", "..", "migrations", "sql"))
+
+	exitCode := m.Run()
+
+	if teardown != nil {
+		teardown()
+	}
+	os.Exit(exitCode)
+}
+
+func newTestStorage(tb testing.TB) *postgres.Storage {
+	if testing.Short() {
+		tb.Skip("skipping tests that use postgres on -short")
+	}
+	return _testStorage
+}
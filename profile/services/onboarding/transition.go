@@ -0,0 +1,36 @@
+package onboarding
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	opb "brank.as/petnet/gunk/dsa/v2/onboarding"
+)
+
+func (s *Svc) TransitionOnboarding(ctx context.Context, req *opb.TransitionOnboardingRequest) (*opb.TransitionOnboardingResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgID, validation.Required, is.UUID),
+		validation.Field(&req.State, validation.Required, validation.By(func(interface{}) error {
+			if fromState(req.GetState()) == "" {
+				return validation.NewError("validation_invalid_state", "invalid onboarding state")
+			}
+			return nil
+		})),
+		validation.Field(&req.Remarks, validation.Length(0, 1000)),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	h, err := s.core.Transition(ctx, req.GetOrgID(), fromState(req.GetState()), req.GetRemarks())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to record onboarding transition")
+	}
+	return &opb.TransitionOnboardingResponse{Transition: toTransition(*h)}, nil
+}
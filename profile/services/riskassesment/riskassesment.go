@@ -19,6 +19,8 @@ type RiskAssesmentCore interface {
 	UpsertMlTfQuestion(ctx context.Context, in *rat.RiskAssesmentQuestionRequest) (*rat.RiskAssesmentQuestionResponse, error)
 	ListQuestion(ctx context.Context, in *rat.ListQuestionRequest) (*rat.ListQuestionResponse, error)
 	ListMlTfQuestion(ctx context.Context, in *rat.ListQuestionRequest) (*rat.ListQuestionResponse, error)
+	GetRiskScore(ctx context.Context, in *rat.GetRiskScoreRequest) (*rat.GetRiskScoreResponse, error)
+	ListRiskScores(ctx context.Context, in *rat.ListRiskScoresRequest) (*rat.ListRiskScoresResponse, error)
 }
 
 func New(core RiskAssesmentCore) *Svc {
@@ -0,0 +1,44 @@
+package riskassesment
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rat "brank.as/petnet/gunk/dsa/v1/riskassesment"
+)
+
+func (s *Svc) GetRiskScore(ctx context.Context, req *rat.GetRiskScoreRequest) (*rat.GetRiskScoreResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgID, validation.Required, is.UUIDv4),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := s.core.GetRiskScore(ctx, req)
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to get risk score")
+	}
+	return res, nil
+}
+
+func (s *Svc) ListRiskScores(ctx context.Context, req *rat.ListRiskScoresRequest) (*rat.ListRiskScoresResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgIDs, validation.Required, validation.Each(is.UUIDv4)),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	res, err := s.core.ListRiskScores(ctx, req)
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to list risk scores")
+	}
+	return res, nil
+}
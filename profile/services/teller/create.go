@@ -0,0 +1,89 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"brank.as/petnet/profile/storage"
+
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) CreateTeller(ctx context.Context, req *tpb.CreateTellerRequest) (*tpb.CreateTellerResponse, error) {
+	t := req.GetTeller()
+	if err := validation.ValidateStruct(t,
+		validation.Field(&t.OrgID, validation.Required, is.UUID),
+		validation.Field(&t.UserID, validation.Required),
+		validation.Field(&t.Username, validation.Required),
+		validation.Field(&t.Email, validation.Required, is.EmailFormat),
+		validation.Field(&t.BranchID, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tl, err := s.core.CreateTeller(ctx, storage.Teller{
+		OrgID:      t.GetOrgID(),
+		UserID:     t.GetUserID(),
+		Username:   t.GetUsername(),
+		FirstName:  t.GetFirstName(),
+		MiddleName: t.GetMiddleName(),
+		LastName:   t.GetLastName(),
+		Email:      t.GetEmail(),
+		Phone:      t.GetPhone(),
+		Birthdate:  t.GetBirthdate(),
+		BranchID:   t.GetBranchID(),
+		OperatorID: t.GetOperatorID(),
+		TerminalID: t.GetTerminalID(),
+		Status:     toStorageStatus(t.GetStatus()),
+	})
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to record teller")
+	}
+	return &tpb.CreateTellerResponse{ID: tl.ID}, nil
+}
+
+func (s *Svc) RegisterTeller(ctx context.Context, req *tpb.RegisterTellerRequest) (*tpb.RegisterTellerResponse, error) {
+	t := req.GetTeller()
+	if err := validation.ValidateStruct(t,
+		validation.Field(&t.OrgID, validation.Required, is.UUID),
+		validation.Field(&t.Username, validation.Required),
+		validation.Field(&t.Email, validation.Required, is.EmailFormat),
+		validation.Field(&t.BranchID, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.Password, validation.Required),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tl, err := s.core.RegisterTeller(ctx, storage.Teller{
+		OrgID:      t.GetOrgID(),
+		Username:   t.GetUsername(),
+		FirstName:  t.GetFirstName(),
+		MiddleName: t.GetMiddleName(),
+		LastName:   t.GetLastName(),
+		Email:      t.GetEmail(),
+		Phone:      t.GetPhone(),
+		Birthdate:  t.GetBirthdate(),
+		BranchID:   t.GetBranchID(),
+		OperatorID: t.GetOperatorID(),
+		TerminalID: t.GetTerminalID(),
+		Status:     toStorageStatus(t.GetStatus()),
+	}, req.GetPassword())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to register teller")
+	}
+	return &tpb.RegisterTellerResponse{ID: tl.ID, UserID: tl.UserID}, nil
+}
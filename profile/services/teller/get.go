@@ -0,0 +1,29 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) GetTeller(ctx context.Context, req *tpb.GetTellerRequest) (*tpb.GetTellerResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tl, err := s.core.GetTeller(ctx, req.GetID())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve teller")
+	}
+	return &tpb.GetTellerResponse{Teller: toTeller(*tl)}, nil
+}
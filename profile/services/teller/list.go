@@ -0,0 +1,89 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	tspb "google.golang.org/protobuf/types/known/timestamppb"
+
+	"brank.as/petnet/profile/storage"
+
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) ListTellers(ctx context.Context, req *tpb.ListTellersRequest) (*tpb.ListTellersResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.OrgID, validation.Required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+		validation.Field(&req.Limit, validation.Min(0)),
+		validation.Field(&req.Offset, validation.Min(0)),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tls, err := s.core.ListTellers(ctx, storage.TellerFilter{
+		OrgID:    req.GetOrgID(),
+		BranchID: req.GetBranchID(),
+		Status:   toStorageStatus(req.GetStatus()),
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	})
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to retrieve tellers")
+	}
+	res := &tpb.ListTellersResponse{Tellers: make([]*tpb.Teller, len(tls))}
+	for i, t := range tls {
+		res.Tellers[i] = toTeller(t)
+	}
+	if len(tls) > 0 {
+		res.Total = int32(tls[0].Count)
+	}
+	return res, nil
+}
+
+func toTeller(t storage.Teller) *tpb.Teller {
+	return &tpb.Teller{
+		ID:         t.ID,
+		OrgID:      t.OrgID,
+		UserID:     t.UserID,
+		Username:   t.Username,
+		FirstName:  t.FirstName,
+		MiddleName: t.MiddleName,
+		LastName:   t.LastName,
+		Email:      t.Email,
+		Phone:      t.Phone,
+		Birthdate:  t.Birthdate,
+		BranchID:   t.BranchID,
+		OperatorID: t.OperatorID,
+		TerminalID: t.TerminalID,
+		Status:     toTellerStatus(t.Status),
+		Created:    tspb.New(t.Created),
+		Updated:    tspb.New(t.Updated),
+	}
+}
+
+func toTellerStatus(s string) tpb.TellerStatus {
+	switch s {
+	case storage.TellerEnabled:
+		return tpb.TellerStatus_Enabled
+	case storage.TellerDisabled:
+		return tpb.TellerStatus_Disabled
+	}
+	return tpb.TellerStatus_UnknownTellerStatus
+}
+
+func toStorageStatus(s tpb.TellerStatus) string {
+	switch s {
+	case tpb.TellerStatus_Enabled:
+		return storage.TellerEnabled
+	case tpb.TellerStatus_Disabled:
+		return storage.TellerDisabled
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package teller
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+
+	"brank.as/petnet/profile/storage"
+
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+type Svc struct {
+	tpb.UnimplementedTellerServiceServer
+	core TellerCore
+}
+
+type TellerCore interface {
+	CreateTeller(context.Context, storage.Teller) (*storage.Teller, error)
+	RegisterTeller(ctx context.Context, t storage.Teller, password string) (*storage.Teller, error)
+	GetTeller(ctx context.Context, id string) (*storage.Teller, error)
+	ListTellers(context.Context, storage.TellerFilter) ([]storage.Teller, error)
+	SetTellerStatus(ctx context.Context, id, status string) (*storage.Teller, error)
+	AssignTellerBranch(ctx context.Context, id, branchID string) (*storage.Teller, error)
+}
+
+func New(core TellerCore) *Svc {
+	return &Svc{
+		core: core,
+	}
+}
+
+// RegisterService with grpc server.
+func (s *Svc) Register(srv *grpc.Server) { tpb.RegisterTellerServiceServer(srv, s) }
+
+// RegisterGateway grpcgw
+func (s *Svc) RegisterGateway(ctx context.Context, mux *runtime.ServeMux, address string, options []grpc.DialOption) error {
+	return tpb.RegisterTellerServiceHandlerFromEndpoint(ctx, mux, address, options)
+}
@@ -0,0 +1,197 @@
+package teller
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"brank.as/petnet/profile/storage"
+	"brank.as/petnet/profile/storage/postgres"
+
+	bpb "brank.as/petnet/gunk/dsa/v2/branch"
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+	bc "brank.as/petnet/profile/core/branch"
+	tc "brank.as/petnet/profile/core/teller"
+	bs "brank.as/petnet/profile/services/branch"
+	ops "brank.as/petnet/profile/services/orgprofile"
+	upb "brank.as/rbac/gunk/v1/user"
+)
+
+func TestTeller(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	st := newTestStorage(t)
+
+	oid := uuid.NewString()
+	if _, err := st.CreateOrgProfile(ctx, &storage.OrgProfile{
+		OrgID:         oid,
+		UserID:        uuid.NewString(),
+		TerminalIdOtc: "TERM-1",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	br, err := bs.New(bc.New(st)).UpsertBranch(ctx, &bpb.UpsertBranchRequest{
+		Branch: &bpb.Branch{OrgID: oid, Title: "branch"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := New(tc.New(st, ops.Mock{}, ops.Mock{}))
+	want := &tpb.Teller{
+		OrgID:      oid,
+		UserID:     uuid.NewString(),
+		Username:   "teller",
+		FirstName:  "first",
+		LastName:   "last",
+		Email:      "teller@example.com",
+		OperatorID: "OP-1",
+		TerminalID: "TERM-1",
+		Status:     tpb.TellerStatus_Enabled,
+	}
+	other := proto.Clone(want).(*tpb.Teller)
+	other.TerminalID = "TERM-2"
+	if _, err := s.CreateTeller(ctx, &tpb.CreateTellerRequest{Teller: other}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want invalid argument for a terminal of another org, got %v", err)
+	}
+	res, err := s.CreateTeller(ctx, &tpb.CreateTellerRequest{Teller: want})
+	if err != nil {
+		t.Fatal("create teller: ", err)
+	}
+	want.ID = res.GetID()
+
+	if _, err := s.AssignTellerBranch(ctx, &tpb.AssignTellerBranchRequest{
+		ID:       res.GetID(),
+		BranchID: uuid.NewString(),
+	}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("want invalid argument for unknown branch, got %v", err)
+	}
+	if _, err := s.AssignTellerBranch(ctx, &tpb.AssignTellerBranchRequest{
+		ID:       res.GetID(),
+		BranchID: br.GetID(),
+	}); err != nil {
+		t.Fatal("assign branch: ", err)
+	}
+	want.BranchID = br.GetID()
+
+	got, err := s.SetTellerStatus(ctx, &tpb.SetTellerStatusRequest{
+		ID:     res.GetID(),
+		Status: tpb.TellerStatus_Disabled,
+	})
+	if err != nil {
+		t.Fatal("disable teller: ", err)
+	}
+	want.Status = tpb.TellerStatus_Disabled
+	o := cmp.Options{
+		cmpopts.IgnoreFields(tpb.Teller{}, "Created", "Updated"),
+		cmpopts.IgnoreUnexported(tpb.Teller{}),
+	}
+	if !cmp.Equal(want, got.GetTeller(), o) {
+		t.Error("(-want +got): ", cmp.Diff(want, got.GetTeller(), o))
+	}
+
+	l, err := s.ListTellers(ctx, &tpb.ListTellersRequest{OrgID: oid, BranchID: br.GetID()})
+	if err != nil {
+		t.Fatal("list tellers: ", err)
+	}
+	if l.GetTotal() != 1 || !cmp.Equal(want, l.GetTellers()[0], o) {
+		t.Error("(-want +got): ", cmp.Diff([]*tpb.Teller{want}, l.GetTellers(), o))
+	}
+}
+
+type userClient struct {
+	ops.Mock
+	uid      string
+	disabled []string
+}
+
+func (c *userClient) Signup(context.Context, *upb.SignupRequest, ...grpc.CallOption) (*upb.SignupResponse, error) {
+	return &upb.SignupResponse{UserID: c.uid}, nil
+}
+
+func (c *userClient) DisableUser(_ context.Context, req *upb.DisableUserRequest, _ ...grpc.CallOption) (*upb.DisableUserResponse, error) {
+	c.disabled = append(c.disabled, req.GetUserID())
+	return &upb.DisableUserResponse{}, nil
+}
+
+func TestRegisterTeller(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	st := newTestStorage(t)
+	oid := uuid.NewString()
+	if _, err := st.CreateOrgProfile(ctx, &storage.OrgProfile{
+		OrgID:  oid,
+		UserID: uuid.NewString(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	uc := &userClient{uid: uuid.NewString()}
+	s := New(tc.New(st, uc, uc))
+	req := &tpb.RegisterTellerRequest{
+		Teller: &tpb.Teller{
+			OrgID:    oid,
+			Username: "teller",
+			Email:    "teller@example.com",
+		},
+		Password: "Pa$$w0rd",
+	}
+	res, err := s.RegisterTeller(ctx, req)
+	if err != nil {
+		t.Fatal("register teller: ", err)
+	}
+	if res.GetUserID() != uc.uid {
+		t.Errorf("want user %q, got %q", uc.uid, res.GetUserID())
+	}
+	if len(uc.disabled) != 0 {
+		t.Errorf("want no disabled user, got %v", uc.disabled)
+	}
+
+	// the same RBAC user can't be recorded twice, it is disabled again
+	if _, err := s.RegisterTeller(ctx, req); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("want already exists, got %v", err)
+	}
+	if !cmp.Equal([]string{uc.uid}, uc.disabled) {
+		t.Error("(-want +got): ", cmp.Diff([]string{uc.uid}, uc.disabled))
+	}
+}
+
+var _testStorage *postgres.Storage
+
+func TestMain(m *testing.M) {
+	const dbConnEnv = "DATABASE_CONNECTION"
+	ddlConnStr := os.Getenv(dbConnEnv)
+	if ddlConnStr == "" {
+		log.Printf("%s is not set, skipping", dbConnEnv)
+		return
+	}
+
+	var teardown func()
+	_testStorage, teardown = postgres.NewTestStorage(ddlConnStr, filepath.Join("..", "..", "migrations", "sql"))
+
+	exitCode := m.Run()
+
+	if teardown != nil {
+		teardown()
+	}
+	os.Exit(exitCode)
+}
+
+func newTestStorage(tb testing.TB) *postgres.Storage {
+	if testing.Short() {
+		tb.Skip("skipping tests that use postgres on -short")
+	}
+	return _testStorage
+}
@@ -0,0 +1,50 @@
+package teller
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "brank.as/petnet/gunk/dsa/v2/teller"
+)
+
+func (s *Svc) SetTellerStatus(ctx context.Context, req *tpb.SetTellerStatusRequest) (*tpb.SetTellerStatusResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+		validation.Field(&req.Status, validation.Required, validation.In(
+			tpb.TellerStatus_Enabled, tpb.TellerStatus_Disabled,
+		)),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tl, err := s.core.SetTellerStatus(ctx, req.GetID(), toStorageStatus(req.GetStatus()))
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to update teller status")
+	}
+	return &tpb.SetTellerStatusResponse{Teller: toTeller(*tl)}, nil
+}
+
+func (s *Svc) AssignTellerBranch(ctx context.Context, req *tpb.AssignTellerBranchRequest) (*tpb.AssignTellerBranchResponse, error) {
+	if err := validation.ValidateStruct(req,
+		validation.Field(&req.ID, validation.Required, is.UUID),
+		validation.Field(&req.BranchID, is.UUID),
+	); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tl, err := s.core.AssignTellerBranch(ctx, req.GetID(), req.GetBranchID())
+	if err != nil {
+		if status.Code(err) != codes.Unknown {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, "failed to assign teller branch")
+	}
+	return &tpb.AssignTellerBranchResponse{Teller: toTeller(*tl)}, nil
+}
@@ -101,6 +101,19 @@ func (s *Storage) UpsertBranch(ctx context.Context, br storage.Branch) (*storage
 	return &br, nil
 }
 
+// GetBranch returns the branch record with the given ID.
+func (s *Storage) GetBranch(ctx context.Context, id string) (*storage.Branch, error) {
+	const getBranch = `SELECT * FROM branch WHERE id = $1 AND deleted IS NULL`
+	var br storage.Branch
+	if err := s.db.GetContext(ctx, &br, getBranch, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.NotFound
+		}
+		return nil, err
+	}
+	return &br, nil
+}
+
 // ListBranches return branch records matched against org ID
 func (s *Storage) ListBranches(ctx context.Context, oid string, f storage.LimitOffsetFilter, title string) ([]storage.Branch, error) {
 	const getBranches = `
@@ -217,3 +217,47 @@ func TestListBranches(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBranch(t *testing.T) {
+	ts := newTestStorage(t)
+
+	ctx := context.Background()
+	oid := uuid.NewString()
+	_, err := ts.CreateOrgProfile(ctx, &storage.OrgProfile{
+		OrgID:  oid,
+		UserID: uuid.NewString(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := ts.CreateBranch(ctx, storage.Branch{
+		OrgID:        oid,
+		OrgProfileID: uuid.NewString(),
+		Title:        "title1",
+		BranchAddress: storage.BranchAddress{
+			Address1:   "addr1",
+			City:       "city",
+			State:      "state",
+			PostalCode: "12345",
+		},
+		PhoneNumber:   "12345",
+		FaxNumber:     "12345",
+		ContactPerson: "contact",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ts.GetBranch(ctx, want.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o := cmpopts.IgnoreFields(storage.Branch{}, "Created", "Updated")
+	if !cmp.Equal(*want, *got, o) {
+		t.Error("(-want +got): ", cmp.Diff(*want, *got, o))
+	}
+
+	if _, err := ts.GetBranch(ctx, uuid.NewString()); err != storage.NotFound {
+		t.Errorf("want not found, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"brank.as/petnet/profile/storage"
+)
+
+// GetOnboarding returns the current onboarding state of an org.
+func (s *Storage) GetOnboarding(ctx context.Context, orgID string) (*storage.Onboarding, error) {
+	const getOnboarding = `SELECT * FROM onboarding WHERE org_id = $1`
+	var o storage.Onboarding
+	if err := s.db.GetContext(ctx, &o, getOnboarding, orgID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.NotFound
+		}
+		return nil, err
+	}
+	return &o, nil
+}
+
+const transitionOnboarding = `
+INSERT INTO onboarding (
+	org_id,
+	state
+) VALUES (
+	:org_id,
+	:to_state
+) ON CONFLICT (org_id) DO UPDATE SET
+	state = :to_state,
+	updated = now()
+WHERE onboarding.state = :from_state
+RETURNING *
+`
+
+const insertOnboardingHistory = `
+INSERT INTO onboarding_history (
+	org_id,
+	from_state,
+	to_state,
+	actor_id,
+	remarks
+) VALUES (
+	:org_id,
+	:from_state,
+	:to_state,
+	:actor_id,
+	:remarks
+) RETURNING
+	id, created
+`
+
+// TransitionOnboarding moves the org from h.FromState to h.ToState, sets the
+// org profile status to orgStatus and records the transition. Conflict is
+// returned when the org is no longer in h.FromState.
+func (s *Storage) TransitionOnboarding(ctx context.Context, h storage.OnboardingHistory, orgStatus int) (*storage.OnboardingHistory, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareNamedContext(ctx, transitionOnboarding)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	var o storage.Onboarding
+	if err := stmt.GetContext(ctx, &o, h); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing onboarding update: %w", err)
+	}
+
+	const setOrgStatus = `UPDATE org_profile SET status = $2, updated = now() WHERE org_id = $1`
+	if _, err := tx.ExecContext(ctx, setOrgStatus, h.OrgID, orgStatus); err != nil {
+		return nil, fmt.Errorf("executing org profile status update: %w", err)
+	}
+
+	hstmt, err := tx.PrepareNamedContext(ctx, insertOnboardingHistory)
+	if err != nil {
+		return nil, err
+	}
+	defer hstmt.Close()
+	if err := hstmt.GetContext(ctx, &h, h); err != nil {
+		return nil, fmt.Errorf("executing onboarding history insert: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// ListOnboardingHistory returns the onboarding transitions of an org, oldest
+// first.
+func (s *Storage) ListOnboardingHistory(ctx context.Context, orgID string) ([]storage.OnboardingHistory, error) {
+	const listOnboardingHistory = `SELECT * FROM onboarding_history WHERE org_id = $1 ORDER BY created ASC`
+	var hs []storage.OnboardingHistory
+	if err := s.db.SelectContext(ctx, &hs, listOnboardingHistory, orgID); err != nil {
+		return nil, err
+	}
+	return hs, nil
+}
@@ -0,0 +1,80 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/profile/storage"
+)
+
+func TestOnboarding(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.Background()
+	oid, uid := uuid.NewString(), uuid.NewString()
+
+	if _, err := ts.CreateOrgProfile(ctx, &storage.OrgProfile{
+		OrgID:  oid,
+		UserID: uuid.NewString(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.GetOnboarding(ctx, oid); err != storage.NotFound {
+		t.Fatalf("want not found, got %v", err)
+	}
+
+	want := []storage.OnboardingHistory{
+		{
+			OrgID:     oid,
+			FromState: storage.OnboardingDraft,
+			ToState:   storage.OnboardingSubmitted,
+			ActorID:   uid,
+		},
+		{
+			OrgID:     oid,
+			FromState: storage.OnboardingSubmitted,
+			ToState:   storage.OnboardingUnderReview,
+			ActorID:   uid,
+			Remarks:   "documents complete",
+		},
+	}
+	for i, h := range want {
+		got, err := ts.TransitionOnboarding(ctx, h, i+1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.ID == "" || got.Created.IsZero() {
+			t.Error("want history id and created set")
+		}
+	}
+	// stale transitions are rejected
+	if _, err := ts.TransitionOnboarding(ctx, want[0], 1); err != storage.Conflict {
+		t.Errorf("want conflict, got %v", err)
+	}
+	pf, err := ts.GetOrgProfile(ctx, oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pf.Status != len(want) {
+		t.Errorf("want org profile status %d, got %d", len(want), pf.Status)
+	}
+
+	o, err := ts.GetOnboarding(ctx, oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.State != storage.OnboardingUnderReview {
+		t.Errorf("want state %q, got %q", storage.OnboardingUnderReview, o.State)
+	}
+	got, err := ts.ListOnboardingHistory(ctx, oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opt := cmpopts.IgnoreFields(storage.OnboardingHistory{}, "ID", "Created")
+	if !cmp.Equal(want, got, opt) {
+		t.Error("(-want +got): ", cmp.Diff(want, got, opt))
+	}
+}
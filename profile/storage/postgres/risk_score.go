@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/profile/storage"
+)
+
+// ListOrgMlTfQuestion return the MLTF questions answered by any user of the org
+func (s *Storage) ListOrgMlTfQuestion(ctx context.Context, orgID string) ([]storage.Question, error) {
+	const listQuestion = `SELECT * FROM mltf_risk_assesment_question WHERE org_id = $1 ORDER BY qid, updated`
+	var qsn []storage.Question
+	if err := s.db.SelectContext(ctx, &qsn, listQuestion, orgID); err != nil {
+		return nil, err
+	}
+	return qsn, nil
+}
+
+// UpsertRiskScore stores the computed risk score of the org
+func (s *Storage) UpsertRiskScore(ctx context.Context, rs *storage.RiskScore) (*storage.RiskScore, error) {
+	const upsertRiskScore = `
+INSERT INTO risk_score (org_id, risk_score, percent, model_version, breakdown)
+VALUES (:org_id, :risk_score, :percent, :model_version, :breakdown)
+ON CONFLICT (org_id) DO UPDATE SET
+	risk_score = EXCLUDED.risk_score,
+	percent = EXCLUDED.percent,
+	model_version = EXCLUDED.model_version,
+	breakdown = EXCLUDED.breakdown,
+	updated = now()
+RETURNING *`
+	stmt, err := s.db.PrepareNamedContext(ctx, upsertRiskScore)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(rs, rs); err != nil {
+		return nil, fmt.Errorf("executing risk score upsert: %w", err)
+	}
+	return rs, nil
+}
+
+// SetOrgProfileRiskScore sets the risk score level on the org profile
+func (s *Storage) SetOrgProfileRiskScore(ctx context.Context, orgID string, riskScore int) error {
+	const setRiskScore = `UPDATE org_profile SET risk_score = $2, updated = now() WHERE org_id = $1`
+	res, err := s.db.ExecContext(ctx, setRiskScore, orgID, riskScore)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return storage.NotFound
+	}
+	return nil
+}
+
+// GetRiskScore return the computed risk score of the org
+func (s *Storage) GetRiskScore(ctx context.Context, orgID string) (*storage.RiskScore, error) {
+	const getRiskScore = `SELECT * FROM risk_score WHERE org_id = $1`
+	var rs storage.RiskScore
+	if err := s.db.GetContext(ctx, &rs, getRiskScore, orgID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.NotFound
+		}
+		return nil, err
+	}
+	return &rs, nil
+}
+
+// ListRiskScores return the computed risk scores of the orgs, orgs not scored yet are left out
+func (s *Storage) ListRiskScores(ctx context.Context, orgIDs []string) ([]storage.RiskScore, error) {
+	const listRiskScores = `SELECT * FROM risk_score WHERE org_id = ANY($1)`
+	var rs []storage.RiskScore
+	if err := s.db.SelectContext(ctx, &rs, listRiskScores, pq.Array(orgIDs)); err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
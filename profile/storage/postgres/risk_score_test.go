@@ -0,0 +1,91 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/profile/storage"
+)
+
+func TestRiskScore(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.Background()
+	oid := uuid.NewString()
+	if _, err := ts.CreateOrgProfile(ctx, &storage.OrgProfile{
+		OrgID:  oid,
+		UserID: uuid.NewString(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for _, uid := range []string{uuid.NewString(), uuid.NewString()} {
+		if _, err := ts.UpsertMlTfQuestion(ctx, &storage.Question{
+			OrgID:          oid,
+			UserID:         uid,
+			QID:            "1",
+			QType:          "1",
+			CustomersTotal: "10",
+			HrTotal:        "1",
+			ImpactScore:    "3",
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	qs, err := ts.ListOrgMlTfQuestion(ctx, oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(qs) != 2 {
+		t.Fatalf("want the answers of every user, got %d", len(qs))
+	}
+
+	want := storage.RiskScore{
+		OrgID:        oid,
+		RiskScore:    2,
+		Percent:      60,
+		ModelVersion: "v1",
+		Breakdown: storage.RiskBreakdown{Questions: []storage.RiskFactor{
+			{QID: "1", Weight: 1, CustomersTotal: 10, HrTotal: 1, ImpactScore: 3, Points: 3, MaxPoints: 5},
+		}},
+	}
+	o := cmpopts.IgnoreFields(storage.RiskScore{}, "Created", "Updated")
+	for _, rs := range []storage.RiskScore{want, want} {
+		if _, err := ts.UpsertRiskScore(ctx, &rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := ts.GetRiskScore(ctx, oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(want, *got, o) {
+		t.Error(cmp.Diff(want, *got, o))
+	}
+	if err := ts.SetOrgProfileRiskScore(ctx, oid, want.RiskScore); err != nil {
+		t.Fatal(err)
+	}
+	if err := ts.SetOrgProfileRiskScore(ctx, uuid.NewString(), want.RiskScore); err != storage.NotFound {
+		t.Errorf("want not found, got %v", err)
+	}
+	pf, err := ts.GetOrgProfile(ctx, oid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pf.RiskScore != want.RiskScore {
+		t.Errorf("want org profile risk score %d, got %d", want.RiskScore, pf.RiskScore)
+	}
+
+	l, err := ts.ListRiskScores(ctx, []string{oid, uuid.NewString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal([]storage.RiskScore{want}, l, o) {
+		t.Error(cmp.Diff([]storage.RiskScore{want}, l, o))
+	}
+	if _, err := ts.GetRiskScore(ctx, uuid.NewString()); err != storage.NotFound {
+		t.Errorf("want not found, got %v", err)
+	}
+}
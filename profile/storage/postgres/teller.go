@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"brank.as/petnet/profile/storage"
+)
+
+const insertTeller = `
+INSERT INTO teller (
+	org_id,
+	user_id,
+	username,
+	first_name,
+	middle_name,
+	last_name,
+	email,
+	phone,
+	birthdate,
+	branch_id,
+	operator_id,
+	terminal_id,
+	status
+) VALUES (
+	:org_id,
+	:user_id,
+	:username,
+	:first_name,
+	:middle_name,
+	:last_name,
+	:email,
+	:phone,
+	:birthdate,
+	:branch_id,
+	:operator_id,
+	:terminal_id,
+	:status
+) RETURNING
+	id, created, updated
+`
+
+// CreateTeller inserts a teller record, a user can only be registered once.
+func (s *Storage) CreateTeller(ctx context.Context, t storage.Teller) (*storage.Teller, error) {
+	if t.Status == "" {
+		t.Status = storage.TellerEnabled
+	}
+	stmt, err := s.db.PrepareNamedContext(ctx, insertTeller)
+	if err != nil {
+		return nil, err
+	}
+	defer stmt.Close()
+	if err := stmt.Get(&t, t); err != nil {
+		pErr, ok := err.(*pq.Error)
+		if ok && pErr.Code == pqUnique {
+			return nil, storage.Conflict
+		}
+		return nil, fmt.Errorf("executing teller insert: %w", err)
+	}
+	return &t, nil
+}
+
+// GetTeller returns the teller record with the given ID.
+func (s *Storage) GetTeller(ctx context.Context, id string) (*storage.Teller, error) {
+	const getTeller = `SELECT * FROM teller WHERE id = $1`
+	var t storage.Teller
+	if err := s.db.GetContext(ctx, &t, getTeller, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.NotFound
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ListTellers returns the tellers of an org, optionally only those of a branch
+// or with a status.
+func (s *Storage) ListTellers(ctx context.Context, f storage.TellerFilter) ([]storage.Teller, error) {
+	const listTellers = `
+SELECT *, count(*) OVER() AS count
+FROM teller
+WHERE org_id = $1
+AND ($2 = '' OR branch_id = $2)
+AND ($3 = '' OR status = $3)
+ORDER BY created ASC
+LIMIT NULLIF($4, 0)
+OFFSET $5`
+	var ts []storage.Teller
+	if err := s.db.SelectContext(ctx, &ts, listTellers, f.OrgID, f.BranchID, f.Status, f.Limit, f.Offset); err != nil {
+		return nil, err
+	}
+	return ts, nil
+}
+
+// SetTellerStatus enables or disables a teller.
+func (s *Storage) SetTellerStatus(ctx context.Context, id, status string) (*storage.Teller, error) {
+	const setTellerStatus = `UPDATE teller SET status = $2, updated = now() WHERE id = $1 RETURNING *`
+	var t storage.Teller
+	if err := s.db.GetContext(ctx, &t, setTellerStatus, id, status); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.NotFound
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+// AssignTellerBranch moves a teller to a branch, an empty branch unassigns
+// the teller.
+func (s *Storage) AssignTellerBranch(ctx context.Context, id, branchID string) (*storage.Teller, error) {
+	const assignTellerBranch = `UPDATE teller SET branch_id = $2, updated = now() WHERE id = $1 RETURNING *`
+	var t storage.Teller
+	if err := s.db.GetContext(ctx, &t, assignTellerBranch, id, branchID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.NotFound
+		}
+		return nil, err
+	}
+	return &t, nil
+}
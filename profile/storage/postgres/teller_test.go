@@ -0,0 +1,79 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+
+	"brank.as/petnet/profile/storage"
+)
+
+func TestTeller(t *testing.T) {
+	ts := newTestStorage(t)
+	ctx := context.Background()
+	oid, bid := uuid.NewString(), uuid.NewString()
+
+	want := storage.Teller{
+		OrgID:      oid,
+		UserID:     uuid.NewString(),
+		Username:   "teller1",
+		FirstName:  "first",
+		LastName:   "last",
+		Email:      "teller1@example.com",
+		OperatorID: "OP-1",
+		TerminalID: "TERM-1",
+	}
+	got, err := ts.CreateTeller(ctx, want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want.ID = got.ID
+	want.Status = storage.TellerEnabled
+	o := cmpopts.IgnoreFields(storage.Teller{}, "Created", "Updated", "Count")
+	g, err := ts.GetTeller(ctx, got.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(want, *g, o) {
+		t.Error("(-want +got): ", cmp.Diff(want, *g, o))
+	}
+	if _, err := ts.CreateTeller(ctx, want); err != storage.Conflict {
+		t.Errorf("want conflict registering the user twice, got %v", err)
+	}
+	if _, err := ts.CreateTeller(ctx, storage.Teller{
+		OrgID:  oid,
+		UserID: uuid.NewString(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ts.AssignTellerBranch(ctx, got.ID, bid); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ts.SetTellerStatus(ctx, got.ID, storage.TellerDisabled); err != nil {
+		t.Fatal(err)
+	}
+	want.BranchID, want.Status = bid, storage.TellerDisabled
+
+	l, err := ts.ListTellers(ctx, storage.TellerFilter{OrgID: oid, BranchID: bid})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal([]storage.Teller{want}, l, o) {
+		t.Error("(-want +got): ", cmp.Diff([]storage.Teller{want}, l, o))
+	}
+	l, err = ts.ListTellers(ctx, storage.TellerFilter{OrgID: oid, Status: storage.TellerEnabled})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(l) != 1 || l[0].Count != 1 {
+		t.Errorf("want 1 enabled teller, got %d", len(l))
+	}
+
+	if _, err := ts.GetTeller(ctx, uuid.NewString()); err != storage.NotFound {
+		t.Errorf("want not found, got %v", err)
+	}
+}
@@ -131,6 +131,73 @@ type Branch struct {
 	// Used for list query only
 	Count int
 }
+
+// Teller statuses.
+const (
+	TellerEnabled  = "ENABLED"
+	TellerDisabled = "DISABLED"
+)
+
+// Teller is a DSA staff member transacting over the counter. OperatorID and
+// TerminalID are sent to the partners on the teller's transactions.
+type Teller struct {
+	ID         string    `db:"id"`
+	OrgID      string    `db:"org_id"`
+	UserID     string    `db:"user_id"`
+	Username   string    `db:"username"`
+	FirstName  string    `db:"first_name"`
+	MiddleName string    `db:"middle_name"`
+	LastName   string    `db:"last_name"`
+	Email      string    `db:"email"`
+	Phone      string    `db:"phone"`
+	Birthdate  string    `db:"birthdate"`
+	BranchID   string    `db:"branch_id"`
+	OperatorID string    `db:"operator_id"`
+	TerminalID string    `db:"terminal_id"`
+	Status     string    `db:"status"`
+	Created    time.Time `db:"created"`
+	Updated    time.Time `db:"updated"`
+	// Used for list query only
+	Count int `db:"count"`
+}
+
+type TellerFilter struct {
+	OrgID    string
+	BranchID string
+	Status   string
+	Limit    int
+	Offset   int
+}
+
+// Onboarding states of a DSA application.
+const (
+	OnboardingDraft            = "DRAFT"
+	OnboardingSubmitted        = "SUBMITTED"
+	OnboardingDocumentsPending = "DOCUMENTS_PENDING"
+	OnboardingUnderReview      = "UNDER_REVIEW"
+	OnboardingApproved         = "APPROVED"
+	OnboardingRejected         = "REJECTED"
+	OnboardingLive             = "LIVE"
+)
+
+type Onboarding struct {
+	OrgID   string    `db:"org_id"`
+	State   string    `db:"state"`
+	Created time.Time `db:"created"`
+	Updated time.Time `db:"updated"`
+}
+
+// OnboardingHistory records a single onboarding state transition.
+type OnboardingHistory struct {
+	ID        string    `db:"id"`
+	OrgID     string    `db:"org_id"`
+	FromState string    `db:"from_state"`
+	ToState   string    `db:"to_state"`
+	ActorID   string    `db:"actor_id"`
+	Remarks   string    `db:"remarks"`
+	Created   time.Time `db:"created"`
+}
+
 type Amount struct {
 	Amount   string `json:"amount,omitempty"`
 	Currency string `json:"currency,omitempty"`
@@ -181,6 +248,28 @@ type Question struct {
 	Created        time.Time `db:"created"`
 	Updated        time.Time `db:"updated"`
 }
+type RiskScore struct {
+	OrgID        string        `db:"org_id"`
+	RiskScore    int           `db:"risk_score"`
+	Percent      float64       `db:"percent"`
+	ModelVersion string        `db:"model_version"`
+	Breakdown    RiskBreakdown `db:"breakdown"`
+	Created      time.Time     `db:"created"`
+	Updated      time.Time     `db:"updated"`
+}
+type RiskBreakdown struct {
+	Questions []RiskFactor `json:"questions"`
+}
+type RiskFactor struct {
+	QID            string  `json:"qid"`
+	Weight         float64 `json:"weight"`
+	CustomersTotal float64 `json:"customers_total"`
+	HrTotal        float64 `json:"hr_total"`
+	ImpactScore    float64 `json:"impact_score"`
+	Points         float64 `json:"points"`
+	MaxPoints      float64 `json:"max_points"`
+	Note           string  `json:"note,omitempty"`
+}
 type WesternUnionPartner struct {
 	Coy        string    `json:"coy"`
 	TerminalID string    `json:"terminal_id"`
@@ -464,6 +553,18 @@ func (d *RoleData) Scan(value interface{}) error {
 	return json.Unmarshal(b, &d)
 }
 
+func (d RiskBreakdown) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+func (d *RiskBreakdown) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, &d)
+}
+
 type UploadServiceRequest struct {
 	ID       string       `db:"id"`
 	OrgID    string       `db:"org_id"`
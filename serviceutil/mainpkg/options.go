@@ -53,6 +53,14 @@ func WithLeaderCron(name string, cron Schedule, f LeaderFunc) Option {
 	}
 }
 
+// WithLeadElector sets the leader election client used by leader crons. The
+// function is called with the configured elector socket.
+func WithLeadElector(f func(string) (Leader, error)) Option {
+	return func(conf *Config) {
+		conf.LeadElector = f
+	}
+}
+
 // OptionList allows configuring a set of options for a given environment,
 // avoiding the need to append all standard service options
 // to an environment-specific set of options.
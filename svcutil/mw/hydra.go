@@ -744,6 +744,8 @@ const (
 	BillsPaymentService  string = "bills_payment.BillspaymentService"
 	RuralNetService      string = "microinsurance.MicroInsuranceService"
 	RuralNetParter       string = "RuralNet"
+	ELoadService         string = "eload.ELoadService"
+	ELoadPartner         string = "ELOAD"
 	Provider             string = "provider"
 )
 
@@ -924,6 +926,14 @@ func ValidateAccess(cl pfSvc.ServiceServiceClient, pl ptnrLst.PartnerListService
 			needValidate = true
 			service = pfSvc.ServiceType_MICROINSURANCE.String()
 			partner = RuralNetParter
+		case strings.Contains(info.FullMethod, ELoadService):
+			if orgType == Provider {
+				log.Error("providers not allowed to access eload")
+				return nil, apiutil.HandleServiceErr(status.Error(codes.PermissionDenied, "Forbidden partner"))
+			}
+			needValidate = true
+			service = pfSvc.ServiceType_ELOAD.String()
+			partner = ELoadPartner
 		default:
 			needValidate = false
 		}
@@ -65,3 +65,12 @@ var RTAPartnersList = []*storage.PartnerList{
 		ServiceName: sVcpb.ServiceType_REMITTOACCOUNT.String(),
 	},
 }
+
+var ELoadPartnersList = []*storage.PartnerList{
+	{
+		Stype:       "ELOAD",
+		Name:        "ELOAD",
+		Status:      spb.PartnerStatusType_ENABLED.String(),
+		ServiceName: sVcpb.ServiceType_ELOAD.String(),
+	},
+}